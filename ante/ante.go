@@ -36,6 +36,8 @@ type HandlerOptions struct {
 	// use dynamic fee checker or the cosmos-sdk default one for native transactions
 	DynamicFeeChecker bool
 	PendingTxListener PendingTxListener
+	// optional keeper used to pay transaction fees with whitelisted fee tokens
+	FeeTokenKeeper anteinterfaces.FeeTokenKeeper
 }
 
 // Validate checks if the keepers are defined
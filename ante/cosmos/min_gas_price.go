@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"slices"
 
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -22,12 +23,20 @@ import (
 // CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
 type MinGasPriceDecorator struct {
 	feemarketParams *feemarkettypes.Params
+	feeTokenKeeper  anteinterfaces.FeeTokenKeeper
 }
 
 // NewMinGasPriceDecorator creates a new MinGasPriceDecorator instance used only for
 // Cosmos transactions.
 func NewMinGasPriceDecorator(feemarketParams *feemarkettypes.Params) MinGasPriceDecorator {
-	return MinGasPriceDecorator{feemarketParams}
+	return MinGasPriceDecorator{feemarketParams: feemarketParams}
+}
+
+// WithFeeTokenKeeper allows paying the fees with the fee tokens whitelisted in
+// the given keeper, which are converted to the EVM denom for the min gas price check.
+func (mpd MinGasPriceDecorator) WithFeeTokenKeeper(feeTokenKeeper anteinterfaces.FeeTokenKeeper) MinGasPriceDecorator {
+	mpd.feeTokenKeeper = feeTokenKeeper
+	return mpd
 }
 
 func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
@@ -46,6 +55,14 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	//
 	// TODO: is the handling of stake necessary here? Why not adjust the tests to contain the correct denom?
 	validFees := len(feeCoins) == 0 || (len(feeCoins) == 1 && slices.Contains([]string{evmDenom, sdk.DefaultBondDenom}, feeCoins.GetDenomByIndex(0)))
+
+	// fees paid with a whitelisted fee token are checked against their value in the EVM denom
+	if !validFees && len(feeCoins) == 1 && mpd.feeTokenKeeper != nil {
+		if price, err := mpd.feeTokenKeeper.GetFeeTokenPrice(ctx, feeCoins[0].Denom); err == nil {
+			feeCoins = sdk.Coins{{Denom: evmDenom, Amount: math.LegacyNewDecFromInt(feeCoins[0].Amount).Mul(price).TruncateInt()}}
+			validFees = true
+		}
+	}
 	if !validFees && !simulate {
 		return ctx, fmt.Errorf("expected only native token %s for fee, but got %s", evmDenom, feeCoins.String())
 	}
@@ -0,0 +1,180 @@
+package cosmos
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	"github.com/cosmos/evm/ethereum/eip712"
+
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SigVerificationDecorator verifies the signatures of the transactions like the
+// SDK SigVerificationDecorator, and additionally supports the signatures of the
+// EIP-712 sign mode, which the SDK signature verification rejects as it only
+// supports its own sign modes.
+//
+// CONTRACT: Pubkeys are set in context for all signers before this decorator runs
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type SigVerificationDecorator struct {
+	ak              anteinterfaces.AccountKeeper
+	signModeHandler *txsigning.HandlerMap
+	sdkDecorator    authante.SigVerificationDecorator
+}
+
+// NewSigVerificationDecorator creates a new SigVerificationDecorator
+func NewSigVerificationDecorator(ak anteinterfaces.AccountKeeper, signModeHandler *txsigning.HandlerMap) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		sdkDecorator:    authante.NewSigVerificationDecorator(ak, signModeHandler),
+	}
+}
+
+// AnteHandle verifies the signatures of the transactions containing EIP-712
+// sign mode signatures, and delegates the other transactions to the SDK
+// signature verification.
+func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	if !hasEIP712Signature(sigs) {
+		return svd.sdkDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if err := svd.verifySignatures(ctx, sigTx, sigs, simulate); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// verifySignatures verifies the signatures and sequences of the transaction
+// signers.
+func (svd SigVerificationDecorator) verifySignatures(ctx sdk.Context, tx authsigning.Tx, sigs []signing.SignatureV2, simulate bool) error {
+	// the unordered transactions are not supported by the EIP-712 sign mode
+	if utx, ok := tx.(sdk.TxWithUnordered); ok && utx.GetUnordered() {
+		return errorsmod.Wrap(errortypes.ErrNotSupported, "unordered transactions are not supported by the EIP-712 sign mode")
+	}
+
+	signers, err := tx.GetSigners()
+	if err != nil {
+		return err
+	}
+
+	if len(sigs) != len(signers) {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	for i, sig := range sigs {
+		acc, err := authante.GetSignerAcc(ctx, svd.ak, signers[i])
+		if err != nil {
+			return err
+		}
+
+		pubKey := acc.GetPubKey()
+		if !simulate && pubKey == nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+
+		if sig.Sequence != acc.GetSequence() {
+			return errorsmod.Wrapf(
+				errortypes.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		// no need to verify signatures on recheck tx
+		if simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+			continue
+		}
+
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+
+		anyPk, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return err
+		}
+
+		signerData := txsigning.SignerData{
+			Address:       acc.GetAddress().String(),
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      sig.Sequence,
+			PubKey: &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			},
+		}
+
+		adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+		if !ok {
+			return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+		}
+		txData := adaptableTx.GetSigningTxData()
+
+		if err := svd.verifySignature(ctx, pubKey, signerData, sig.Data, txData); err != nil {
+			return errorsmod.Wrapf(
+				errortypes.ErrUnauthorized,
+				"signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, ctx.ChainID(), err.Error(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// verifySignature verifies a signature of the EIP-712 sign mode, or delegates
+// the verification of the other sign modes to the SDK.
+func (svd SigVerificationDecorator) verifySignature(
+	ctx sdk.Context,
+	pubKey cryptotypes.PubKey,
+	signerData txsigning.SignerData,
+	sigData signing.SignatureData,
+	txData txsigning.TxData,
+) error {
+	data, ok := sigData.(*signing.SingleSignatureData)
+	if !ok || data.SignMode != signing.SignMode(eip712.SignModeEIP712) {
+		return authsigning.VerifySignature(ctx, pubKey, signerData, sigData, svd.signModeHandler, txData)
+	}
+
+	signBytes, err := svd.signModeHandler.GetSignBytes(ctx, eip712.SignModeEIP712, signerData, txData)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, data.Signature) {
+		return fmt.Errorf("unable to verify single signer signature")
+	}
+	return nil
+}
+
+// hasEIP712Signature returns true if any of the signatures uses the EIP-712
+// sign mode.
+func hasEIP712Signature(sigs []signing.SignatureV2) bool {
+	for _, sig := range sigs {
+		if data, ok := sig.Data.(*signing.SingleSignatureData); ok && data.SignMode == signing.SignMode(eip712.SignModeEIP712) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package cosmos_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/cosmos/evm/ante/cosmos"
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/ethereum/eip712"
+	"github.com/cosmos/evm/testutil"
+	"github.com/cosmos/evm/testutil/constants"
+
+	"cosmossdk.io/math"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type sigVerifyAccountKeeper struct {
+	anteinterfaces.AccountKeeper
+	accounts map[string]sdk.AccountI
+}
+
+func (k sigVerifyAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return k.accounts[addr.String()]
+}
+
+func (k sigVerifyAccountKeeper) UnorderedTransactionsEnabled() bool {
+	return false
+}
+
+func TestSigVerificationDecoratorEIP712(t *testing.T) {
+	encodingCfg := encoding.MakeConfig(constants.ExampleChainID.EVMChainID)
+	txCfg := encodingCfg.TxConfig
+	testPrivKeys, testAddresses, err := testutil.GeneratePrivKeyAddressPairs(2)
+	require.NoError(t, err)
+
+	const (
+		chainID       = "cosmos-1"
+		accountNumber = uint64(5)
+		sequence      = uint64(3)
+	)
+	account := authtypes.NewBaseAccount(testAddresses[0], testPrivKeys[0].PubKey(), accountNumber, sequence)
+	ak := sigVerifyAccountKeeper{accounts: map[string]sdk.AccountI{testAddresses[0].String(): account}}
+	decorator := cosmos.NewSigVerificationDecorator(ak, txCfg.SignModeHandler())
+
+	ctx := sdk.Context{}.WithBlockHeight(1).WithChainID(chainID).WithIsSigverifyTx(true)
+
+	newTx := func(privKey *ethsecp256k1.PrivKey, sigSequence uint64) sdk.Tx {
+		txBuilder := txCfg.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(
+			testAddresses[0],
+			testAddresses[1],
+			sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(10))),
+		)))
+		txBuilder.SetGasLimit(200000)
+		txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(2000))))
+
+		sig := signing.SignatureV2{
+			PubKey:   testPrivKeys[0].PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode(eip712.SignModeEIP712)},
+			Sequence: sigSequence,
+		}
+		require.NoError(t, txBuilder.SetSignatures(sig))
+
+		anyPk, err := codectypes.NewAnyWithValue(testPrivKeys[0].PubKey())
+		require.NoError(t, err)
+		signerData := txsigning.SignerData{
+			Address:       testAddresses[0].String(),
+			ChainID:       chainID,
+			AccountNumber: accountNumber,
+			Sequence:      sigSequence,
+			PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+		}
+		txData := txBuilder.GetTx().(authsigning.V2AdaptableTx).GetSigningTxData()
+		signBytes, err := txCfg.SignModeHandler().GetSignBytes(ctx, eip712.SignModeEIP712, signerData, txData)
+		require.NoError(t, err)
+
+		sig.Data.(*signing.SingleSignatureData).Signature, err = privKey.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, txBuilder.SetSignatures(sig))
+		return txBuilder.GetTx()
+	}
+
+	testCases := []struct {
+		name        string
+		tx          sdk.Tx
+		reCheckTx   bool
+		expectedErr error
+	}{
+		{
+			"valid EIP-712 signature",
+			newTx(testPrivKeys[0], sequence),
+			false,
+			nil,
+		},
+		{
+			"invalid EIP-712 signature",
+			newTx(testPrivKeys[1], sequence),
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"invalid EIP-712 signature - recheck tx",
+			newTx(testPrivKeys[1], sequence),
+			true,
+			nil,
+		},
+		{
+			"wrong sequence",
+			newTx(testPrivKeys[0], sequence+1),
+			false,
+			sdkerrors.ErrWrongSequence,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(ctx.WithIsReCheckTx(tc.reCheckTx), tc.tx, false, testutil.NoOpNextFn)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -18,7 +18,7 @@ func newMonoEVMAnteHandler(ctx sdk.Context, options HandlerOptions) sdk.AnteHand
 			options.MaxTxGasWanted,
 			&evmParams,
 			&feemarketParams,
-		),
+		).WithFeeTokenKeeper(options.FeeTokenKeeper),
 		NewTxListenerDecorator(options.PendingTxListener),
 	}
 
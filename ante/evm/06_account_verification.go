@@ -1,12 +1,15 @@
 package evm
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	"github.com/cosmos/evm/x/vm/keeper"
 	"github.com/cosmos/evm/x/vm/statedb"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -27,6 +30,59 @@ func VerifyAccountBalance(
 	account *statedb.Account,
 	from common.Address,
 	ethTx *ethtypes.Transaction,
+) error {
+	return verifyAccountBalance(ctx, evmKeeper, accountKeeper, account, from, ethTx, false)
+}
+
+// VerifyAccountBalanceWithFeeToken performs the same checks as VerifyAccountBalance
+// for transactions whose fees are paid with a whitelisted fee token, in which
+// case the account balance only needs to cover the transaction value.
+func VerifyAccountBalanceWithFeeToken(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	ethTx *ethtypes.Transaction,
+) error {
+	return verifyAccountBalance(ctx, evmKeeper, accountKeeper, account, from, ethTx, true)
+}
+
+// SelectFeeTokenPayment returns the whitelisted fee token used to pay for the
+// transaction fees. The EVM denom is always preferred, so a fee token is only
+// selected when the account balance cannot cover the total transaction cost.
+func SelectFeeTokenPayment(
+	ctx sdk.Context,
+	feeTokenKeeper anteinterfaces.FeeTokenKeeper,
+	account *statedb.Account,
+	from common.Address,
+	ethTx *ethtypes.Transaction,
+) (evmtypes.FeeTokenPayment, bool) {
+	if feeTokenKeeper == nil {
+		return evmtypes.FeeTokenPayment{}, false
+	}
+
+	cost := ethTx.Cost()
+	if account != nil && account.Balance.ToBig().Cmp(cost) >= 0 {
+		return evmtypes.FeeTokenPayment{}, false
+	}
+
+	maxFees := new(big.Int).Sub(cost, ethTx.Value())
+	if maxFees.Sign() <= 0 {
+		return evmtypes.FeeTokenPayment{}, false
+	}
+
+	return feeTokenKeeper.GetFeeTokenPayment(ctx, from.Bytes(), maxFees)
+}
+
+func verifyAccountBalance(
+	ctx sdk.Context,
+	evmKeeper anteinterfaces.EVMKeeper,
+	accountKeeper anteinterfaces.AccountKeeper,
+	account *statedb.Account,
+	from common.Address,
+	ethTx *ethtypes.Transaction,
+	payWithFeeToken bool,
 ) error {
 	// Only EOA are allowed to send transactions.
 	if account != nil && account.HasCodeHash() {
@@ -46,7 +102,19 @@ func VerifyAccountBalance(
 		account = statedb.NewEmptyAccount()
 	}
 
-	if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(account.Balance.ToBig()), ethTx); err != nil {
+	balance := sdkmath.NewIntFromBigInt(account.Balance.ToBig())
+	if payWithFeeToken {
+		// the fees are deducted from the fee token balance
+		if balance.BigInt().Cmp(ethTx.Value()) < 0 {
+			return errorsmod.Wrapf(
+				errortypes.ErrInsufficientFunds,
+				"failed to check sender balance: sender balance < tx value (%s < %s)", balance, ethTx.Value(),
+			)
+		}
+		return nil
+	}
+
+	if err := keeper.CheckSenderBalance(balance, ethTx); err != nil {
 		return errorsmod.Wrap(err, "failed to check sender balance")
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/params"
 
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 	cosmosevmtypes "github.com/cosmos/evm/ante/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -25,7 +26,9 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params) authante.TxFeeChecker {
+// - when the fee is paid with a fee token whitelisted in the optional `feeTokenKeeper`,
+// the fee is converted to the EVM denom before applying the logic above.
+func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params, feeTokenKeeper anteinterfaces.FeeTokenKeeper) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
 		if !ok {
@@ -40,6 +43,14 @@ func NewDynamicFeeChecker(feemarketParams *feemarkettypes.Params) authante.TxFee
 		denom := evmtypes.GetEVMCoinDenom()
 		ethCfg := evmtypes.GetEthChainConfig()
 
+		if feeTokenKeeper != nil {
+			if fees := feeTx.GetFee(); len(fees) == 1 && fees[0].Denom != denom {
+				if price, err := feeTokenKeeper.GetFeeTokenPrice(ctx, fees[0].Denom); err == nil {
+					return FeeTokenChecker(ctx, feemarketParams, fees[0].Denom, price, ethCfg, feeTx)
+				}
+			}
+		}
+
 		return FeeChecker(ctx, feemarketParams, denom, ethCfg, feeTx)
 	}
 }
@@ -57,6 +68,61 @@ func FeeChecker(
 		return checkTxFeeWithValidatorMinGasPrices(ctx, feeTx)
 	}
 
+	feeAmt := feeTx.GetFee().AmountOfNoDenomValidation(denom) //nolint:staticcheck // TODO: fix
+	effectiveFee, priority, err := dynamicFee(ctx, feemarketParams, denom, feeTx, feeAmt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// NOTE: create a new coins slice without having to validate the denom
+	return sdk.Coins{{Denom: denom, Amount: effectiveFee}}, priority, nil
+}
+
+// FeeTokenChecker returns the effective fee and priority for a transaction that
+// pays for the fees with a whitelisted fee token. The fee is converted to the
+// EVM denom using the given price to apply the fee market logic and the
+// effective fee is converted back to the fee token, rounding up.
+func FeeTokenChecker(
+	ctx sdk.Context,
+	feemarketParams *feemarkettypes.Params,
+	feeDenom string,
+	price sdkmath.LegacyDec,
+	ethConfig *params.ChainConfig,
+	feeTx sdk.FeeTx,
+) (sdk.Coins, int64, error) {
+	if !evmtypes.IsLondon(ethConfig, ctx.BlockHeight()) {
+		// london hardfork is not enabled: fallback to min-gas-prices logic
+		return checkTxFeeWithValidatorMinGasPrices(ctx, feeTx)
+	}
+
+	if !price.IsPositive() {
+		return nil, 0, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid price for fee token %s: %s", feeDenom, price)
+	}
+
+	feeTokenAmt := feeTx.GetFee().AmountOf(feeDenom)
+	feeAmt := sdkmath.LegacyNewDecFromInt(feeTokenAmt).Mul(price).TruncateInt()
+	effectiveFee, priority, err := dynamicFee(ctx, feemarketParams, evmtypes.GetEVMCoinDenom(), feeTx, feeAmt)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	effectiveFeeTokenAmt := sdkmath.MinInt(
+		sdkmath.LegacyNewDecFromInt(effectiveFee).Quo(price).Ceil().TruncateInt(),
+		feeTokenAmt,
+	)
+
+	return sdk.Coins{{Denom: feeDenom, Amount: effectiveFeeTokenAmt}}, priority, nil
+}
+
+// dynamicFee returns the effective fee amount and priority of a transaction
+// paying the given fee amount in the EVM denom, using the EIP-1559 logic.
+func dynamicFee(
+	ctx sdk.Context,
+	feemarketParams *feemarkettypes.Params,
+	denom string,
+	feeTx sdk.FeeTx,
+	feeAmt sdkmath.Int,
+) (sdkmath.Int, int64, error) {
 	baseFee := feemarketParams.BaseFee
 	// if baseFee is not enabled, consider it 0
 	// so the DynamicFeeTx logic can be applied
@@ -82,33 +148,26 @@ func FeeChecker(
 
 	// priority fee cannot be negative
 	if maxPriorityPrice.IsNegative() {
-		return nil, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "max priority price cannot be negative")
+		return sdkmath.Int{}, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "max priority price cannot be negative")
 	}
 
 	gas := sdkmath.NewIntFromUint64(feeTx.GetGas())
 
 	if gas.IsZero() {
-		return nil, 0, errorsmod.Wrap(errortypes.ErrInvalidRequest, "gas cannot be zero")
+		return sdkmath.Int{}, 0, errorsmod.Wrap(errortypes.ErrInvalidRequest, "gas cannot be zero")
 	}
 
-	feeCoins := feeTx.GetFee()
-	feeAmtDec := sdkmath.LegacyNewDecFromInt(feeCoins.AmountOfNoDenomValidation(denom)) //nolint:staticcheck // TODO: fix
+	feeAmtDec := sdkmath.LegacyNewDecFromInt(feeAmt)
 
 	feeCap := feeAmtDec.QuoInt(gas)
 	if feeCap.LT(baseFee) {
-		return nil, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "gas prices too low, got: %s%s required: %s%s. Please retry using a higher gas price or a higher fee", feeCap, denom, baseFee, denom)
+		return sdkmath.Int{}, 0, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "gas prices too low, got: %s%s required: %s%s. Please retry using a higher gas price or a higher fee", feeCap, denom, baseFee, denom)
 	}
 
 	// calculate the effective gas price using the EIP-1559 logic.
 	effectivePrice := effectiveGasPriceLegacyDec(baseFee, feeCap, maxPriorityPrice)
 
-	// NOTE: create a new coins slice without having to validate the denom
-	effectiveFee := sdk.Coins{
-		{
-			Denom:  denom,
-			Amount: effectivePrice.MulInt(gas).Ceil().RoundInt(),
-		},
-	}
+	effectiveFee := effectivePrice.MulInt(gas).Ceil().RoundInt()
 	priorityInt := effectivePrice.Sub(baseFee).QuoInt(evmtypes.DefaultPriorityReduction).TruncateInt()
 	priority := int64(math.MaxInt64)
 
@@ -1,6 +1,7 @@
 package evm_test
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -258,7 +259,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 				cfg.LondonBlock = big.NewInt(0)
 			}
 			feemarketParams := tc.feemarketParamsFn()
-			fees, priority, err := evm.NewDynamicFeeChecker(&feemarketParams)(tc.ctx, tc.buildTx())
+			fees, priority, err := evm.NewDynamicFeeChecker(&feemarketParams, nil)(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
@@ -268,3 +269,97 @@ func TestSDKTxFeeChecker(t *testing.T) {
 		})
 	}
 }
+
+// mockFeeTokenKeeper whitelists a single fee token with a fixed price.
+type mockFeeTokenKeeper struct {
+	denom string
+	price math.LegacyDec
+}
+
+func (m mockFeeTokenKeeper) GetFeeTokenPrice(_ sdk.Context, denom string) (math.LegacyDec, error) {
+	if denom != m.denom {
+		return math.LegacyDec{}, fmt.Errorf("%s is not a whitelisted fee token", denom)
+	}
+	return m.price, nil
+}
+
+func (m mockFeeTokenKeeper) GetFeeTokenPayment(_ sdk.Context, _ sdk.AccAddress, _ *big.Int) (evmtypes.FeeTokenPayment, bool) {
+	return evmtypes.FeeTokenPayment{Denom: m.denom, Price: m.price}, true
+}
+
+func TestSDKTxFeeCheckerWithFeeToken(t *testing.T) {
+	chainID := uint64(testconstants.EighteenDecimalsChainID)
+	encodingConfig := encoding.MakeConfig(chainID) //nolint:staticcheck // this is used
+
+	configurator := evmtypes.NewEVMConfigurator()
+	configurator.ResetTestConfig()
+	ethCfg := evmtypes.DefaultChainConfig(chainID)
+	require.NoError(t, evmtypes.SetChainConfig(ethCfg))
+	err := configurator.
+		WithExtendedEips(evmtypes.DefaultCosmosEVMActivators).
+		WithEVMCoinInfo(testconstants.ChainsCoinInfo[chainID]).
+		Configure()
+	require.NoError(t, err)
+
+	feeDenom := "ibc/fee"
+	// one unit of the fee token is worth two units of the EVM denom
+	feeTokenKeeper := mockFeeTokenKeeper{denom: feeDenom, price: math.LegacyNewDec(2)}
+	deliverTxCtx := sdk.NewContext(nil, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+	feemarketParams := feemarkettypes.Params{BaseFee: math.LegacyNewDec(10)}
+
+	testCases := []struct {
+		name        string
+		fees        sdk.Coins
+		expFees     string
+		expPriority int64
+		expSuccess  bool
+	}{
+		{
+			"success, fee token converted to the EVM denom",
+			sdk.NewCoins(sdk.NewCoin(feeDenom, math.NewInt(5))),
+			"5ibc/fee",
+			0,
+			true,
+		},
+		{
+			"success, fee token priority",
+			sdk.NewCoins(sdk.NewCoin(feeDenom, math.NewInt(5).Add(evmtypes.DefaultPriorityReduction))),
+			"1000005ibc/fee",
+			2,
+			true,
+		},
+		{
+			"fail, fee token value below the base fee",
+			sdk.NewCoins(sdk.NewCoin(feeDenom, math.NewInt(4))),
+			"",
+			0,
+			false,
+		},
+		{
+			"fail, not whitelisted token is not converted",
+			sdk.NewCoins(sdk.NewCoin("ibc/other", math.NewInt(100))),
+			"",
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evmtypes.GetEthChainConfig().LondonBlock = big.NewInt(0)
+
+			txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+			txBuilder.SetGasLimit(1)
+			txBuilder.SetFeeAmount(tc.fees)
+
+			fees, priority, err := evm.NewDynamicFeeChecker(&feemarketParams, feeTokenKeeper)(deliverTxCtx, txBuilder.GetTx())
+			if tc.expSuccess {
+				require.NoError(t, err)
+				require.Equal(t, tc.expFees, fees.String())
+				require.Equal(t, tc.expPriority, priority)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ type MonoDecorator struct {
 	maxGasWanted    uint64
 	evmParams       *evmtypes.Params
 	feemarketParams *feemarkettypes.Params
+	feeTokenKeeper  anteinterfaces.FeeTokenKeeper
 }
 
 // NewEVMMonoDecorator creates the 'mono' decorator, that is used to run the ante handle logic
@@ -62,6 +63,14 @@ func NewEVMMonoDecorator(
 	}
 }
 
+// WithFeeTokenKeeper enables paying for the transaction fees with the fee
+// tokens whitelisted in the given keeper when the sender balance of the EVM
+// denom is not enough to cover them.
+func (md MonoDecorator) WithFeeTokenKeeper(feeTokenKeeper anteinterfaces.FeeTokenKeeper) MonoDecorator {
+	md.feeTokenKeeper = feeTokenKeeper
+	return md
+}
+
 // AnteHandle handles the entire decorator chain using a mono decorator.
 func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// 0. Basic validation of the transaction
@@ -76,8 +85,6 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		}
 	}
 
-	evmDenom := evmtypes.GetEVMCoinDenom()
-
 	// 1. setup ctx
 	ctx, err = SetupContextAndResetTransientGas(ctx, tx)
 	if err != nil {
@@ -90,15 +97,63 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
-	// NOTE: the protocol does not support multiple EVM messages currently so
-	// this loop will complete after the first message.
+	// A transaction contains a single EVM message, unless batching is enabled
+	// in the EVM params. The messages of a batch are checked one after the
+	// other, so that they can be sent by the same account with consecutive
+	// nonces, and their fees and gas limits are aggregated.
 	msgs := tx.GetMsgs()
-	if len(msgs) != 1 {
-		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "expected 1 message, got %d", len(msgs))
+	if len(msgs) == 0 {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "expected at least 1 message, got 0")
+	}
+	isBatch := len(msgs) > 1
+	if isBatch {
+		if !decUtils.EvmParams.IsBatchEnabled(1) {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "expected 1 message, got %d", len(msgs))
+		}
+		if !decUtils.EvmParams.IsBatchEnabled(len(msgs)) {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"expected at most %d messages in a batch, got %d", decUtils.EvmParams.MaxBatchMsgs, len(msgs),
+			)
+		}
+		ctx = evmtypes.ContextWithEthBatch(ctx)
+	}
+
+	for msgIndex, msg := range msgs {
+		ctx, err = md.anteHandleMsg(ctx, msg, isBatch, decUtils, simulate)
+		if err != nil {
+			if isBatch {
+				return ctx, errorsmod.Wrapf(err, "batch message %d", msgIndex)
+			}
+			return ctx, err
+		}
 	}
-	msgIndex := 0
 
-	ethMsg, ethTx, err := evmtypes.UnpackEthMsg(msgs[msgIndex])
+	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
+		return ctx, err
+	}
+
+	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
+	if err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// anteHandleMsg runs the checks of a single EVM message of the transaction,
+// deducts its fees, increments the sender nonce and updates the aggregated
+// values of the decorator utils.
+func (md MonoDecorator) anteHandleMsg(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	isBatch bool,
+	decUtils *DecoratorUtils,
+	simulate bool,
+) (sdk.Context, error) {
+	evmDenom := evmtypes.GetEVMCoinDenom()
+
+	ethMsg, ethTx, err := evmtypes.UnpackEthMsg(msg)
 	if err != nil {
 		return ctx, err
 	}
@@ -179,7 +234,24 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// using a wrapper of the bank keeper as a dependency to scale all
 	// balances to 18 decimals.
 	account := md.evmKeeper.GetAccount(ctx, fromAddr)
-	if err := VerifyAccountBalance(
+	// The fees of a batch can only be paid with the EVM denom, since a single
+	// fee token payment is recorded for the transaction.
+	feeTokenKeeper := md.feeTokenKeeper
+	if isBatch {
+		feeTokenKeeper = nil
+	}
+	feePayment, payWithFeeToken := SelectFeeTokenPayment(
+		ctx,
+		feeTokenKeeper,
+		account,
+		fromAddr,
+		ethTx,
+	)
+	verifyBalance := VerifyAccountBalance
+	if payWithFeeToken {
+		verifyBalance = VerifyAccountBalanceWithFeeToken
+	}
+	if err := verifyBalance(
 		ctx,
 		md.evmKeeper,
 		md.accountKeeper,
@@ -217,6 +289,16 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		return ctx, err
 	}
 
+	if payWithFeeToken && !msgFees.IsZero() {
+		// charge the fees in the fee token, rounding up in favor of the fee
+		// collector, and record it for the gas refund after the execution
+		msgFees = sdk.Coins{sdk.NewCoin(
+			feePayment.Denom,
+			feePayment.ConvertFrom18Decimals(msgFees.AmountOf(evmDenom).BigInt(), true),
+		)}
+		ctx = evmtypes.ContextWithFeeTokenPayment(ctx, feePayment)
+	}
+
 	err = ConsumeFeesAndEmitEvent(
 		ctx,
 		md.evmKeeper,
@@ -268,14 +350,5 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// Emit event unconditionally - ctx.TxIndex() will be valid during block execution
 	EmitTxHashEvent(ctx, ethMsg, uint64(ctx.TxIndex())) // #nosec G115 -- no overlfow here
 
-	if err := CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
-		return ctx, err
-	}
-
-	ctx, err = CheckBlockGasLimit(ctx, decUtils.GasWanted, decUtils.MinPriority)
-	if err != nil {
-		return ctx, err
-	}
-
-	return next(ctx, tx, simulate)
+	return ctx, nil
 }
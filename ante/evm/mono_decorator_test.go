@@ -83,15 +83,22 @@ func (m MockFeeMarketKeeper) GetBaseFee(_ sdk.Context) math.LegacyDec { return m
 // matches the actual signatures
 type MockAccountKeeper struct {
 	FundedAddr sdk.AccAddress
+	// Sequences records the sequences of the accounts set, if not nil
+	Sequences map[string]uint64
 }
 
 func (m MockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
 	if m.FundedAddr != nil && addr.Equals(m.FundedAddr) {
-		return &authtypes.BaseAccount{Address: addr.String()}
+		return &authtypes.BaseAccount{Address: addr.String(), Sequence: m.Sequences[addr.String()]}
 	}
 	return nil
 }
-func (m MockAccountKeeper) SetAccount(_ context.Context, _ sdk.AccountI) {}
+
+func (m MockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	if m.Sequences != nil {
+		m.Sequences[acc.GetAddress().String()] = acc.GetSequence()
+	}
+}
 func (m MockAccountKeeper) NewAccountWithAddress(_ context.Context, _ sdk.AccAddress) sdk.AccountI {
 	return nil
 }
@@ -144,15 +151,30 @@ func TestMonoDecorator(t *testing.T) {
 	chainID := uint64(constants.EighteenDecimalsChainID)
 	cfg := encoding.MakeConfig(chainID)
 
+	buildMsgs := func(privKey *ethsecp256k1.PrivKey, count int) []*evmsdktypes.MsgEthereumTx {
+		msgs := make([]*evmsdktypes.MsgEthereumTx, count)
+		for i := range msgs {
+			msgs[i] = signMsgEthereumTx(t, privKey, &evmsdktypes.EvmTxArgs{
+				Nonce:    uint64(i),
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1),
+				Input:    []byte("test"),
+			})
+		}
+		return msgs
+	}
+
 	testCases := []struct {
-		name      string
-		simulate  bool
-		buildMsgs func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx
-		expErr    string
+		name         string
+		simulate     bool
+		maxBatchMsgs uint64
+		buildMsgs    func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx
+		expErr       string
 	}{
 		{
 			"success with one evm tx",
 			true,
+			0,
 			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
 				args := &evmsdktypes.EvmTxArgs{
 					Nonce:    0,
@@ -167,6 +189,7 @@ func TestMonoDecorator(t *testing.T) {
 		{
 			"failure with two evm txs",
 			true,
+			0,
 			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
 				args1 := &evmsdktypes.EvmTxArgs{
 					Nonce:    0,
@@ -187,6 +210,24 @@ func TestMonoDecorator(t *testing.T) {
 			},
 			"expected 1 message, got 2",
 		},
+		{
+			"success with a batch of evm txs",
+			true,
+			3,
+			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
+				return buildMsgs(privKey, 3)
+			},
+			"",
+		},
+		{
+			"failure with a batch exceeding the max batch msgs",
+			true,
+			2,
+			func(privKey *ethsecp256k1.PrivKey) []*evmsdktypes.MsgEthereumTx {
+				return buildMsgs(privKey, 3)
+			},
+			"expected at most 2 messages in a batch, got 3",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -210,9 +251,10 @@ func TestMonoDecorator(t *testing.T) {
 			require.NoError(t, err)
 			privKey, _ := ethsecp256k1.GenerateKey()
 			keeper, cosmosAddr := setupFundedKeeper(t, privKey)
-			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr}
+			accountKeeper := MockAccountKeeper{FundedAddr: cosmosAddr, Sequences: map[string]uint64{}}
 			feeMarketKeeper := MockFeeMarketKeeper{}
 			params := keeper.GetParams(sdk.Context{})
+			params.MaxBatchMsgs = tc.maxBatchMsgs
 			feemarketParams := feeMarketKeeper.GetParams(sdk.Context{})
 			monoDec := evm.NewEVMMonoDecorator(accountKeeper, feeMarketKeeper, keeper, 0, &params, &feemarketParams)
 			ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
@@ -232,6 +274,7 @@ func TestMonoDecorator(t *testing.T) {
 			if tc.expErr == "" {
 				require.NoError(t, err)
 				require.NotNil(t, newCtx)
+				require.Equal(t, len(msgs) > 1, evmsdktypes.IsEthBatch(newCtx))
 			} else {
 				require.ErrorContains(t, err, tc.expErr)
 			}
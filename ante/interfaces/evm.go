@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
@@ -11,6 +13,8 @@ import (
 	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
@@ -26,6 +30,13 @@ type EVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
 }
 
+// FeeTokenKeeper exposes the required interface to pay transaction fees with
+// whitelisted fee tokens instead of the EVM denom.
+type FeeTokenKeeper interface {
+	GetFeeTokenPrice(ctx sdk.Context, denom string) (sdkmath.LegacyDec, error)
+	GetFeeTokenPayment(ctx sdk.Context, payer sdk.AccAddress, fees *big.Int) (evmtypes.FeeTokenPayment, bool)
+}
+
 // FeeMarketKeeper exposes the required feemarket keeper interface required for ante handlers
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
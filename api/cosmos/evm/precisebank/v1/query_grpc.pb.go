@@ -19,14 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Remainder_FullMethodName         = "/cosmos.evm.precisebank.v1.Query/Remainder"
-	Query_FractionalBalance_FullMethodName = "/cosmos.evm.precisebank.v1.Query/FractionalBalance"
+	Query_Params_FullMethodName                  = "/cosmos.evm.precisebank.v1.Query/Params"
+	Query_TotalFractionalBalances_FullMethodName = "/cosmos.evm.precisebank.v1.Query/TotalFractionalBalances"
+	Query_Remainder_FullMethodName               = "/cosmos.evm.precisebank.v1.Query/Remainder"
+	Query_FractionalBalance_FullMethodName       = "/cosmos.evm.precisebank.v1.Query/FractionalBalance"
 )
 
 // QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params returns the precisebank module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TotalFractionalBalances returns the sum of all fractional balances managed
+	// by the module.
+	TotalFractionalBalances(ctx context.Context, in *QueryTotalFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryTotalFractionalBalancesResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error)
@@ -43,6 +50,24 @@ func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalFractionalBalances(ctx context.Context, in *QueryTotalFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryTotalFractionalBalancesResponse, error) {
+	out := new(QueryTotalFractionalBalancesResponse)
+	err := c.cc.Invoke(ctx, Query_TotalFractionalBalances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error) {
 	out := new(QueryRemainderResponse)
 	err := c.cc.Invoke(ctx, Query_Remainder_FullMethodName, in, out, opts...)
@@ -65,6 +90,11 @@ func (c *queryClient) FractionalBalance(ctx context.Context, in *QueryFractional
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
+	// Params returns the precisebank module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TotalFractionalBalances returns the sum of all fractional balances managed
+	// by the module.
+	TotalFractionalBalances(context.Context, *QueryTotalFractionalBalancesRequest) (*QueryTotalFractionalBalancesResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error)
@@ -78,6 +108,12 @@ type QueryServer interface {
 type UnimplementedQueryServer struct {
 }
 
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) TotalFractionalBalances(context.Context, *QueryTotalFractionalBalancesRequest) (*QueryTotalFractionalBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalFractionalBalances not implemented")
+}
 func (UnimplementedQueryServer) Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Remainder not implemented")
 }
@@ -97,6 +133,42 @@ func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
 	s.RegisterService(&Query_ServiceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalFractionalBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalFractionalBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalFractionalBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalFractionalBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalFractionalBalances(ctx, req.(*QueryTotalFractionalBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Remainder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRemainderRequest)
 	if err := dec(in); err != nil {
@@ -140,6 +212,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.precisebank.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "TotalFractionalBalances",
+			Handler:    _Query_TotalFractionalBalances_Handler,
+		},
 		{
 			MethodName: "Remainder",
 			Handler:    _Query_Remainder_Handler,
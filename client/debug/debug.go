@@ -13,15 +13,22 @@ import (
 
 	"github.com/cosmos/evm/ethereum/eip712"
 
+	txsigning "cosmossdk.io/x/tx/signing"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	cosmosclientdebug "github.com/cosmos/cosmos-sdk/client/debug"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
-var flagPrefix = "prefix"
+var (
+	flagPrefix        = "prefix"
+	flagAccountNumber = "account-number"
+	flagSequence      = "sequence"
+)
 
 // Cmd creates a main CLI command
 func Cmd() *cobra.Command {
@@ -42,6 +49,7 @@ func Cmd() *cobra.Command {
 		AddrCmd(),
 		RawBytesCmd(),
 		LegacyEIP712Cmd(),
+		EIP712Cmd(),
 	)
 
 	return cmd
@@ -204,3 +212,72 @@ func LegacyEIP712Cmd() *cobra.Command {
 		},
 	}
 }
+
+// EIP712Cmd outputs the EIP-712 typed data signed with the EIP-712 sign mode
+// for the given transaction
+func EIP712Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eip712 [file] [evm-chain-id]",
+		Short: "Output the eip712 typed data signed with the eip712 sign mode according to the given transaction",
+		Long: `Output the eip712 typed data generated from the proto definitions of the transaction messages,
+which is signed by the eip712 sign mode. Any registered message is supported.`,
+		Example: fmt.Sprintf(`$ %s debug eip712 tx.json 4221 --chain-id evmd-1 --account-number 1 --sequence 0`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			stdTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return errors.Wrap(err, "read tx from file")
+			}
+
+			evmChainID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "parse evm-chain-id")
+			}
+
+			accountNumber, err := cmd.Flags().GetUint64(flagAccountNumber)
+			if err != nil {
+				return err
+			}
+			sequence, err := cmd.Flags().GetUint64(flagSequence)
+			if err != nil {
+				return err
+			}
+
+			adaptableTx, ok := stdTx.(authsigning.V2AdaptableTx)
+			if !ok {
+				return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", stdTx)
+			}
+
+			handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{
+				EVMChainID:   evmChainID,
+				FileResolver: clientCtx.InterfaceRegistry,
+			})
+			signerData := txsigning.SignerData{
+				ChainID:       clientCtx.ChainID,
+				AccountNumber: accountNumber,
+				Sequence:      sequence,
+			}
+			td, err := handler.GetTypedData(signerData, adaptableTx.GetSigningTxData())
+			if err != nil {
+				return errors.Wrap(err, "wrap tx to typed data")
+			}
+
+			bz, err := json.MarshalIndent(td, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagAccountNumber, 0, "The account number of the signer")
+	cmd.Flags().Uint64(flagSequence, 0, "The sequence of the signer")
+	return cmd
+}
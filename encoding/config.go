@@ -54,10 +54,16 @@ func MakeConfig(evmChainID uint64) Config {
 	// the deprecated method legacytx.StdSignBytes
 	legacytx.RegressionTestingAminoCodec = cdc
 
+	// enable signing any message with EIP-712 typed data generated from its proto definition
+	eip712SignModeHandler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{
+		EVMChainID:   evmChainID,
+		FileResolver: interfaceRegistry,
+	})
+
 	return Config{
 		InterfaceRegistry: interfaceRegistry,
 		Codec:             codec,
-		TxConfig:          tx.NewTxConfig(codec, tx.DefaultSignModes),
+		TxConfig:          tx.NewTxConfig(codec, tx.DefaultSignModes, eip712SignModeHandler),
 		Amino:             cdc,
 	}
 }
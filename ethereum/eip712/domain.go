@@ -5,6 +5,30 @@ import (
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
+// domainTypes are the EIP-712 types of the typed data domain.
+var domainTypes = []apitypes.Type{
+	{
+		Name: "name",
+		Type: "string",
+	},
+	{
+		Name: "version",
+		Type: "string",
+	},
+	{
+		Name: "chainId",
+		Type: "uint256",
+	},
+	{
+		Name: "verifyingContract",
+		Type: "string",
+	},
+	{
+		Name: "salt",
+		Type: "string",
+	},
+}
+
 // createEIP712Domain creates the typed data domain for the given chainID.
 func createEIP712Domain(chainID uint64) apitypes.TypedDataDomain {
 	domain := apitypes.TypedDataDomain{
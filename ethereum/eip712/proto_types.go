@@ -0,0 +1,388 @@
+package eip712
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/tx/signing"
+
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	anyTypePrefix = "Any"
+
+	anyFullName       = "google.protobuf.Any"
+	timestampFullName = "google.protobuf.Timestamp"
+	durationFullName  = "google.protobuf.Duration"
+
+	// maxProtoTypeDepth is the maximum nesting of the messages, which bounds
+	// the recursive proto definitions.
+	maxProtoTypeDepth = 32
+)
+
+// typedDataBuilder generates the EIP-712 types and values of proto messages
+// using proto reflection, so that any registered message can be represented,
+// including the ones nesting other messages in Any fields.
+//
+// The messages are represented as structs with all the fields of their proto
+// definitions, in the order of their declaration. The integers are encoded as
+// decimal strings, the bytes as hex strings, and the enums, floats, timestamps
+// and durations as strings. The Any fields are represented as a struct with the
+// type URL and the value of the packed message.
+type typedDataBuilder struct {
+	fileResolver signing.ProtoFileResolver
+	typeResolver signing.TypeResolver
+	types        apitypes.Types
+}
+
+func newTypedDataBuilder(fileResolver signing.ProtoFileResolver, typeResolver signing.TypeResolver) *typedDataBuilder {
+	return &typedDataBuilder{
+		fileResolver: fileResolver,
+		typeResolver: typeResolver,
+		types: apitypes.Types{
+			"EIP712Domain": domainTypes,
+		},
+	}
+}
+
+// txMessage returns the typed data message of the transaction, adding the
+// types of the transaction, its fee and its messages to the builder types.
+func (b *typedDataBuilder) txMessage(signerData signing.SignerData, body *txv1beta1.TxBody, fee *txv1beta1.Fee) (apitypes.TypedDataMessage, error) {
+	if len(body.Messages) == 0 {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, "transaction does not contain any messages")
+	}
+
+	feeType, feeValue, err := b.messageValue(fee.ProtoReflect(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	txTypes := []apitypes.Type{
+		{Name: "account_number", Type: "uint64"},
+		{Name: "chain_id", Type: ethString},
+		{Name: "fee", Type: feeType},
+		{Name: "memo", Type: ethString},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "timeout_height", Type: "uint64"},
+	}
+	message := apitypes.TypedDataMessage{
+		"account_number": strconv.FormatUint(signerData.AccountNumber, 10),
+		"chain_id":       signerData.ChainID,
+		"fee":            feeValue,
+		"memo":           body.Memo,
+		"sequence":       strconv.FormatUint(signerData.Sequence, 10),
+		"timeout_height": strconv.FormatUint(body.TimeoutHeight, 10),
+	}
+
+	// the messages are flattened as msg{i} fields, to support messages of
+	// different types
+	for i, msg := range body.Messages {
+		msgType, msgValue, err := b.anyValue(msg.ProtoReflect(), 0)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "message %d", i)
+		}
+		field := msgFieldForIndex(i)
+		txTypes = append(txTypes, apitypes.Type{Name: field, Type: msgType})
+		message[field] = msgValue
+	}
+
+	b.types[txField] = txTypes
+	return message, nil
+}
+
+// messageValue adds the type of the message to the builder types, and returns
+// its name and the value of the message.
+func (b *typedDataBuilder) messageValue(msg protoreflect.Message, depth int) (string, map[string]interface{}, error) {
+	if depth > maxProtoTypeDepth {
+		return "", nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "message %s exceeds the maximum nesting depth", msg.Descriptor().FullName())
+	}
+
+	desc := msg.Descriptor()
+	if desc.FullName() == anyFullName {
+		return b.anyValue(msg, depth)
+	}
+
+	fields := desc.Fields()
+	types := make([]apitypes.Type, 0, fields.Len())
+	value := make(map[string]interface{}, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+
+		var (
+			fieldType  string
+			fieldValue interface{}
+			err        error
+		)
+		switch {
+		case fd.IsMap():
+			fieldType, fieldValue, err = b.mapValue(msg.Get(fd).Map(), fd, depth)
+		case fd.IsList():
+			fieldType, fieldValue, err = b.listValue(msg.Get(fd).List(), fd, depth)
+		case fd.Message() != nil && !msg.Has(fd):
+			// the unset messages are represented with their zero values
+			fieldType, fieldValue, err = b.singularValue(msg.NewField(fd), fd, depth)
+		default:
+			fieldType, fieldValue, err = b.singularValue(msg.Get(fd), fd, depth)
+		}
+		if err != nil {
+			return "", nil, errorsmod.Wrapf(err, "field %s", fd.FullName())
+		}
+
+		types = append(types, apitypes.Type{Name: string(fd.Name()), Type: fieldType})
+		value[string(fd.Name())] = fieldValue
+	}
+
+	typeName, err := addTypesToRoot(b.types, sanitizeTypedef(string(desc.FullName())), types)
+	if err != nil {
+		return "", nil, err
+	}
+	return typeName, value, nil
+}
+
+// anyValue unpacks the message of the Any and returns the type and the value
+// of the Any, containing its type URL and the packed message.
+func (b *typedDataBuilder) anyValue(msg protoreflect.Message, depth int) (string, map[string]interface{}, error) {
+	fields := msg.Descriptor().Fields()
+	typeURL := msg.Get(fields.ByName("type_url")).String()
+	bz := msg.Get(fields.ByName("value")).Bytes()
+
+	// the type of an Any without type URL is unknown, so it is represented
+	// with its raw value
+	if typeURL == "" {
+		typeName, err := addTypesToRoot(b.types, anyTypePrefix, []apitypes.Type{
+			{Name: "type_url", Type: ethString},
+			{Name: "value", Type: "bytes"},
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return typeName, map[string]interface{}{"type_url": typeURL, "value": hexutil.Encode(bz)}, nil
+	}
+
+	packed, err := b.unpackAny(typeURL, bz)
+	if err != nil {
+		return "", nil, err
+	}
+
+	packedType, packedValue, err := b.messageValue(packed, depth+1)
+	if err != nil {
+		return "", nil, err
+	}
+
+	typeName, err := addTypesToRoot(b.types, anyTypePrefix+packedType, []apitypes.Type{
+		{Name: "type_url", Type: ethString},
+		{Name: "value", Type: packedType},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return typeName, map[string]interface{}{"type_url": typeURL, "value": packedValue}, nil
+}
+
+// unpackAny returns the message packed in an Any, which is dynamic if its
+// type is not registered.
+func (b *typedDataBuilder) unpackAny(typeURL string, bz []byte) (protoreflect.Message, error) {
+	var msg protoreflect.Message
+	if typ, err := b.typeResolver.FindMessageByURL(typeURL); err == nil {
+		msg = typ.New()
+	} else {
+		name := typeURL
+		if i := strings.LastIndexByte(typeURL, '/'); i >= 0 {
+			name = typeURL[i+1:]
+		}
+		desc, err := b.fileResolver.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "can't resolve type URL %s", typeURL)
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "type URL %s is not a message", typeURL)
+		}
+		msg = dynamicpb.NewMessage(msgDesc)
+	}
+
+	if err := protov2.Unmarshal(bz, msg.Interface()); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to unmarshal %s", typeURL)
+	}
+	return msg, nil
+}
+
+// listValue returns the type and the value of a repeated field. Since the
+// elements of the EIP-712 arrays must have the same type, the lists with
+// elements of different types, such as Anys packing different messages, are
+// represented as structs with a {field}{i} field per element.
+func (b *typedDataBuilder) listValue(list protoreflect.List, fd protoreflect.FieldDescriptor, depth int) (string, interface{}, error) {
+	// the type of an empty list is the one of its zero element
+	if list.Len() == 0 {
+		elemType, _, err := b.singularValue(list.NewElement(), fd, depth)
+		if err != nil {
+			return "", nil, err
+		}
+		return elemType + "[]", []interface{}{}, nil
+	}
+
+	homogeneous := true
+	itemTypes := make([]string, list.Len())
+	items := make([]interface{}, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		itemType, item, err := b.singularValue(list.Get(i), fd, depth)
+		if err != nil {
+			return "", nil, err
+		}
+		itemTypes[i], items[i] = itemType, item
+		homogeneous = homogeneous && itemType == itemTypes[0]
+	}
+	if homogeneous {
+		return itemTypes[0] + "[]", items, nil
+	}
+
+	types := make([]apitypes.Type, len(items))
+	value := make(map[string]interface{}, len(items))
+	for i, item := range items {
+		field := fmt.Sprintf("%s%d", fd.Name(), i)
+		types[i] = apitypes.Type{Name: field, Type: itemTypes[i]}
+		value[field] = item
+	}
+
+	typeName, err := addTypesToRoot(b.types, sanitizeTypedef(string(fd.FullName())), types)
+	if err != nil {
+		return "", nil, err
+	}
+	return typeName, value, nil
+}
+
+// mapValue returns the type and the value of a map field, which is
+// represented as a list of key-value entries sorted by key.
+func (b *typedDataBuilder) mapValue(m protoreflect.Map, fd protoreflect.FieldDescriptor, depth int) (string, []interface{}, error) {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		return mapKeyLess(keys[i], keys[j])
+	})
+
+	entryValue := func(key, value protoreflect.Value) (string, map[string]interface{}, error) {
+		keyType, keyValue, err := b.singularValue(key, fd.MapKey(), depth)
+		if err != nil {
+			return "", nil, err
+		}
+		valueType, valueValue, err := b.singularValue(value, fd.MapValue(), depth)
+		if err != nil {
+			return "", nil, err
+		}
+		entryType, err := addTypesToRoot(b.types, sanitizeTypedef(string(fd.Message().FullName())), []apitypes.Type{
+			{Name: "key", Type: keyType},
+			{Name: "value", Type: valueType},
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return entryType, map[string]interface{}{"key": keyValue, "value": valueValue}, nil
+	}
+
+	// the type of an empty map is the one of its zero entry
+	if len(keys) == 0 {
+		entryType, _, err := entryValue(fd.MapKey().Default(), m.NewValue())
+		if err != nil {
+			return "", nil, err
+		}
+		return entryType + "[]", []interface{}{}, nil
+	}
+
+	var entryType string
+	entries := make([]interface{}, len(keys))
+	for i, key := range keys {
+		itemType, entry, err := entryValue(key.Value(), m.Get(key))
+		if err != nil {
+			return "", nil, err
+		}
+		if i > 0 && itemType != entryType {
+			return "", nil, errorsmod.Wrapf(
+				errortypes.ErrInvalidType,
+				"map field contains values of different types %s and %s", entryType, itemType,
+			)
+		}
+		entryType = itemType
+		entries[i] = entry
+	}
+	return entryType + "[]", entries, nil
+}
+
+// singularValue returns the EIP-712 type and value of a single value of the
+// given field.
+func (b *typedDataBuilder) singularValue(v protoreflect.Value, fd protoreflect.FieldDescriptor, depth int) (string, interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return ethBool, v.Bool(), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32", strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return ethInt64, strconv.FormatInt(v.Int(), 10), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32", strconv.FormatUint(v.Uint(), 10), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64", strconv.FormatUint(v.Uint(), 10), nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return ethString, strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	case protoreflect.StringKind:
+		return ethString, v.String(), nil
+	case protoreflect.BytesKind:
+		return "bytes", hexutil.Encode(v.Bytes()), nil
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(v.Enum()); enumValue != nil {
+			return ethString, string(enumValue.Name()), nil
+		}
+		return ethString, strconv.FormatInt(int64(v.Enum()), 10), nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.nestedMessageValue(v.Message(), depth+1)
+	default:
+		return "", nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "unsupported field kind %s", fd.Kind())
+	}
+}
+
+// nestedMessageValue returns the EIP-712 type and value of a message field,
+// representing the timestamps and durations as strings.
+func (b *typedDataBuilder) nestedMessageValue(msg protoreflect.Message, depth int) (string, interface{}, error) {
+	fields := msg.Descriptor().Fields()
+	switch msg.Descriptor().FullName() {
+	case timestampFullName:
+		seconds := msg.Get(fields.ByName("seconds")).Int()
+		nanos := msg.Get(fields.ByName("nanos")).Int()
+		return ethString, time.Unix(seconds, nanos).UTC().Format(time.RFC3339Nano), nil
+	case durationFullName:
+		seconds := msg.Get(fields.ByName("seconds")).Int()
+		nanos := msg.Get(fields.ByName("nanos")).Int()
+		return ethString, (time.Duration(seconds)*time.Second + time.Duration(nanos)).String(), nil
+	default:
+		return b.messageValue(msg, depth)
+	}
+}
+
+// mapKeyLess orders the map keys, which are either booleans, integers or
+// strings.
+func mapKeyLess(a, b protoreflect.MapKey) bool {
+	switch x := a.Interface().(type) {
+	case bool:
+		return !x && b.Bool()
+	case int32, int64:
+		return a.Int() < b.Int()
+	case uint32, uint64:
+		return a.Uint() < b.Uint()
+	default:
+		return fmt.Sprint(x) < b.String()
+	}
+}
@@ -0,0 +1,112 @@
+package eip712
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/signing"
+)
+
+// SignModeEIP712 is the sign mode of the transactions signed over the EIP-712
+// typed data generated from the proto definitions of their messages. It allows
+// signing any registered message with an Ethereum wallet, including the ones
+// without an amino registration.
+const SignModeEIP712 = signingv1beta1.SignMode(712)
+
+// SignModeHandlerOptions are the options of the EIP-712 sign mode handler.
+type SignModeHandlerOptions struct {
+	// EVMChainID is the chain ID of the EIP-712 domain.
+	EVMChainID uint64
+	// FileResolver resolves the descriptors of the messages packed in an Any.
+	// Defaults to the gogoproto hybrid resolver.
+	FileResolver signing.ProtoFileResolver
+	// TypeResolver resolves the types of the messages packed in an Any.
+	// Defaults to the global protobuf registry.
+	TypeResolver signing.TypeResolver
+}
+
+// SignModeHandler implements the EIP-712 sign mode, whose sign bytes are the
+// EIP-712 encoding of the transaction typed data. Their Keccak256 hash is the
+// digest signed by the Ethereum wallets with eth_signTypedData_v4, which is the
+// one checked by the eth_secp256k1 public keys.
+type SignModeHandler struct {
+	evmChainID   uint64
+	fileResolver signing.ProtoFileResolver
+	typeResolver signing.TypeResolver
+}
+
+var _ signing.SignModeHandler = SignModeHandler{}
+
+// NewSignModeHandler returns a new EIP-712 sign mode handler.
+func NewSignModeHandler(options SignModeHandlerOptions) SignModeHandler {
+	h := SignModeHandler{
+		evmChainID:   options.EVMChainID,
+		fileResolver: options.FileResolver,
+		typeResolver: options.TypeResolver,
+	}
+	if h.fileResolver == nil {
+		h.fileResolver = proto.HybridResolver
+	}
+	if h.typeResolver == nil {
+		h.typeResolver = protoregistry.GlobalTypes
+	}
+	return h
+}
+
+// Mode implements the signing.SignModeHandler interface.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return SignModeEIP712
+}
+
+// GetSignBytes implements the signing.SignModeHandler interface.
+func (h SignModeHandler) GetSignBytes(_ context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	typedData, err := h.GetTypedData(signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rawData, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("could not get EIP-712 object bytes: %w", err)
+	}
+
+	return []byte(rawData), nil
+}
+
+// GetTypedData returns the EIP-712 typed data signed by the given signer for
+// the transaction.
+func (h SignModeHandler) GetTypedData(signerData signing.SignerData, txData signing.TxData) (apitypes.TypedData, error) {
+	body, authInfo := txData.Body, txData.AuthInfo
+	if body == nil || authInfo == nil || authInfo.Fee == nil {
+		return apitypes.TypedData{}, errors.New("transaction body, auth info and fee are required")
+	}
+
+	// the fields that are not part of the typed data would not be signed
+	if len(body.ExtensionOptions) != 0 || len(body.NonCriticalExtensionOptions) != 0 ||
+		body.Unordered || body.TimeoutTimestamp != nil || authInfo.Tip != nil { //nolint:staticcheck // deprecated tips are rejected
+		return apitypes.TypedData{}, errors.New("transaction contains unsupported fields: ExtensionOptions, NonCriticalExtensionOptions, Unordered, TimeoutTimestamp or Tip")
+	}
+	if _, err := decode.RejectUnknownFields(txData.BodyBytes, body.ProtoReflect().Descriptor(), false, h.fileResolver); err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	builder := newTypedDataBuilder(h.fileResolver, h.typeResolver)
+	message, err := builder.txMessage(signerData, body, authInfo.Fee)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	return apitypes.TypedData{
+		Types:       builder.types,
+		PrimaryType: txField,
+		Domain:      createEIP712Domain(h.evmChainID),
+		Message:     message,
+	}, nil
+}
@@ -0,0 +1,219 @@
+package eip712_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/encoding"
+	"github.com/cosmos/evm/ethereum/eip712"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var signerData = txsigning.SignerData{
+	ChainID:       "cosmos-1",
+	AccountNumber: 1,
+	Sequence:      2,
+}
+
+func newSignModeTestConfig(t *testing.T) (encoding.Config, eip712.SignModeHandler) {
+	t.Helper()
+	config := encoding.MakeConfig(chainID)
+	for _, register := range []func(codectypes.InterfaceRegistry){
+		authz.RegisterInterfaces,
+		banktypes.RegisterInterfaces,
+		distrtypes.RegisterInterfaces,
+		feegrant.RegisterInterfaces,
+		govv1.RegisterInterfaces,
+		slashingtypes.RegisterInterfaces,
+		stakingtypes.RegisterInterfaces,
+		erc20types.RegisterInterfaces,
+		feemarkettypes.RegisterInterfaces,
+		precisebanktypes.RegisterInterfaces,
+		evmtypes.RegisterInterfaces,
+	} {
+		register(config.InterfaceRegistry)
+	}
+
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{
+		EVMChainID:   chainID,
+		FileResolver: config.InterfaceRegistry,
+	})
+	return config, handler
+}
+
+func getSigningTxData(t *testing.T, config encoding.Config, msgs ...sdk.Msg) txsigning.TxData {
+	t.Helper()
+	txBuilder := config.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(2000))))
+	txBuilder.SetMemo("memo")
+
+	adaptableTx, ok := txBuilder.GetTx().(authsigning.V2AdaptableTx)
+	require.True(t, ok)
+	return adaptableTx.GetSigningTxData()
+}
+
+func TestSignModeHandlerRegisteredMsgs(t *testing.T) {
+	config, handler := newSignModeTestConfig(t)
+
+	typeURLs := config.InterfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName)
+	require.NotEmpty(t, typeURLs)
+
+	for _, typeURL := range typeURLs {
+		t.Run(typeURL, func(t *testing.T) {
+			msg, err := config.InterfaceRegistry.Resolve(typeURL)
+			require.NoError(t, err)
+
+			typedData, err := handler.GetTypedData(signerData, getSigningTxData(t, config, msg))
+			require.NoError(t, err)
+
+			_, _, err = apitypes.TypedDataAndHash(typedData)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSignModeHandlerGetTypedData(t *testing.T) {
+	config, handler := newSignModeTestConfig(t)
+
+	msgSend := banktypes.NewMsgSend(
+		sdk.AccAddress("from"),
+		sdk.AccAddress("to"),
+		sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(10))),
+	)
+	msgDelegate := stakingtypes.NewMsgDelegate(
+		sdk.AccAddress("from").String(),
+		sdk.ValAddress("validator").String(),
+		sdk.NewCoin("atest", math.NewInt(10)),
+	)
+	msgExec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{msgSend, msgDelegate})
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		validate func(typedData apitypes.TypedData)
+	}{
+		{
+			"single message",
+			[]sdk.Msg{msgSend},
+			func(typedData apitypes.TypedData) {
+				require.Equal(t, "2", typedData.Message["sequence"])
+				require.Equal(t, "memo", typedData.Message["memo"])
+
+				msg := typedData.Message["msg0"].(map[string]interface{})
+				require.Equal(t, sdk.MsgTypeURL(msgSend), msg["type_url"])
+				value := msg["value"].(map[string]interface{})
+				require.Equal(t, msgSend.FromAddress, value["from_address"])
+				require.Equal(t, []interface{}{map[string]interface{}{"denom": "atest", "amount": "10"}}, value["amount"])
+			},
+		},
+		{
+			"messages of different types",
+			[]sdk.Msg{msgSend, msgDelegate},
+			func(typedData apitypes.TypedData) {
+				require.Contains(t, typedData.Message, "msg0")
+				require.Contains(t, typedData.Message, "msg1")
+			},
+		},
+		{
+			"nested messages of different types",
+			[]sdk.Msg{&msgExec},
+			func(typedData apitypes.TypedData) {
+				msg := typedData.Message["msg0"].(map[string]interface{})
+				msgs := msg["value"].(map[string]interface{})["msgs"].(map[string]interface{})
+				require.Contains(t, msgs, "msgs0")
+				require.Contains(t, msgs, "msgs1")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			typedData, err := handler.GetTypedData(signerData, getSigningTxData(t, config, tc.msgs...))
+			require.NoError(t, err)
+
+			_, _, err = apitypes.TypedDataAndHash(typedData)
+			require.NoError(t, err)
+
+			tc.validate(typedData)
+		})
+	}
+}
+
+func TestSignModeHandlerGetSignBytes(t *testing.T) {
+	config, handler := newSignModeTestConfig(t)
+
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	key, err := privKey.ToECDSA()
+	require.NoError(t, err)
+
+	msgSend := banktypes.NewMsgSend(
+		sdk.AccAddress(privKey.PubKey().Address()),
+		sdk.AccAddress("to"),
+		sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(10))),
+	)
+	txData := getSigningTxData(t, config, msgSend)
+
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	// the wallets sign the hash of the typed data
+	typedData, err := handler.GetTypedData(signerData, txData)
+	require.NoError(t, err)
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+
+	signature, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(signBytes, signature))
+
+	// the signature is bound to the signer data
+	otherSignerData := signerData
+	otherSignerData.Sequence++
+	otherSignBytes, err := handler.GetSignBytes(context.Background(), otherSignerData, txData)
+	require.NoError(t, err)
+	require.False(t, privKey.PubKey().VerifySignature(otherSignBytes, signature))
+}
+
+func TestSignModeHandlerUnsupportedFields(t *testing.T) {
+	config, handler := newSignModeTestConfig(t)
+
+	msgSend := banktypes.NewMsgSend(
+		sdk.AccAddress("from"),
+		sdk.AccAddress("to"),
+		sdk.NewCoins(sdk.NewCoin("atest", math.NewInt(10))),
+	)
+
+	txData := getSigningTxData(t, config, msgSend)
+	txData.Body.Unordered = true
+	_, err := handler.GetTypedData(signerData, txData)
+	require.ErrorContains(t, err, "unsupported fields")
+
+	txData = getSigningTxData(t, config)
+	_, err = handler.GetTypedData(signerData, txData)
+	require.ErrorContains(t, err, "does not contain any messages")
+}
@@ -38,28 +38,7 @@ const (
 // for the given message payload.
 func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, error) {
 	eip712Types := apitypes.Types{
-		"EIP712Domain": {
-			{
-				Name: "name",
-				Type: "string",
-			},
-			{
-				Name: "version",
-				Type: "string",
-			},
-			{
-				Name: "chainId",
-				Type: "uint256",
-			},
-			{
-				Name: "verifyingContract",
-				Type: "string",
-			},
-			{
-				Name: "salt",
-				Type: "string",
-			},
-		},
+		"EIP712Domain": domainTypes,
 		"Tx": {
 			{Name: "account_number", Type: "string"},
 			{Name: "chain_id", Type: "string"},
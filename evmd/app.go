@@ -21,6 +21,7 @@ import (
 	antetypes "github.com/cosmos/evm/ante/types"
 	evmencoding "github.com/cosmos/evm/encoding"
 	evmaddress "github.com/cosmos/evm/encoding/address"
+	"github.com/cosmos/evm/ethereum/eip712"
 	evmconfig "github.com/cosmos/evm/evmd/config"
 	evmmempool "github.com/cosmos/evm/mempool"
 	precompiletypes "github.com/cosmos/evm/precompiles/types"
@@ -35,6 +36,7 @@ import (
 	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	ibccallbackskeeper "github.com/cosmos/evm/x/ibc/callbacks/keeper"
+	ibccallbacksevmv2 "github.com/cosmos/evm/x/ibc/callbacks/v2"
 	"github.com/cosmos/evm/x/ibc/transfer"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	transferv2 "github.com/cosmos/evm/x/ibc/transfer/v2"
@@ -46,6 +48,7 @@ import (
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	"github.com/cosmos/gogoproto/proto"
 	ibccallbacks "github.com/cosmos/ibc-go/v10/modules/apps/callbacks"
+	ibccallbacksv2 "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/v2"
 	ibctransfer "github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v10/modules/core"
@@ -68,6 +71,7 @@ import (
 	"cosmossdk.io/x/feegrant"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
+	txsigning "cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/upgrade"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
@@ -308,6 +312,13 @@ func NewExampleApp(
 	txConfigOpts := authtx.ConfigOptions{
 		EnabledSignModes:           enabledSignModes,
 		TextualCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper),
+		// enable signing any message with EIP-712 typed data generated from its proto definition
+		CustomSignModes: []txsigning.SignModeHandler{
+			eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{
+				EVMChainID:   evmChainID,
+				FileResolver: interfaceRegistry,
+			}),
+		},
 	}
 	txConfig, err := authtx.NewTxConfigWithOptions(
 		appCodec,
@@ -444,6 +455,8 @@ func NewExampleApp(
 		keys[precisebanktypes.StoreKey],
 		app.BankKeeper,
 		app.AccountKeeper,
+		&app.Erc20Keeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
 	// Set up EVM keeper
@@ -475,7 +488,7 @@ func NewExampleApp(
 			app.SlashingKeeper,
 			appCodec,
 		),
-	)
+	).WithDistributionKeeper(app.DistrKeeper)
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
@@ -514,6 +527,10 @@ func NewExampleApp(
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
 			channel.RecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> transfer.OnRecvPacket
+
+		The IBC v2 transfer stack mirrors it with the v2 callbacks and ERC-20 middlewares,
+		sharing the same EVM ContractKeeper. It is wrapped by the EVM v2 callbacks middleware,
+		which passes the payload encoding and the packet timeout on to the ContractKeeper.
 	*/
 
 	// create IBC module from top to bottom of stack
@@ -532,9 +549,21 @@ func NewExampleApp(
 	callbacksMiddleware.SetUnderlyingApplication(transferStack)
 	transferStack = callbacksMiddleware
 
+	// route outgoing packets through the callbacks middleware so that
+	// source callbacks are notified on send
+	app.TransferKeeper.WithICS4Wrapper(callbacksMiddleware)
+
 	var transferStackV2 ibcapi.IBCModule
 	transferStackV2 = transferv2.NewIBCModule(app.TransferKeeper)
 	transferStackV2 = erc20v2.NewIBCMiddleware(transferStackV2, app.Erc20Keeper)
+	transferStackV2 = ibccallbacksv2.NewIBCMiddleware(
+		transferStackV2,
+		app.IBCKeeper.ChannelKeeperV2,
+		app.CallbackKeeper,
+		app.IBCKeeper.ChannelKeeperV2,
+		maxCallbackGas,
+	)
+	transferStackV2 = ibccallbacksevmv2.NewIBCMiddleware(transferStackV2)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -793,6 +822,7 @@ func (app *EVMD) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
 		MaxTxGasWanted:         maxGasWanted,
 		DynamicFeeChecker:      true,
 		PendingTxListener:      app.onPendingTx,
+		FeeTokenKeeper:         app.Erc20Keeper,
 	}
 	if err := options.Validate(); err != nil {
 		panic(err)
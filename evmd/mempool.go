@@ -66,5 +66,9 @@ func (app *EVMD) createMempoolConfig(appOpts servertypes.AppOptions, logger log.
 		LegacyPoolConfig: server.GetLegacyPoolConfig(appOpts, logger),
 		BlockGasLimit:    server.GetBlockGasLimit(appOpts, logger),
 		MinTip:           server.GetMinTip(appOpts, logger),
+		FeeTokenKeeper:   app.Erc20Keeper,
+		// queue the Cosmos transactions with a future sequence
+		AccountKeeper:     app.AccountKeeper,
+		CosmosQueueConfig: server.GetCosmosQueueConfig(appOpts, logger),
 	}, nil
 }
@@ -1,13 +1,11 @@
 package ibc
 
 import (
-	"errors"
 	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/cosmos/evm"
 	"github.com/cosmos/evm/contracts"
@@ -16,8 +14,6 @@ import (
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 
-	errorsmod "cosmossdk.io/errors"
-
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -114,29 +110,10 @@ func SetupNativeErc20(t *testing.T, chain *evmibctesting.TestChain, senderAcc ev
 	}
 }
 
-// SetupNativeErc20 deploys, registers, and mints a native ERC20 token on an EVM-based chain.
+// DeployContract deploys the contract with the given deployment data from the
+// default sender of the chain and returns its address.
 func DeployContract(t *testing.T, chain *evmibctesting.TestChain, deploymentData testutiltypes.ContractDeploymentData) (common.Address, error) {
 	t.Helper()
 
-	// Get account's nonce to create contract hash
-	from := common.BytesToAddress(chain.SenderPrivKey.PubKey().Address().Bytes())
-	account := chain.App.(evm.EvmApp).GetEVMKeeper().GetAccount(chain.GetContext(), from)
-	if account == nil {
-		return common.Address{}, errors.New("account not found")
-	}
-
-	ctorArgs, err := deploymentData.Contract.ABI.Pack("", deploymentData.ConstructorArgs...)
-	if err != nil {
-		return common.Address{}, errorsmod.Wrap(err, "failed to pack constructor arguments")
-	}
-
-	data := deploymentData.Contract.Bin
-	data = append(data, ctorArgs...)
-
-	_, err = chain.App.(evm.EvmApp).GetEVMKeeper().CallEVMWithData(chain.GetContext(), from, nil, data, true, nil)
-	if err != nil {
-		return common.Address{}, errorsmod.Wrapf(err, "failed to deploy contract")
-	}
-
-	return crypto.CreateAddress(from, account.Nonce), nil
+	return chain.DeployContract(deploymentData)
 }
@@ -0,0 +1,216 @@
+package ibc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ForwardTestSuite tests the forwarding of ICS20 packets with
+// packet-forward-middleware style memos through the EVM chain.
+type ForwardTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	evmChainA *evmibctesting.TestChain
+	chainB    *evmibctesting.TestChain
+	chainC    *evmibctesting.TestChain
+
+	// pathBToA.EndpointA = endpoint on chainB
+	// pathBToA.EndpointB = endpoint on evmChainA
+	pathBToA *evmibctesting.Path
+	// pathAToC.EndpointA = endpoint on evmChainA
+	// pathAToC.EndpointB = endpoint on chainC
+	pathAToC *evmibctesting.Path
+}
+
+func (suite *ForwardTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 2, integration.SetupEvmd)
+	suite.evmChainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(evmibctesting.GetChainID(3))
+
+	suite.pathBToA = evmibctesting.NewTransferPath(suite.chainB, suite.evmChainA)
+	suite.pathBToA.Setup()
+	suite.pathAToC = evmibctesting.NewTransferPath(suite.evmChainA, suite.chainC)
+	suite.pathAToC.Setup()
+}
+
+func TestForwardTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(ForwardTestSuite))
+}
+
+// sendForwardPacket sends the bond denom of chainB to evmChainA with the given
+// forward memo and receives it on evmChainA.
+func (suite *ForwardTestSuite) sendForwardPacket(memo string) (channeltypes.Packet, sdk.Coin, []byte, channeltypes.Packet) {
+	chainBApp := suite.chainB.GetSimApp()
+	bondDenom, err := chainBApp.StakingKeeper.BondDenom(suite.chainB.GetContext())
+	suite.Require().NoError(err)
+	coin := sdk.NewCoin(bondDenom, evmibctesting.DefaultCoinAmount)
+
+	msg := transfertypes.NewMsgTransfer(
+		suite.pathBToA.EndpointA.ChannelConfig.PortID,
+		suite.pathBToA.EndpointA.ChannelID,
+		coin,
+		suite.chainB.SenderAccount.GetAddress().String(),
+		erc20types.ForwardReceiverPlaceholder,
+		suite.chainB.GetTimeoutHeight(), 0, memo,
+	)
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.pathBToA.EndpointB.UpdateClient())
+	res, err = suite.pathBToA.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the packet is acknowledged synchronously only if it could not be forwarded
+	ack, err := evmibctesting.ParseAckFromEvents(res.Events)
+	if err == nil {
+		return packet, coin, ack, channeltypes.Packet{}
+	}
+
+	forwardedPacket, err := evmibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	return packet, coin, nil, forwardedPacket
+}
+
+func (suite *ForwardTestSuite) forwardMemo(extra string) string {
+	return fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"%s","channel":"%s"%s}}`,
+		suite.chainC.SenderAccount.GetAddress().String(),
+		suite.pathAToC.EndpointA.ChannelConfig.PortID,
+		suite.pathAToC.EndpointA.ChannelID,
+		extra,
+	)
+}
+
+func (suite *ForwardTestSuite) TestForwardPacket() {
+	packet, coin, ack, forwardedPacket := suite.sendForwardPacket(suite.forwardMemo(""))
+	suite.Require().Nil(ack, "packet should be acknowledged asynchronously")
+
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	evmCtx := suite.evmChainA.GetContext()
+	_, found := evmApp.Erc20Keeper.GetInFlightPacket(evmCtx, forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+	suite.Require().True(found)
+
+	// the tokens are forwarded without being held by the forwarder
+	voucherDenom := transfertypes.NewDenom(coin.Denom, transfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel))
+	forwarder := erc20types.GetForwardReceiver(packet.DestinationChannel, suite.chainB.SenderAccount.GetAddress().String())
+	suite.Require().True(evmApp.BankKeeper.GetBalance(evmCtx, forwarder, voucherDenom.IBCDenom()).IsZero())
+
+	// relay the forwarded packet to chainC and its acknowledgement back to evmChainA
+	suite.Require().NoError(suite.pathAToC.EndpointB.UpdateClient())
+	res, err := suite.pathAToC.EndpointB.RecvPacketWithResult(forwardedPacket)
+	suite.Require().NoError(err)
+	forwardedAck, err := evmibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	res, err = suite.pathAToC.EndpointA.AcknowledgePacketWithResult(forwardedPacket, forwardedAck)
+	suite.Require().NoError(err)
+	ack, err = evmibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+
+	_, found = evmApp.Erc20Keeper.GetInFlightPacket(suite.evmChainA.GetContext(), forwardedPacket.SourcePort, forwardedPacket.SourceChannel, forwardedPacket.Sequence)
+	suite.Require().False(found)
+
+	// acknowledge the original packet on chainB
+	suite.Require().NoError(suite.pathBToA.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.pathBToA.EndpointA.AcknowledgePacket(packet, ack))
+
+	// the receiver on chainC holds the tokens
+	chainCDenom := transfertypes.NewDenom(
+		coin.Denom,
+		transfertypes.NewHop(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel),
+		transfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel),
+	)
+	chainCBalance := suite.chainC.GetSimApp().BankKeeper.GetBalance(
+		suite.chainC.GetContext(),
+		suite.chainC.SenderAccount.GetAddress(),
+		chainCDenom.IBCDenom(),
+	)
+	suite.Require().Equal(coin.Amount, chainCBalance.Amount)
+}
+
+func (suite *ForwardTestSuite) TestForwardPacketFailure() {
+	testCases := []struct {
+		name string
+		memo string
+	}{
+		{
+			"invalid forward metadata",
+			`{"forward":{"receiver":"","port":"transfer","channel":"channel-0"}}`,
+		},
+		{
+			"forward channel does not exist",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-100"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			packet, coin, ack, _ := suite.sendForwardPacket(tc.memo)
+			suite.Require().NotNil(ack)
+
+			var acknowledgement channeltypes.Acknowledgement
+			suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+			suite.Require().False(acknowledgement.Success())
+
+			suite.Require().NoError(suite.pathBToA.EndpointA.UpdateClient())
+			suite.Require().NoError(suite.pathBToA.EndpointA.AcknowledgePacket(packet, ack))
+			suite.requireRefunded(packet, coin)
+		})
+	}
+}
+
+func (suite *ForwardTestSuite) TestForwardPacketTimeout() {
+	packet, coin, ack, forwardedPacket := suite.sendForwardPacket(suite.forwardMemo(`,"timeout":"1m"`))
+	suite.Require().Nil(ack)
+
+	// time out the forwarded packet on evmChainA
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.chainC)
+	suite.Require().NoError(suite.pathAToC.EndpointA.UpdateClient())
+	res, err := suite.pathAToC.EndpointA.TimeoutPacketWithResult(forwardedPacket)
+	suite.Require().NoError(err)
+
+	ack, err = evmibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	var acknowledgement channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+	suite.Require().False(acknowledgement.Success())
+
+	// the vouchers minted on evmChainA are burned
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	voucherDenom := transfertypes.NewDenom(coin.Denom, transfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel))
+	suite.Require().True(evmApp.BankKeeper.GetSupply(suite.evmChainA.GetContext(), voucherDenom.IBCDenom()).IsZero())
+
+	suite.Require().NoError(suite.pathBToA.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.pathBToA.EndpointA.AcknowledgePacket(packet, ack))
+	suite.requireRefunded(packet, coin)
+}
+
+// requireRefunded checks that the tokens escrowed on chainB to send the packet
+// have been refunded.
+func (suite *ForwardTestSuite) requireRefunded(packet channeltypes.Packet, coin sdk.Coin) {
+	escrowAddress := transfertypes.GetEscrowAddress(packet.SourcePort, packet.SourceChannel)
+	escrowBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, coin.Denom)
+	suite.Require().True(escrowBalance.IsZero())
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/evm/ibc"
 	"github.com/cosmos/evm/testutil"
 	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	utiltx "github.com/cosmos/evm/testutil/tx"
 	testutiltypes "github.com/cosmos/evm/testutil/types"
 	"github.com/cosmos/evm/x/erc20"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
@@ -416,6 +417,200 @@ func (suite *MiddlewareTestSuite) TestOnRecvPacketWithCallback() {
 	}
 }
 
+// TestOnRecvPacketWithHook checks the OnRecvPacket logic for ICS-20 with destination
+// callbacks executed as IBC hooks, which send the unused tokens to a fallback address.
+func (suite *MiddlewareTestSuite) TestOnRecvPacketWithHook() {
+	var (
+		contractData  evmtypes.CompiledContract
+		contractAddr  common.Address
+		erc20Contract common.Address
+		isolatedAddr  sdk.AccAddress
+	)
+
+	sendAmt := ibctesting.DefaultCoinAmount
+	fallbackAddr := utiltx.GenerateAddress()
+
+	hookMemo := func(contract common.Address, calldata []byte, fallback string) string {
+		return fmt.Sprintf(`{
+			"dest_callback": {
+				"address": "%s",
+				"gas_limit": "%d",
+				"calldata": "%x",
+				"fallback_address": "%s"
+			}
+		}`, contract, 1_000_000, calldata, fallback)
+	}
+
+	testCases := []struct {
+		name           string
+		memo           func() string
+		expError       string
+		expHookSuccess bool
+		expContractAmt math.Int
+	}{
+		{
+			name: "success - contract uses part of the tokens",
+			memo: func() string {
+				packedBytes, _ := contractData.ABI.Pack("add", erc20Contract, sendAmt.QuoRaw(4).BigInt())
+				return hookMemo(contractAddr, packedBytes, fallbackAddr.Hex())
+			},
+			expHookSuccess: true,
+			expContractAmt: sendAmt.QuoRaw(4),
+		},
+		{
+			name: "success - contract uses all the tokens with a bech32 fallback address",
+			memo: func() string {
+				packedBytes, _ := contractData.ABI.Pack("add", erc20Contract, sendAmt.BigInt())
+				return hookMemo(contractAddr, packedBytes, sdk.AccAddress(fallbackAddr.Bytes()).String())
+			},
+			expHookSuccess: true,
+			expContractAmt: sendAmt,
+		},
+		{
+			name: "success - contract doesn't use the tokens",
+			memo: func() string {
+				packedBytes, _ := contractData.ABI.Pack("getCounter")
+				return hookMemo(contractAddr, packedBytes, fallbackAddr.Hex())
+			},
+			expHookSuccess: true,
+			expContractAmt: math.ZeroInt(),
+		},
+		{
+			name: "success - failed call sends the tokens to the fallback address",
+			memo: func() string {
+				return hookMemo(contractAddr, []byte{0xff, 0xff, 0xff, 0xff}, fallbackAddr.Hex())
+			},
+			expHookSuccess: false,
+			expContractAmt: math.ZeroInt(),
+		},
+		{
+			name: "failure - invalid fallback address",
+			memo: func() string {
+				packedBytes, _ := contractData.ABI.Pack("getCounter")
+				return hookMemo(contractAddr, packedBytes, "not_an_address")
+			},
+			expError: "ABCI code: 10",
+		},
+		{
+			name: "failure - zero fallback address",
+			memo: func() string {
+				packedBytes, _ := contractData.ABI.Pack("getCounter")
+				return hookMemo(contractAddr, packedBytes, common.Address{}.Hex())
+			},
+			expError: "ABCI code: 10",
+		},
+		{
+			name: "failure - fallback address is the isolated address",
+			memo: func() string {
+				packedBytes, _ := contractData.ABI.Pack("getCounter")
+				return hookMemo(contractAddr, packedBytes, isolatedAddr.String())
+			},
+			expError: "ABCI code: 10",
+		},
+		{
+			name: "failure - calldata without function selector",
+			memo: func() string {
+				return hookMemo(contractAddr, nil, fallbackAddr.Hex())
+			},
+			expError: "ABCI code: 3",
+		},
+		{
+			name: "failure - hook to non-existent contract",
+			memo: func() string {
+				packedBytes, _ := contractData.ABI.Pack("getCounter")
+				return hookMemo(utiltx.GenerateAddress(), packedBytes, fallbackAddr.Hex())
+			},
+			expError: "ABCI code: 4",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path := suite.path
+
+			evmCtx := suite.evmChainA.GetContext()
+			bondDenom, err := suite.chainB.GetSimApp().StakingKeeper.BondDenom(suite.chainB.GetContext())
+			suite.Require().NoError(err)
+
+			isolatedAddr = callbacktypes.GenerateIsolatedAddress(path.EndpointA.ChannelID, suite.chainB.SenderAccount.GetAddress().String())
+
+			contractData, err = ibctestutil.LoadCounterWithCallbacksContract()
+			suite.Require().NoError(err)
+			contractAddr, err = DeployContract(suite.T(), suite.evmChainA, testutiltypes.ContractDeploymentData{Contract: contractData})
+			suite.Require().NoError(err)
+
+			packetData := transfertypes.NewFungibleTokenPacketData(
+				bondDenom,
+				sendAmt.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				isolatedAddr.String(),
+				"",
+			)
+			sourceChan := path.EndpointB.GetChannel()
+			data, err := transfertypes.UnmarshalPacketData(packetData.GetBytes(), sourceChan.Version, "")
+			suite.Require().NoError(err)
+
+			voucherDenom := testutil.GetVoucherDenomFromPacketData(data, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			tokenPair, err := types.NewTokenPairSTRv2(voucherDenom)
+			suite.Require().NoError(err)
+			erc20Contract = tokenPair.GetERC20Contract()
+
+			packetData.Memo = tc.memo()
+			packet := channeltypes.Packet{
+				Sequence:           1,
+				SourcePort:         path.EndpointB.ChannelConfig.PortID,
+				SourceChannel:      path.EndpointB.ChannelID,
+				DestinationPort:    path.EndpointA.ChannelConfig.PortID,
+				DestinationChannel: path.EndpointA.ChannelID,
+				Data:               packetData.GetBytes(),
+				TimeoutHeight:      suite.evmChainA.GetTimeoutHeight(),
+				TimeoutTimestamp:   0,
+			}
+
+			transferStack, ok := suite.evmChainA.App.GetIBCKeeper().PortKeeper.Route(transfertypes.ModuleName)
+			suite.Require().True(ok)
+
+			ack := transferStack.OnRecvPacket(evmCtx, sourceChan.Version, packet, suite.evmChainA.SenderAccount.GetAddress())
+
+			evmApp := suite.evmChainA.App.(*evmd.EVMD)
+			erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			contractBal := evmApp.Erc20Keeper.BalanceOf(evmCtx, erc20ABI, erc20Contract, contractAddr)
+			fallbackBal := evmApp.Erc20Keeper.BalanceOf(evmCtx, erc20ABI, erc20Contract, fallbackAddr)
+			isolatedBal := evmApp.Erc20Keeper.BalanceOf(evmCtx, erc20ABI, erc20Contract, common.BytesToAddress(isolatedAddr))
+
+			if tc.expError != "" {
+				suite.Require().False(ack.Success(), "Expected failure but got success")
+				ackObj, ok := ack.(channeltypes.Acknowledgement)
+				suite.Require().True(ok)
+				ackErr, ok := ackObj.Response.(*channeltypes.Acknowledgement_Error)
+				suite.Require().True(ok)
+				suite.Require().Contains(ackErr.Error, tc.expError)
+
+				suite.Require().Equal("0", contractBal.String())
+				suite.Require().Equal("0", fallbackBal.String())
+				return
+			}
+
+			suite.Require().True(ack.Success(), "Expected success but got failure")
+			suite.Require().Equal(tc.expContractAmt.String(), contractBal.String())
+			suite.Require().Equal(sendAmt.Sub(tc.expContractAmt).String(), fallbackBal.String())
+			suite.Require().Equal("0", isolatedBal.String())
+
+			var hookEvent *sdk.Event
+			for _, event := range evmCtx.EventManager().Events() {
+				if event.Type == callbacktypes.EventTypeHook {
+					hookEvent = &event
+				}
+			}
+			suite.Require().NotNil(hookEvent)
+			success, found := hookEvent.GetAttribute(callbacktypes.AttributeKeySuccess)
+			suite.Require().True(found)
+			suite.Require().Equal(fmt.Sprint(tc.expHookSuccess), success.Value)
+		})
+	}
+}
+
 // TestNewIBCMiddleware verifies the middleware instantiation logic.
 func (suite *MiddlewareTestSuite) TestNewIBCMiddleware() {
 	testCases := []struct {
@@ -855,6 +1050,7 @@ func (suite *MiddlewareTestSuite) TestOnAcknowledgementPacketWithCallback() {
 		memo           func() string
 		ackType        string // "success" or "error"
 		onSendRequired bool
+		expSentPackets int64
 		expError       string
 	}{
 		// SUCCESS CASES
@@ -903,6 +1099,23 @@ func (suite *MiddlewareTestSuite) TestOnAcknowledgementPacketWithCallback() {
 			onSendRequired: true,
 			expError:       "",
 		},
+		{
+			name:     "success - callback with send callback enabled",
+			malleate: nil,
+			memo: func() string {
+				return fmt.Sprintf(`{
+					"src_callback": {
+						"address": "%s",
+						"gas_limit": "%d",
+						"send_callback": true
+					}
+				}`, contractAddr, 1_000_000)
+			},
+			ackType:        "success",
+			onSendRequired: true,
+			expSentPackets: 1,
+			expError:       "",
+		},
 		{
 			name:     "success - no callback in memo (regular transfer)",
 			malleate: nil,
@@ -1235,6 +1448,25 @@ func (suite *MiddlewareTestSuite) TestOnAcknowledgementPacketWithCallback() {
 					suite.Require().True(counter.Cmp(big.NewInt(1)) >= 0, "Counter should be incremented by callback")
 				}
 
+				// Verify send callback execution by checking the sent packets count
+				if tc.expSentPackets != 0 {
+					sentRes, err := evmApp.EVMKeeper.CallEVM(
+						ctxA,
+						contractData.ABI,
+						common.BytesToAddress(suite.evmChainA.SenderAccount.GetAddress()),
+						contractAddr,
+						false,
+						big.NewInt(100000),
+						"sentPackets",
+					)
+					suite.Require().NoError(err)
+
+					var sentPackets *big.Int
+					err = contractData.ABI.UnpackIntoInterface(&sentPackets, "sentPackets", sentRes.Ret)
+					suite.Require().NoError(err)
+					suite.Require().Equal(big.NewInt(tc.expSentPackets).String(), sentPackets.String(), "Send callback should be executed")
+				}
+
 				// Verify refund for error acknowledgements
 				if tc.ackType == "error" && tc.onSendRequired {
 					escrowAddr := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
@@ -0,0 +1,174 @@
+package ibc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RateLimitTestSuite tests the IBC rate limits of the erc20 module on the
+// transfers between an EVM chain and a Cosmos chain.
+type RateLimitTestSuite struct {
+	suite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	evmChainA *evmibctesting.TestChain
+	chainB    *evmibctesting.TestChain
+
+	path *evmibctesting.Path
+}
+
+func (suite *RateLimitTestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 1, 1, integration.SetupEvmd)
+	suite.evmChainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetChainID(2))
+
+	// NOTE:
+	// path.EndpointA = endpoint on evmChainA
+	// path.EndpointB = endpoint on chainB
+	suite.path = evmibctesting.NewTransferPath(suite.evmChainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+// setRateLimit sets a rate limit with an hourly window on the channel of evmChainA.
+func (suite *RateLimitTestSuite) setRateLimit(denom string, maxInflow, maxOutflow sdkmath.Int) erc20types.RateLimit {
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	ctx := suite.evmChainA.GetContext()
+
+	rateLimit := erc20types.RateLimit{
+		ChannelId:  suite.path.EndpointA.ChannelID,
+		Denom:      denom,
+		MaxInflow:  maxInflow,
+		MaxOutflow: maxOutflow,
+		Window:     time.Hour,
+	}
+	params := evmApp.Erc20Keeper.GetParams(ctx)
+	params.RateLimits = []erc20types.RateLimit{rateLimit}
+	suite.Require().NoError(evmApp.Erc20Keeper.SetParams(ctx, params))
+	return rateLimit
+}
+
+func (suite *RateLimitTestSuite) TestOutflowRateLimit() {
+	evmApp := suite.evmChainA.App.(*evmd.EVMD)
+	bondDenom, err := evmApp.StakingKeeper.BondDenom(suite.evmChainA.GetContext())
+	suite.Require().NoError(err)
+
+	amount := sdkmath.NewInt(1000)
+	rateLimit := suite.setRateLimit(bondDenom, sdkmath.ZeroInt(), amount.MulRaw(3).QuoRaw(2))
+
+	sender := suite.evmChainA.SenderAccounts[1]
+	transfer := func() (*channeltypes.Packet, error) {
+		msg := transfertypes.NewMsgTransfer(
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+			sdk.NewCoin(bondDenom, amount),
+			sender.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccount.GetAddress().String(),
+			clienttypes.NewHeight(1, 110), 0, "",
+		)
+		res, err := suite.evmChainA.SendMsgsWithSender(sender, msg)
+		if err != nil {
+			return nil, err
+		}
+		packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+		return &packet, err
+	}
+
+	packet, err := transfer()
+	suite.Require().NoError(err)
+
+	// the second transfer exceeds the outflow quota
+	_, err = transfer()
+	suite.Require().ErrorContains(err, erc20types.ErrRateLimitExceeded.Error())
+
+	_, outflow := evmApp.Erc20Keeper.GetRateLimitUsage(suite.evmChainA.GetContext(), rateLimit)
+	suite.Require().Equal(amount.String(), outflow.String())
+
+	// the quota is available again once the transfer times out and is refunded
+	suite.coordinator.CommitNBlocks(suite.chainB, 110)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(*packet))
+
+	_, outflow = evmApp.Erc20Keeper.GetRateLimitUsage(suite.evmChainA.GetContext(), rateLimit)
+	suite.Require().True(outflow.IsZero())
+}
+
+func (suite *RateLimitTestSuite) TestInflowRateLimit() {
+	testCases := []struct {
+		name       string
+		amount     sdkmath.Int
+		expSuccess bool
+	}{
+		{"success - inflow within quota", sdkmath.NewInt(1000), true},
+		{"fail - inflow exceeds quota", sdkmath.NewInt(1001), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			evmApp := suite.evmChainA.App.(*evmd.EVMD)
+			bondDenomB, err := suite.chainB.GetSimApp().StakingKeeper.BondDenom(suite.chainB.GetContext())
+			suite.Require().NoError(err)
+
+			voucherDenom := transfertypes.NewDenom(
+				bondDenomB,
+				transfertypes.NewHop(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID),
+			).IBCDenom()
+			rateLimit := suite.setRateLimit(voucherDenom, sdkmath.NewInt(1000), sdkmath.ZeroInt())
+			receiver := suite.evmChainA.SenderAccount.GetAddress()
+
+			msg := transfertypes.NewMsgTransfer(
+				suite.path.EndpointB.ChannelConfig.PortID,
+				suite.path.EndpointB.ChannelID,
+				sdk.NewCoin(bondDenomB, tc.amount),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				receiver.String(),
+				clienttypes.NewHeight(1, 110), 0, "",
+			)
+			res, err := suite.chainB.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := evmibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			_, ackBz, err := suite.path.RelayPacketWithResults(packet)
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+			suite.Require().Equal(tc.expSuccess, ack.Success())
+
+			ctxA := suite.evmChainA.GetContext()
+			inflow, _ := evmApp.Erc20Keeper.GetRateLimitUsage(ctxA, rateLimit)
+			balance := evmApp.BankKeeper.GetBalance(ctxA, receiver, voucherDenom)
+			if tc.expSuccess {
+				suite.Require().Equal(tc.amount.String(), inflow.String())
+				suite.Require().Equal(tc.amount.String(), balance.Amount.String())
+				return
+			}
+
+			suite.Require().True(inflow.IsZero())
+			suite.Require().True(balance.IsZero())
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package ibc
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/evm/contracts"
+	"github.com/cosmos/evm/evmd"
+	"github.com/cosmos/evm/evmd/tests/integration"
+	"github.com/cosmos/evm/precompiles/ics20"
+	evmibctesting "github.com/cosmos/evm/testutil/ibc"
+	"github.com/cosmos/evm/x/erc20/types"
+	callbacktypes "github.com/cosmos/evm/x/ibc/callbacks/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	"cosmossdk.io/math"
+)
+
+// CallbacksV2TestSuite tests the EVM callbacks for ICS20 transfers sent through
+// the ICS20 precompile over IBC v2 client-to-client routes.
+type CallbacksV2TestSuite struct {
+	testifysuite.Suite
+
+	coordinator *evmibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA           *evmibctesting.TestChain
+	chainAPrecompile *ics20.Precompile
+	chainB           *evmibctesting.TestChain
+	chainBPrecompile *ics20.Precompile
+
+	path *evmibctesting.Path
+}
+
+func (suite *CallbacksV2TestSuite) SetupTest() {
+	suite.coordinator = evmibctesting.NewCoordinator(suite.T(), 2, 0, integration.SetupEvmd)
+	suite.chainA = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(1))
+	suite.chainB = suite.coordinator.GetChain(evmibctesting.GetEvmChainID(2))
+
+	evmAppA := suite.chainA.App.(*evmd.EVMD)
+	suite.chainAPrecompile = ics20.NewPrecompile(
+		evmAppA.BankKeeper,
+		*evmAppA.StakingKeeper,
+		evmAppA.TransferKeeper,
+		evmAppA.IBCKeeper.ChannelKeeper,
+	)
+	evmAppB := suite.chainB.App.(*evmd.EVMD)
+	suite.chainBPrecompile = ics20.NewPrecompile(
+		evmAppB.BankKeeper,
+		*evmAppB.StakingKeeper,
+		evmAppB.TransferKeeper,
+		evmAppB.IBCKeeper.ChannelKeeper,
+	)
+
+	// NOTE:
+	// path.EndpointA = endpoint on chainA
+	// path.EndpointB = endpoint on chainB
+	suite.path = evmibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.SetupV2()
+}
+
+func TestCallbacksV2TestSuite(t *testing.T) {
+	testifysuite.Run(t, new(CallbacksV2TestSuite))
+}
+
+// queryCounter calls the given view method of the counter contract.
+func (suite *CallbacksV2TestSuite) queryCounter(
+	chain *evmibctesting.TestChain,
+	contractData evmtypes.CompiledContract,
+	contractAddr common.Address,
+	method string,
+) *big.Int {
+	evmApp := chain.App.(*evmd.EVMD)
+	res, err := evmApp.EVMKeeper.CallEVM(
+		chain.GetContext(),
+		contractData.ABI,
+		common.BytesToAddress(chain.SenderAccount.GetAddress()),
+		contractAddr,
+		false,
+		big.NewInt(100000),
+		method,
+	)
+	suite.Require().NoError(err)
+
+	var value *big.Int
+	err = contractData.ABI.UnpackIntoInterface(&value, method, res.Ret)
+	suite.Require().NoError(err)
+	return value
+}
+
+func (suite *CallbacksV2TestSuite) TestSourceCallback() {
+	testCases := []struct {
+		name         string
+		malleate     func(contractAddr common.Address) string
+		timeout      bool
+		expSendError string
+		expCounter   int64
+	}{
+		{
+			name: "success - callback on acknowledgement",
+			malleate: func(contractAddr common.Address) string {
+				return fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "%d", "send_callback": true}}`, contractAddr, 1_000_000)
+			},
+			expCounter: 1,
+		},
+		{
+			name: "success - callback on timeout",
+			malleate: func(contractAddr common.Address) string {
+				return fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "%d", "send_callback": true}}`, contractAddr, 1_000_000)
+			},
+			timeout:    true,
+			expCounter: -1,
+		},
+		{
+			name: "fail - send rejected for callback address without code",
+			malleate: func(_ common.Address) string {
+				return fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "%d", "send_callback": true}}`, common.HexToAddress("0x1234"), 1_000_000)
+			},
+			expSendError: callbacktypes.ErrCallbackFailed.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			evmAppA := suite.chainA.App.(*evmd.EVMD)
+			bondDenom, err := evmAppA.StakingKeeper.BondDenom(suite.chainA.GetContext())
+			suite.Require().NoError(err)
+			sendAmt := ibctesting.DefaultCoinAmount
+
+			contractData, contractAddr := suite.chainA.DeployCounterWithCallbacks()
+
+			escrowAddr := transfertypes.GetEscrowAddress(transfertypes.PortID, suite.path.EndpointA.ClientID)
+			escrowBefore := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddr, bondDenom)
+
+			packet, err := suite.path.EndpointA.TransferV2(
+				suite.chainAPrecompile,
+				1,
+				bondDenom,
+				sendAmt,
+				suite.chainB.SenderAccount.GetAddress().String(),
+				tc.malleate(contractAddr),
+			)
+
+			if tc.expSendError != "" {
+				suite.Require().ErrorContains(err, tc.expSendError)
+
+				escrowedBal := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddr, bondDenom)
+				suite.Require().Equal(escrowBefore, escrowedBal, "no tokens should be escrowed on a rejected send")
+				return
+			}
+			suite.Require().NoError(err)
+
+			sentPackets := suite.queryCounter(suite.chainA, contractData, contractAddr, "sentPackets")
+			suite.Require().Equal(int64(1), sentPackets.Int64(), "send callback should be executed once")
+
+			if tc.timeout {
+				// move past the packet timeout and prove its non-receipt on chainB
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+				suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+				suite.Require().NoError(suite.path.EndpointA.MsgTimeoutPacket(packet))
+			} else {
+				suite.Require().NoError(suite.path.RelayPacketV2(packet))
+			}
+
+			counter := suite.queryCounter(suite.chainA, contractData, contractAddr, "getCounter")
+			suite.Require().Equal(tc.expCounter, counter.Int64())
+
+			escrowedBal := evmAppA.BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddr, bondDenom)
+			if tc.timeout {
+				suite.Require().Equal(escrowBefore, escrowedBal, "escrowed tokens should be refunded on timeout")
+			} else {
+				suite.Require().Equal(escrowBefore.Amount.Add(sendAmt).String(), escrowedBal.Amount.String())
+			}
+		})
+	}
+}
+
+func (suite *CallbacksV2TestSuite) TestDestinationCallback() {
+	testCases := []struct {
+		name       string
+		calldata   func(contractData evmtypes.CompiledContract, erc20Contract common.Address, amount math.Int) []byte
+		expSuccess bool
+	}{
+		{
+			name: "success - callback deposits the received vouchers",
+			calldata: func(contractData evmtypes.CompiledContract, erc20Contract common.Address, amount math.Int) []byte {
+				packedBytes, err := contractData.ABI.Pack("add", erc20Contract, amount.BigInt())
+				suite.Require().NoError(err)
+				return packedBytes
+			},
+			expSuccess: true,
+		},
+		{
+			name: "fail - callback amount exceeds the received vouchers",
+			calldata: func(contractData evmtypes.CompiledContract, erc20Contract common.Address, amount math.Int) []byte {
+				packedBytes, err := contractData.ABI.Pack("add", erc20Contract, amount.AddRaw(1).BigInt())
+				suite.Require().NoError(err)
+				return packedBytes
+			},
+			expSuccess: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			evmAppA := suite.chainA.App.(*evmd.EVMD)
+			evmAppB := suite.chainB.App.(*evmd.EVMD)
+			bondDenomB, err := evmAppB.StakingKeeper.BondDenom(suite.chainB.GetContext())
+			suite.Require().NoError(err)
+			sendAmt := ibctesting.DefaultCoinAmount
+
+			contractData, contractAddr := suite.chainA.DeployCounterWithCallbacks()
+
+			escrowAddr := transfertypes.GetEscrowAddress(transfertypes.PortID, suite.path.EndpointB.ClientID)
+			escrowBefore := evmAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddr, bondDenomB)
+
+			// on v2 the isolated address is derived from the destination client
+			sender := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			isolatedAddr := callbacktypes.GenerateIsolatedAddress(suite.path.EndpointA.ClientID, sender.String())
+
+			voucherDenom := transfertypes.NewDenom(bondDenomB, transfertypes.NewHop(transfertypes.PortID, suite.path.EndpointA.ClientID)).IBCDenom()
+			singleTokenRepresentation, err := types.NewTokenPairSTRv2(voucherDenom)
+			suite.Require().NoError(err)
+			erc20Contract := singleTokenRepresentation.GetERC20Contract()
+
+			memo := fmt.Sprintf(`{"dest_callback": {"address": "%s", "gas_limit": "%d", "calldata": "%x"}}`,
+				contractAddr, 1_000_000, tc.calldata(contractData, erc20Contract, sendAmt))
+
+			packet, err := suite.path.EndpointB.TransferV2(
+				suite.chainBPrecompile,
+				1,
+				bondDenomB,
+				sendAmt,
+				isolatedAddr.String(),
+				memo,
+			)
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(suite.path.RelayPacketV2(packet))
+
+			ctxA := suite.chainA.GetContext()
+			counter := suite.queryCounter(suite.chainA, contractData, contractAddr, "getCounter")
+			escrowedBal := evmAppB.BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddr, bondDenomB)
+
+			if tc.expSuccess {
+				balance := evmAppA.Erc20Keeper.BalanceOf(ctxA, contracts.ERC20MinterBurnerDecimalsContract.ABI, erc20Contract, contractAddr)
+				suite.Require().Equal(sendAmt.String(), balance.String())
+				suite.Require().Equal(int64(1), counter.Int64())
+				suite.Require().Equal(escrowBefore.Amount.Add(sendAmt).String(), escrowedBal.Amount.String())
+				return
+			}
+
+			// the failed callback results in an error acknowledgement which reverts
+			// the receive on chainA and refunds the sender on chainB
+			_, found := evmAppA.Erc20Keeper.GetTokenPair(ctxA, singleTokenRepresentation.GetID())
+			suite.Require().False(found)
+			suite.Require().Zero(counter.Int64())
+			suite.Require().Equal(escrowBefore, escrowedBal, "escrowed tokens should be refunded on error acknowledgement")
+			vouchers := evmAppA.BankKeeper.GetBalance(ctxA, isolatedAddr, voucherDenom)
+			suite.Require().True(vouchers.IsZero())
+		})
+	}
+}
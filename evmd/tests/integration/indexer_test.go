@@ -12,3 +12,18 @@ func TestKVIndexer(t *testing.T) {
 	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
 	indexer.TestKVIndexer(t, create)
 }
+
+func TestKVIndexerLogs(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestKVIndexerLogs(t, create)
+}
+
+func TestPSQLIndexer(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestPSQLIndexer(t, create)
+}
+
+func TestKVIndexerMaintenance(t *testing.T) {
+	create := testapp.ToEvmAppCreator[evm.IntegrationNetworkApp](CreateEvmd, "evm.IntegrationNetworkApp")
+	indexer.TestKVIndexerMaintenance(t, create)
+}
@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -9,9 +10,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	dbm "github.com/cosmos/cosmos-db"
-	rpctypes "github.com/cosmos/evm/rpc/types"
 	servertypes "github.com/cosmos/evm/server/types"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -23,8 +22,13 @@ import (
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixLogAddress = 3
+	KeyPrefixLogTopic   = 4
+	KeyPrefixTxLogs     = 5
+	KeyPrefixLogBlock   = 6
+	KeyPrefixBlock      = 7
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
@@ -37,89 +41,75 @@ type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+
+	// logRetention is the number of recent blocks whose logs are kept in the
+	// log indexes, 0 keeps the logs of all the blocks
+	logRetention uint64
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// SetLogRetention sets the number of recent blocks whose logs are kept in the
+// log indexes, 0 keeps the logs of all the blocks.
+func (kv *KVIndexer) SetLogRetention(blocks uint64) {
+	kv.logRetention = blocks
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
-// - Iterates over all of the Txs in Block
-// - Parses eth Tx infos from cosmos-sdk events for every TxResult
-// - Iterates over all the messages of the Tx
-// - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Indexes the logs of every TxResult by address and topics
+// - Parses the eth txs of the block, see parseEthTxs
+// - Stores the indexer.TxResult of every eth tx
+// - Records the block as indexed
 func (kv *KVIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
 	height := block.Height
 
+	// the logs of the blocks out of the retention window, when indexing
+	// backward, are not indexed
+	_, lastLogBlock, err := kv.LogIndexedRange()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	newestLogBlock := max(height, lastLogBlock)
+	indexLogs := kv.logRetention == 0 || newestLogBlock-height < int64(kv.logRetention) //#nosec G115 -- the retention won't exceed int64
+
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSucessOrExpectedFailure(result) {
-			continue
-		}
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		if !isEthTx(tx) {
-			continue
-		}
-
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := ethMsg.Hash()
-
-			txResult := servertypes.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
-				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
+	// remove the eth txs left by a previous indexing of the block
+	if err := kv.deleteTxResults(batch, height, height); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if indexLogs {
+		for txIndex := range block.Txs {
+			if err := kv.indexTxLogs(batch, height, txIndex, txResults[txIndex]); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
 		}
 	}
+	for _, tx := range parseEthTxs(kv.logger, kv.clientCtx.TxConfig.TxDecoder(), block, txResults) {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, tx.msg.Hash(), &tx.result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if err := batch.Set(BlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set block key", height)
+	}
+	if indexLogs {
+		if err := batch.Set(LogBlockKey(height), []byte{}); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d, set log block key", height)
+		}
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
+	if kv.logRetention > 0 && newestLogBlock > int64(kv.logRetention) { //#nosec G115 -- the retention won't exceed int64
+		if err := kv.pruneLogs(newestLogBlock - int64(kv.logRetention)); err != nil { //#nosec G115 -- the retention won't exceed int64
+			return errorsmod.Wrapf(err, "IndexBlock %d, prune logs", height)
+		}
+	}
 	return nil
 }
 
@@ -133,6 +123,99 @@ func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
 	return LoadFirstBlock(kv.db)
 }
 
+// MissingBlocks returns the blocks of the [from, to] range which are not
+// indexed. The blocks indexed before the block records were introduced are
+// only detected if they have eth txs.
+func (kv *KVIndexer) MissingBlocks(from, to int64) ([]int64, error) {
+	indexed := make(map[int64]struct{})
+	it, err := kv.db.Iterator(BlockKey(from), BlockKey(to+1))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "MissingBlocks")
+	}
+	for ; it.Valid(); it.Next() {
+		indexed[int64(sdk.BigEndianToUint64(it.Key()[1:]))] = struct{}{} //#nosec G115 -- block number won't exceed int64
+	}
+	if err := it.Close(); err != nil {
+		return nil, errorsmod.Wrap(err, "MissingBlocks")
+	}
+
+	it, err = kv.db.Iterator(TxIndexKey(from, 0), TxIndexKey(to+1, 0))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "MissingBlocks")
+	}
+	for ; it.Valid(); it.Next() {
+		height, err := parseBlockNumberFromKey(it.Key())
+		if err != nil {
+			it.Close()
+			return nil, errorsmod.Wrap(err, "MissingBlocks")
+		}
+		indexed[height] = struct{}{}
+	}
+	if err := it.Close(); err != nil {
+		return nil, errorsmod.Wrap(err, "MissingBlocks")
+	}
+
+	var missing []int64
+	for height := from; height <= to; height++ {
+		if _, ok := indexed[height]; !ok {
+			missing = append(missing, height)
+		}
+	}
+	return missing, nil
+}
+
+// PruneBlocks removes the eth txs, the logs and the records of the blocks up
+// to the given height.
+func (kv *KVIndexer) PruneBlocks(height int64) error {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if err := kv.deleteTxResults(batch, 0, height); err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks")
+	}
+
+	it, err := kv.db.Iterator([]byte{KeyPrefixBlock}, BlockKey(height+1))
+	if err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks")
+	}
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(slices.Clone(it.Key())); err != nil {
+			it.Close()
+			return errorsmod.Wrap(err, "PruneBlocks")
+		}
+	}
+	if err := it.Close(); err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks")
+	}
+
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks")
+	}
+	if err := kv.pruneLogs(height); err != nil {
+		return errorsmod.Wrap(err, "PruneBlocks")
+	}
+	return nil
+}
+
+// deleteTxResults adds to the batch the deletion of the eth txs of the blocks
+// in the [from, to] range.
+func (kv *KVIndexer) deleteTxResults(batch dbm.Batch, from, to int64) error {
+	it, err := kv.db.Iterator(TxIndexKey(from, 0), TxIndexKey(to+1, 0))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(TxHashKey(common.BytesToHash(it.Value()))); err != nil {
+			return err
+		}
+		if err := batch.Delete(slices.Clone(it.Key())); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
 // GetByTxHash finds eth tx by eth tx hash
 func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*servertypes.TxResult, error) {
 	bz, err := kv.db.Get(TxHashKey(hash))
@@ -173,6 +256,12 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// BlockKey returns the key for db entry: `block number -> nil`, recording the
+// indexed blocks
+func BlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -0,0 +1,328 @@
+package indexer
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txLocationLength is the length of the `(block number, tx index)` suffix of
+// the log index keys.
+const txLocationLength = 8 + 8
+
+// GetLogs returns the logs matching the addresses and topics of the
+// contiguous blocks with indexed logs starting at from and up to to, in the
+// order they were emitted, along with the last block searched. It returns
+// from-1 if the logs of the block from are not indexed.
+func (kv *KVIndexer) GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]*ethtypes.Log, int64, error) {
+	to, err := kv.logIndexedTo(from, to)
+	if err != nil {
+		return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+	}
+	if to < from {
+		return nil, to, nil
+	}
+
+	// the locations of the txs with logs matching every criteria, nil if
+	// there is no criteria
+	var locations map[string]struct{}
+	restrict := func(matches map[string]struct{}) {
+		if locations == nil {
+			locations = matches
+			return
+		}
+		for location := range locations {
+			if _, ok := matches[location]; !ok {
+				delete(locations, location)
+			}
+		}
+	}
+
+	if len(addresses) > 0 {
+		matches := make(map[string]struct{})
+		for _, address := range addresses {
+			prefix := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+			if err := kv.collectLocations(prefix, from, to, matches); err != nil {
+				return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+			}
+		}
+		restrict(matches)
+	}
+	for position, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		matches := make(map[string]struct{})
+		for _, topic := range sub {
+			prefix := append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...)
+			if err := kv.collectLocations(prefix, from, to, matches); err != nil {
+				return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+			}
+		}
+		restrict(matches)
+	}
+
+	var logs []*ethtypes.Log
+	appendMatching := func(location, bz []byte) error {
+		height := sdk.BigEndianToUint64(location[:8])
+		txLogs, err := evmtypes.DecodeTxLogs(bz, height)
+		if err != nil {
+			return err
+		}
+		for _, log := range txLogs {
+			if matchLog(log, addresses, topics) {
+				logs = append(logs, log)
+			}
+		}
+		return nil
+	}
+
+	if locations == nil {
+		it, err := kv.db.Iterator(TxLogsKey(from, 0), TxLogsKey(to+1, 0))
+		if err != nil {
+			return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+		}
+		defer it.Close()
+		for ; it.Valid(); it.Next() {
+			if err := appendMatching(it.Key()[1:], it.Value()); err != nil {
+				return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+			}
+		}
+		return logs, to, nil
+	}
+
+	sorted := make([]string, 0, len(locations))
+	for location := range locations {
+		sorted = append(sorted, location)
+	}
+	sort.Strings(sorted)
+	for _, location := range sorted {
+		bz, err := kv.db.Get(append([]byte{KeyPrefixTxLogs}, location...))
+		if err != nil {
+			return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+		}
+		if err := appendMatching([]byte(location), bz); err != nil {
+			return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+		}
+	}
+	return logs, to, nil
+}
+
+// LogIndexedRange returns the first and last blocks whose logs are indexed,
+// returns -1 if no block is indexed
+func (kv *KVIndexer) LogIndexedRange() (int64, int64, error) {
+	first, err := loadLogBlock(kv.db.Iterator)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexedRange")
+	}
+	last, err := loadLogBlock(kv.db.ReverseIterator)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexedRange")
+	}
+	return first, last, nil
+}
+
+// logIndexedTo returns the last block of the contiguous blocks with indexed
+// logs starting at from and up to to, returns from-1 if the logs of the block
+// from are not indexed.
+func (kv *KVIndexer) logIndexedTo(from, to int64) (int64, error) {
+	it, err := kv.db.Iterator(LogBlockKey(from), LogBlockKey(to+1))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	last := from - 1
+	for ; it.Valid(); it.Next() {
+		height := int64(sdk.BigEndianToUint64(it.Key()[1:])) //#nosec G115 -- block number won't exceed int64
+		if height != last+1 {
+			break
+		}
+		last = height
+	}
+	return last, nil
+}
+
+// indexTxLogs stores the logs of the tx and indexes them by address and
+// topics.
+func (kv *KVIndexer) indexTxLogs(batch dbm.Batch, height int64, txIndex int, result *abci.ExecTxResult) error {
+	logs, err := evmtypes.DecodeTxLogs(result.Data, uint64(height)) //#nosec G115 -- block height is not negative
+	if err != nil {
+		kv.logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex)
+		return nil
+	}
+	if len(logs) == 0 {
+		return nil
+	}
+
+	if err := batch.Set(TxLogsKey(height, txIndex), result.Data); err != nil {
+		return errorsmod.Wrap(err, "set tx-logs key")
+	}
+	for _, key := range logIndexKeys(logs, height, txIndex) {
+		if err := batch.Set(key, []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set log index key")
+		}
+	}
+	return nil
+}
+
+// pruneLogs removes the logs of the blocks up to the given height from the
+// log indexes.
+func (kv *KVIndexer) pruneLogs(height int64) error {
+	it, err := kv.db.Iterator(LogBlockKey(0), LogBlockKey(height+1))
+	if err != nil {
+		return err
+	}
+	var heights []int64
+	for ; it.Valid(); it.Next() {
+		heights = append(heights, int64(sdk.BigEndianToUint64(it.Key()[1:]))) //#nosec G115 -- block height won't exceed int64
+	}
+	if err := it.Close(); err != nil {
+		return err
+	}
+	if len(heights) == 0 {
+		return nil
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	for _, h := range heights {
+		txIt, err := kv.db.Iterator(TxLogsKey(h, 0), TxLogsKey(h+1, 0))
+		if err != nil {
+			return err
+		}
+		for ; txIt.Valid(); txIt.Next() {
+			txIndex := int(sdk.BigEndianToUint64(txIt.Key()[9:]))       //#nosec G115 -- tx index won't exceed int
+			logs, err := evmtypes.DecodeTxLogs(txIt.Value(), uint64(h)) //#nosec G115 -- block height is not negative
+			if err != nil {
+				txIt.Close()
+				return err
+			}
+			for _, key := range logIndexKeys(logs, h, txIndex) {
+				if err := batch.Delete(key); err != nil {
+					txIt.Close()
+					return err
+				}
+			}
+			if err := batch.Delete(TxLogsKey(h, txIndex)); err != nil {
+				txIt.Close()
+				return err
+			}
+		}
+		if err := txIt.Close(); err != nil {
+			return err
+		}
+		if err := batch.Delete(LogBlockKey(h)); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// collectLocations adds the tx locations of the index entries with the prefix
+// in the [from, to] block range to the set.
+func (kv *KVIndexer) collectLocations(prefix []byte, from, to int64, locations map[string]struct{}) error {
+	start := append(slices.Clone(prefix), txLocation(from, 0)...)
+	end := append(slices.Clone(prefix), txLocation(to+1, 0)...)
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		locations[string(key[len(key)-txLocationLength:])] = struct{}{}
+	}
+	return nil
+}
+
+// TxLogsKey returns the key for db entry: `(block number, tx index) -> tx result data`
+func TxLogsKey(blockNumber int64, txIndex int) []byte {
+	return append([]byte{KeyPrefixTxLogs}, txLocation(blockNumber, txIndex)...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, tx index) -> nil`
+func LogAddressKey(address common.Address, blockNumber int64, txIndex int) []byte {
+	key := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+	return append(key, txLocation(blockNumber, txIndex)...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, tx index) -> nil`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64, txIndex int) []byte {
+	key := append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...) //#nosec G115 -- logs have at most 4 topics
+	return append(key, txLocation(blockNumber, txIndex)...)
+}
+
+// LogBlockKey returns the key for db entry: `block number -> nil`, recording
+// the blocks whose logs are indexed
+func LogBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...) //nolint:gosec // G115 // block number won't exceed uint64
+}
+
+func txLocation(blockNumber int64, txIndex int) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) //nolint:gosec // G115 // block number won't exceed uint64
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     //nolint:gosec // G115 // index won't exceed uint64
+	return append(bz1, bz2...)
+}
+
+// logIndexKeys returns the address and topic index keys of the logs of a tx.
+func logIndexKeys(logs []*ethtypes.Log, blockNumber int64, txIndex int) [][]byte {
+	seen := make(map[string]struct{})
+	var keys [][]byte
+	add := func(key []byte) {
+		if _, ok := seen[string(key)]; ok {
+			return
+		}
+		seen[string(key)] = struct{}{}
+		keys = append(keys, key)
+	}
+	for _, log := range logs {
+		add(LogAddressKey(log.Address, blockNumber, txIndex))
+		for position, topic := range log.Topics {
+			add(LogTopicKey(position, topic, blockNumber, txIndex))
+		}
+	}
+	return keys
+}
+
+// loadLogBlock returns the block number of the first log block key returned
+// by the iterator, returns -1 if there is none
+func loadLogBlock(iterator func(start, end []byte) (dbm.Iterator, error)) (int64, error) {
+	it, err := iterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(it.Key()[1:])), nil //#nosec G115 -- block number won't exceed int64
+}
+
+// matchLog returns true if the log matches the addresses and the topics
+// criteria of eth_getLogs.
+func matchLog(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 && !slices.Contains(addresses, log.Address) {
+		return false
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) > 0 && !slices.Contains(sub, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
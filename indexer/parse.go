@@ -0,0 +1,91 @@
+package indexer
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ethTx is an eth tx of a block along with its indexed result.
+type ethTx struct {
+	msg    *evmtypes.MsgEthereumTx
+	result servertypes.TxResult
+}
+
+// parseEthTxs parses the eth txs of a block through the following steps:
+// - Iterates over all of the Txs in Block
+// - Parses eth Tx infos from cosmos-sdk events for every TxResult
+// - Iterates over all the messages of the Tx
+// - Builds a indexer.TxResult based on parsed events for every message
+func parseEthTxs(logger log.Logger, txDecoder sdk.TxDecoder, block *cmttypes.Block, txResults []*abci.ExecTxResult) []ethTx {
+	height := block.Height
+
+	var ethTxs []ethTx
+	// record index of valid eth tx during the iteration
+	var ethTxIndex int32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSucessOrExpectedFailure(result) {
+			continue
+		}
+
+		tx, err := txDecoder(tx)
+		if err != nil {
+			logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !isEthTx(tx) {
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg := msg.(*evmtypes.MsgEthereumTx)
+
+			txResult := servertypes.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  //#nosec G115 -- int overflow is not a concern here
+				MsgIndex:   uint32(msgIndex), //#nosec G115 -- int overflow is not a concern here
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := txs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+			}
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			ethTxs = append(ethTxs, ethTx{msg: ethMsg, result: txResult})
+		}
+	}
+	return ethTxs
+}
@@ -0,0 +1,488 @@
+package indexer
+
+import (
+	"database/sql"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/lib/pq"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servertypes "github.com/cosmos/evm/server/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+const (
+	tableEVMBlocks   = "evm_blocks"
+	tableEVMTxs      = "evm_txs"
+	tableEVMReceipts = "evm_receipts"
+	tableEVMLogs     = "evm_logs"
+	tableEVMEvents   = "evm_events"
+
+	psqlDriverName = "postgres"
+)
+
+// psqlSchema is the schema of the PostgreSQL indexer, installed by
+// NewPSQLIndexer.
+//
+//go:embed psql_schema.sql
+var psqlSchema string
+
+var (
+	evmTxInsertColumns = []string{
+		"hash", "height", "tx_index", "msg_index", "eth_tx_index", "from_address", "to_address", "nonce", "value", "gas_limit", "raw",
+	}
+	evmReceiptInsertColumns = []string{
+		"tx_hash", "height", "status", "gas_used", "cumulative_gas_used", "contract_address", "logs_bloom",
+	}
+	evmLogInsertColumns = []string{
+		"height", "tx_index", "position", "log_index", "tx_hash", "eth_tx_index", "block_hash", "block_timestamp",
+		"address", "topic0", "topic1", "topic2", "topic3", "data",
+	}
+	evmEventInsertColumns = []string{"height", "tx_index", "event_index", "type", "attributes"}
+)
+
+var _ servertypes.EVMTxIndexer = &PSQLIndexer{}
+
+// PSQLIndexer implements an eth tx indexer backed by a PostgreSQL database,
+// using the schema defined in indexer/psql_schema.sql. Besides the data
+// required by the JSON-RPC server, it records the blocks, transactions,
+// receipts, logs and Cosmos events so they can be queried with SQL.
+type PSQLIndexer struct {
+	db        *sql.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewPSQLIndexer creates the PSQLIndexer connected to the PostgreSQL database
+// specified by connStr, and installs the schema if it doesn't exist yet.
+func NewPSQLIndexer(connStr string, logger log.Logger, clientCtx client.Context) (*PSQLIndexer, error) {
+	db, err := sql.Open(psqlDriverName, connStr)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(psqlSchema); err != nil {
+		_ = db.Close()
+		return nil, errorsmod.Wrap(err, "install indexer schema")
+	}
+	return &PSQLIndexer{db: db, logger: logger, clientCtx: clientCtx}, nil
+}
+
+// DB returns the underlying PostgreSQL connection used by the indexer.
+// This is exported to support testing.
+func (pi *PSQLIndexer) DB() *sql.DB { return pi.db }
+
+// Close closes the connection to the database.
+func (pi *PSQLIndexer) Close() error { return pi.db.Close() }
+
+// IndexBlock replaces the rows of the block in a single database transaction:
+// - Records the block
+// - Records the eth txs and their receipts, see parseEthTxs
+// - Records the logs and the events of every TxResult
+func (pi *PSQLIndexer) IndexBlock(block *cmttypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Height
+
+	// the logs of the txs, keyed by eth tx hash for the receipts
+	var (
+		logInserts [][]any
+		txLogs     = make(map[common.Hash][]*ethtypes.Log)
+	)
+	for txIndex := range block.Txs {
+		logs, err := evmtypes.DecodeTxLogs(txResults[txIndex].Data, uint64(height)) //#nosec G115 -- block height is not negative
+		if err != nil {
+			pi.logger.Error("Fail to decode tx logs", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+		for position, txLog := range logs {
+			txLogs[txLog.TxHash] = append(txLogs[txLog.TxHash], txLog)
+			logInserts = append(logInserts, logInsert(height, txIndex, position, txLog))
+		}
+	}
+
+	var eventInserts [][]any
+	for txIndex, result := range txResults {
+		for eventIndex, event := range result.Events {
+			attributes, err := json.Marshal(eventAttributes(event))
+			if err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+			eventInserts = append(eventInserts, []any{height, txIndex, eventIndex, event.Type, string(attributes)})
+		}
+	}
+
+	var txInserts, receiptInserts [][]any
+	for _, tx := range parseEthTxs(pi.logger, pi.clientCtx.TxConfig.TxDecoder(), block, txResults) {
+		insert, err := txInsert(tx)
+		if err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+		txInserts = append(txInserts, insert)
+		receiptInserts = append(receiptInserts, receiptInsert(tx, txLogs[tx.msg.Hash()]))
+	}
+
+	err := runInTransaction(pi.db, func(dbtx *sql.Tx) error {
+		// the rows of the other tables are removed along with the block
+		if _, err := dbtx.Exec(`DELETE FROM `+tableEVMBlocks+` WHERE height = $1`, height); err != nil {
+			return errorsmod.Wrap(err, "delete block")
+		}
+		if _, err := dbtx.Exec(
+			`INSERT INTO `+tableEVMBlocks+` (height, hash, time, proposer, tx_count, created_at) VALUES ($1, $2, $3, $4, $5, $6)`,
+			height, nonNil(block.Hash()), block.Time, nonNil(block.ProposerAddress), len(block.Txs), time.Now().UTC(),
+		); err != nil {
+			return errorsmod.Wrap(err, "insert block")
+		}
+		if err := runBulkInsert(dbtx, tableEVMTxs, evmTxInsertColumns, txInserts); err != nil {
+			return err
+		}
+		if err := runBulkInsert(dbtx, tableEVMReceipts, evmReceiptInsertColumns, receiptInserts); err != nil {
+			return err
+		}
+		if err := runBulkInsert(dbtx, tableEVMLogs, evmLogInsertColumns, logInserts); err != nil {
+			return err
+		}
+		return runBulkInsert(dbtx, tableEVMEvents, evmEventInsertColumns, eventInserts)
+	})
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (pi *PSQLIndexer) LastIndexedBlock() (int64, error) {
+	var height int64
+	if err := pi.db.QueryRow(`SELECT COALESCE(MAX(height), -1) FROM ` + tableEVMBlocks).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	return height, nil
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (pi *PSQLIndexer) FirstIndexedBlock() (int64, error) {
+	var height int64
+	if err := pi.db.QueryRow(`SELECT COALESCE(MIN(height), -1) FROM ` + tableEVMBlocks).Scan(&height); err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	return height, nil
+}
+
+// MissingBlocks returns the blocks of the [from, to] range which are not
+// indexed.
+func (pi *PSQLIndexer) MissingBlocks(from, to int64) ([]int64, error) {
+	rows, err := pi.db.Query(
+		`SELECT h FROM generate_series($1::BIGINT, $2::BIGINT) AS h
+		WHERE NOT EXISTS (SELECT 1 FROM `+tableEVMBlocks+` b WHERE b.height = h) ORDER BY h`, from, to,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "MissingBlocks")
+	}
+	defer rows.Close()
+	var missing []int64
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, errorsmod.Wrap(err, "MissingBlocks")
+		}
+		missing = append(missing, height)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errorsmod.Wrap(err, "MissingBlocks")
+	}
+	return missing, nil
+}
+
+// PruneBlocks removes the rows of the blocks up to the given height, the rows
+// of the other tables are removed along with them.
+func (pi *PSQLIndexer) PruneBlocks(height int64) error {
+	if _, err := pi.db.Exec(`DELETE FROM `+tableEVMBlocks+` WHERE height <= $1`, height); err != nil {
+		return errorsmod.Wrapf(err, "PruneBlocks %d", height)
+	}
+	return nil
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (pi *PSQLIndexer) GetByTxHash(hash common.Hash) (*servertypes.TxResult, error) {
+	res, err := pi.queryTxResult(`t.hash = $1`, hash.Bytes())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return res, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (pi *PSQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*servertypes.TxResult, error) {
+	res, err := pi.queryTxResult(`t.height = $1 AND t.eth_tx_index = $2`, blockNumber, txIndex)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return res, nil
+}
+
+// GetLogs returns the logs matching the addresses and topics of the
+// contiguous indexed blocks starting at from and up to to, in the order they
+// were emitted, along with the last block searched. It returns from-1 if the
+// block from is not indexed.
+func (pi *PSQLIndexer) GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]*ethtypes.Log, int64, error) {
+	to, err := pi.indexedTo(from, to)
+	if err != nil {
+		return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+	}
+	if to < from {
+		return nil, to, nil
+	}
+
+	conditions := []string{`height BETWEEN $1 AND $2`}
+	args := []any{from, to}
+	addCondition := func(column string, values [][]byte) {
+		args = append(args, pq.ByteaArray(values))
+		conditions = append(conditions, fmt.Sprintf("%s = ANY($%d)", column, len(args)))
+	}
+	if len(addresses) > 0 {
+		values := make([][]byte, len(addresses))
+		for i, address := range addresses {
+			values[i] = address.Bytes()
+		}
+		addCondition("address", values)
+	}
+	if len(topics) > 4 {
+		// logs have at most 4 topics
+		return nil, to, nil
+	}
+	for position, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		values := make([][]byte, len(sub))
+		for i, topic := range sub {
+			values[i] = topic.Bytes()
+		}
+		addCondition(fmt.Sprintf("topic%d", position), values)
+	}
+
+	rows, err := pi.db.Query(
+		`SELECT height, log_index, tx_hash, eth_tx_index, block_hash, block_timestamp, address, topic0, topic1, topic2, topic3, data
+FROM `+tableEVMLogs+` WHERE `+strings.Join(conditions, " AND ")+` ORDER BY height, tx_index, position`,
+		args...,
+	)
+	if err != nil {
+		return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+	}
+	defer rows.Close()
+
+	var logs []*ethtypes.Log
+	for rows.Next() {
+		var (
+			ethLog                           ethtypes.Log
+			height, logIndex, ethTxIndex     int64
+			blockTimestamp                   int64
+			txHash, blockHash, address, data []byte
+			topic0, topic1, topic2, topic3   []byte
+		)
+		if err := rows.Scan(
+			&height, &logIndex, &txHash, &ethTxIndex, &blockHash, &blockTimestamp, &address, &topic0, &topic1, &topic2, &topic3, &data,
+		); err != nil {
+			return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+		}
+		ethLog.BlockNumber = uint64(height)            //#nosec G115 -- block height is not negative
+		ethLog.Index = uint(logIndex)                  //#nosec G115 -- log index is not negative
+		ethLog.TxIndex = uint(ethTxIndex)              //#nosec G115 -- tx index is not negative
+		ethLog.BlockTimestamp = uint64(blockTimestamp) //#nosec G115 -- timestamp is not negative
+		ethLog.TxHash = common.BytesToHash(txHash)
+		ethLog.BlockHash = common.BytesToHash(blockHash)
+		ethLog.Address = common.BytesToAddress(address)
+		ethLog.Data = data
+		for _, topic := range [][]byte{topic0, topic1, topic2, topic3} {
+			if topic == nil {
+				break
+			}
+			ethLog.Topics = append(ethLog.Topics, common.BytesToHash(topic))
+		}
+		logs = append(logs, &ethLog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, from - 1, errorsmod.Wrap(err, "GetLogs")
+	}
+	return logs, to, nil
+}
+
+// LogIndexedRange returns the first and last indexed blocks, returns -1 if no
+// block is indexed
+func (pi *PSQLIndexer) LogIndexedRange() (int64, int64, error) {
+	var first, last int64
+	if err := pi.db.QueryRow(
+		`SELECT COALESCE(MIN(height), -1), COALESCE(MAX(height), -1) FROM `+tableEVMBlocks,
+	).Scan(&first, &last); err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexedRange")
+	}
+	return first, last, nil
+}
+
+// indexedTo returns the last block of the contiguous indexed blocks starting
+// at from and up to to, returns from-1 if the block from is not indexed.
+func (pi *PSQLIndexer) indexedTo(from, to int64) (int64, error) {
+	rows, err := pi.db.Query(
+		`SELECT height FROM `+tableEVMBlocks+` WHERE height BETWEEN $1 AND $2 ORDER BY height`, from, to,
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	last := from - 1
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return 0, err
+		}
+		if height != last+1 {
+			break
+		}
+		last = height
+	}
+	return last, rows.Err()
+}
+
+// queryTxResult returns the result of the eth tx matching the condition.
+func (pi *PSQLIndexer) queryTxResult(condition string, args ...any) (*servertypes.TxResult, error) {
+	var (
+		res    servertypes.TxResult
+		status int
+	)
+	err := pi.db.QueryRow(
+		`SELECT t.height, t.tx_index, t.msg_index, t.eth_tx_index, r.gas_used, r.cumulative_gas_used, r.status
+FROM `+tableEVMTxs+` t JOIN `+tableEVMReceipts+` r ON r.tx_hash = t.hash WHERE `+condition,
+		args...,
+	).Scan(&res.Height, &res.TxIndex, &res.MsgIndex, &res.EthTxIndex, &res.GasUsed, &res.CumulativeGasUsed, &status)
+	if err != nil {
+		return nil, err
+	}
+	res.Failed = status == 0
+	return &res, nil
+}
+
+// runInTransaction executes query in a fresh database transaction.
+// If query reports an error, the transaction is rolled back and the
+// error from query is reported to the caller.
+// Otherwise, the result of committing the transaction is returned.
+func runInTransaction(db *sql.DB, query func(*sql.Tx) error) error {
+	dbtx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := query(dbtx); err != nil {
+		_ = dbtx.Rollback() // report the initial error, not the rollback
+		return err
+	}
+	return dbtx.Commit()
+}
+
+// runBulkInsert copies the rows into the table within the transaction.
+func runBulkInsert(dbtx *sql.Tx, tableName string, columns []string, inserts [][]any) error {
+	if len(inserts) == 0 {
+		return nil
+	}
+	stmt, err := dbtx.Prepare(pq.CopyIn(tableName, columns...))
+	if err != nil {
+		return fmt.Errorf("preparing bulk insert statement into %s: %w", tableName, err)
+	}
+	defer stmt.Close()
+	for _, insert := range inserts {
+		if _, err := stmt.Exec(insert...); err != nil {
+			return fmt.Errorf("executing insert statement into %s: %w", tableName, err)
+		}
+	}
+	if _, err := stmt.Exec(); err != nil {
+		return fmt.Errorf("flushing bulk insert into %s: %w", tableName, err)
+	}
+	return nil
+}
+
+// txInsert returns the evm_txs row of the eth tx.
+func txInsert(tx ethTx) ([]any, error) {
+	signedTx := tx.msg.AsTransaction()
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var to any
+	if signedTx.To() != nil {
+		to = signedTx.To().Bytes()
+	}
+	return []any{
+		signedTx.Hash().Bytes(), tx.result.Height, tx.result.TxIndex, tx.result.MsgIndex, tx.result.EthTxIndex,
+		tx.msg.GetSender().Bytes(), to, signedTx.Nonce(), signedTx.Value().String(), signedTx.Gas(), raw,
+	}, nil
+}
+
+// receiptInsert returns the evm_receipts row of the eth tx.
+func receiptInsert(tx ethTx, logs []*ethtypes.Log) []any {
+	signedTx := tx.msg.AsTransaction()
+	status := ethtypes.ReceiptStatusSuccessful
+	if tx.result.Failed {
+		status = ethtypes.ReceiptStatusFailed
+	}
+	var contractAddress any
+	if signedTx.To() == nil {
+		contractAddress = crypto.CreateAddress(tx.msg.GetSender(), signedTx.Nonce()).Bytes()
+	}
+	bloom := ethtypes.CreateBloom(&ethtypes.Receipt{Logs: logs})
+	return []any{
+		signedTx.Hash().Bytes(), tx.result.Height, status, tx.result.GasUsed, tx.result.CumulativeGasUsed, contractAddress, bloom.Bytes(),
+	}
+}
+
+// logInsert returns the evm_logs row of the log.
+func logInsert(height int64, txIndex, position int, txLog *ethtypes.Log) []any {
+	topics := make([]any, 4)
+	for i, topic := range txLog.Topics {
+		if i < len(topics) {
+			topics[i] = topic.Bytes()
+		}
+	}
+	return []any{
+		height, txIndex, position, txLog.Index, txLog.TxHash.Bytes(), txLog.TxIndex, txLog.BlockHash.Bytes(), txLog.BlockTimestamp,
+		txLog.Address.Bytes(), topics[0], topics[1], topics[2], topics[3], nonNil(txLog.Data),
+	}
+}
+
+// eventAttribute is an attribute of a Cosmos event, as stored in the
+// attributes column of evm_events.
+type eventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func eventAttributes(event abci.Event) []eventAttribute {
+	attributes := make([]eventAttribute, len(event.Attributes))
+	for i, attr := range event.Attributes {
+		attributes[i] = eventAttribute{Key: attr.Key, Value: attr.Value}
+	}
+	return attributes
+}
+
+// nonNil returns an empty slice instead of nil, which is stored as NULL.
+func nonNil(bz []byte) []byte {
+	if bz == nil {
+		return []byte{}
+	}
+	return bz
+}
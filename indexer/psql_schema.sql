@@ -0,0 +1,101 @@
+/*
+  This file defines the database schema of the PostgreSQL EVM indexer. It is
+  installed by NewPSQLIndexer when the tables don't exist yet.
+
+  Every indexed block has a row in evm_blocks, the rows of the other tables
+  reference it and are removed along with it when a block is indexed again.
+ */
+
+-- The evm_blocks table records the indexed blocks.
+CREATE TABLE IF NOT EXISTS evm_blocks (
+  height     BIGINT PRIMARY KEY,
+  hash       BYTEA NOT NULL,
+  time       TIMESTAMPTZ NOT NULL,
+  proposer   BYTEA NOT NULL,
+  -- The number of Cosmos transactions in the block.
+  tx_count   INTEGER NOT NULL,
+  -- When this block was indexed, in UTC.
+  created_at TIMESTAMPTZ NOT NULL
+);
+
+-- The evm_txs table records the Ethereum transactions.
+CREATE TABLE IF NOT EXISTS evm_txs (
+  hash         BYTEA PRIMARY KEY,
+  height       BIGINT NOT NULL REFERENCES evm_blocks(height) ON DELETE CASCADE,
+  -- The index of the Cosmos transaction in the block.
+  tx_index     INTEGER NOT NULL,
+  -- The index of the message in the Cosmos transaction.
+  msg_index    INTEGER NOT NULL,
+  -- The index of the Ethereum transaction in the block.
+  eth_tx_index INTEGER NOT NULL,
+  from_address BYTEA NOT NULL,
+  -- NULL for contract creations.
+  to_address   BYTEA NULL,
+  nonce        BIGINT NOT NULL,
+  value        NUMERIC NOT NULL,
+  gas_limit    BIGINT NOT NULL,
+  -- The binary encoding of the transaction.
+  raw          BYTEA NOT NULL,
+
+  UNIQUE (height, eth_tx_index)
+);
+
+-- The evm_receipts table records the receipts of the Ethereum transactions.
+CREATE TABLE IF NOT EXISTS evm_receipts (
+  tx_hash             BYTEA PRIMARY KEY REFERENCES evm_txs(hash) ON DELETE CASCADE,
+  height              BIGINT NOT NULL REFERENCES evm_blocks(height) ON DELETE CASCADE,
+  -- 1 for successful transactions, 0 for failed ones.
+  status              SMALLINT NOT NULL,
+  gas_used            BIGINT NOT NULL,
+  cumulative_gas_used BIGINT NOT NULL,
+  -- The address of the created contract, NULL if the transaction is not a
+  -- contract creation.
+  contract_address    BYTEA NULL,
+  logs_bloom          BYTEA NOT NULL
+);
+
+-- The evm_logs table records the logs emitted by the transactions.
+CREATE TABLE IF NOT EXISTS evm_logs (
+  height          BIGINT NOT NULL REFERENCES evm_blocks(height) ON DELETE CASCADE,
+  -- The index of the Cosmos transaction in the block.
+  tx_index        INTEGER NOT NULL,
+  -- The position of the log in the logs of the Cosmos transaction.
+  position        INTEGER NOT NULL,
+  -- The index of the log in the block.
+  log_index       INTEGER NOT NULL,
+  tx_hash         BYTEA NOT NULL,
+  -- The index of the Ethereum transaction in the block.
+  eth_tx_index    INTEGER NOT NULL,
+  block_hash      BYTEA NOT NULL,
+  block_timestamp BIGINT NOT NULL,
+  address         BYTEA NOT NULL,
+  topic0          BYTEA NULL,
+  topic1          BYTEA NULL,
+  topic2          BYTEA NULL,
+  topic3          BYTEA NULL,
+  data            BYTEA NOT NULL,
+
+  PRIMARY KEY (height, tx_index, position)
+);
+
+CREATE INDEX IF NOT EXISTS idx_evm_logs_address ON evm_logs(address, height);
+CREATE INDEX IF NOT EXISTS idx_evm_logs_topic0 ON evm_logs(topic0, height);
+CREATE INDEX IF NOT EXISTS idx_evm_logs_topic1 ON evm_logs(topic1, height);
+CREATE INDEX IF NOT EXISTS idx_evm_logs_topic2 ON evm_logs(topic2, height);
+CREATE INDEX IF NOT EXISTS idx_evm_logs_topic3 ON evm_logs(topic3, height);
+
+-- The evm_events table records the events of all the Cosmos transactions.
+CREATE TABLE IF NOT EXISTS evm_events (
+  height      BIGINT NOT NULL REFERENCES evm_blocks(height) ON DELETE CASCADE,
+  -- The index of the Cosmos transaction in the block.
+  tx_index    INTEGER NOT NULL,
+  -- The index of the event in the events of the Cosmos transaction.
+  event_index INTEGER NOT NULL,
+  type        VARCHAR NOT NULL,
+  -- The attributes of the event, as an array of {"key", "value"} objects.
+  attributes  JSONB NOT NULL,
+
+  PRIMARY KEY (height, tx_index, event_index)
+);
+
+CREATE INDEX IF NOT EXISTS idx_evm_events_type ON evm_events(type, height);
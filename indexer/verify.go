@@ -0,0 +1,52 @@
+package indexer
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VerifyBlock checks that the eth txs of the block are indexed with the
+// results parsed from txResults, and that no other eth tx is indexed at the
+// block. It returns an error describing the first mismatch found.
+func VerifyBlock(
+	idxer servertypes.EVMTxIndexer,
+	logger log.Logger,
+	txDecoder sdk.TxDecoder,
+	block *cmttypes.Block,
+	txResults []*abci.ExecTxResult,
+) error {
+	height := block.Height
+	ethTxs := parseEthTxs(logger, txDecoder, block, txResults)
+	for _, tx := range ethTxs {
+		hash := tx.msg.Hash()
+		res, err := idxer.GetByTxHash(hash)
+		if err != nil {
+			return fmt.Errorf("block %d: eth tx %s: %w", height, hash.Hex(), err)
+		}
+		if *res != tx.result {
+			return fmt.Errorf("block %d: eth tx %s: indexed result %v, expected %v", height, hash.Hex(), res, &tx.result)
+		}
+
+		res, err = idxer.GetByBlockAndIndex(height, tx.result.EthTxIndex)
+		if err != nil {
+			return fmt.Errorf("block %d: eth tx index %d: %w", height, tx.result.EthTxIndex, err)
+		}
+		if *res != tx.result {
+			return fmt.Errorf("block %d: eth tx index %d: indexed result %v, expected %v", height, tx.result.EthTxIndex, res, &tx.result)
+		}
+	}
+
+	ethTxIndex := int32(len(ethTxs)) //#nosec G115 -- int overflow is not a concern here
+	if res, err := idxer.GetByBlockAndIndex(height, ethTxIndex); err == nil && res != nil {
+		return fmt.Errorf("block %d: unexpected eth tx indexed at index %d", height, ethTxIndex)
+	}
+	return nil
+}
@@ -12,15 +12,16 @@ import (
 )
 
 // NewCheckTxHandler creates a CheckTx handler that integrates with the EVM mempool for transaction validation.
-// It wraps the standard transaction execution flow to handle EVM-specific nonce gap errors by routing
+// It wraps the standard transaction execution flow to handle nonce gap errors by routing
 // transactions with higher tx sequence numbers to the mempool for potential future execution.
+// Cosmos transactions are only queued if the mempool is configured with an account keeper.
 // Returns a handler function that processes ABCI CheckTx requests and manages EVM transaction sequencing.
 func NewCheckTxHandler(mempool *ExperimentalEVMMempool) types.CheckTxHandler {
 	return func(runTx types.RunTx, request *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
 		gInfo, result, anteEvents, err := runTx(request.Tx, nil)
 		if err != nil {
-			// detect if there is a nonce gap error (only returned for EVM transactions)
-			if errors.Is(err, ErrNonceGap) || errors.Is(err, ErrNonceLow) {
+			// detect if there is a nonce gap error, or a sequence mismatch of a Cosmos transaction
+			if errors.Is(err, ErrNonceGap) || errors.Is(err, ErrNonceLow) || errors.Is(err, sdkerrors.ErrWrongSequence) {
 				// send it to the mempool for further triage
 				err := mempool.InsertInvalidNonce(request.Tx)
 				if err != nil {
@@ -0,0 +1,261 @@
+package mempool
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cosmos/evm/mempool/txpool"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// CosmosQueueConfig defines the limits of the queue of the Cosmos transactions
+// whose sequence is higher than the one of their signers. They mirror the
+// limits of the queued EVM transactions in legacypool.Config.
+type CosmosQueueConfig struct {
+	AccountQueue uint64 // Maximum number of queued transactions permitted per account
+	GlobalQueue  uint64 // Maximum number of queued transactions for all accounts
+
+	Lifetime time.Duration // Maximum amount of time transactions are queued
+}
+
+// DefaultCosmosQueueConfig contains the default configurations for the queue of
+// the Cosmos transactions.
+var DefaultCosmosQueueConfig = CosmosQueueConfig{
+	AccountQueue: 64,
+	GlobalQueue:  1024,
+
+	Lifetime: 3 * time.Hour,
+}
+
+// sanitize checks the provided user configurations and changes anything that's
+// unreasonable or unworkable.
+func (config *CosmosQueueConfig) sanitize(logger log.Logger) CosmosQueueConfig {
+	conf := *config
+	if conf.AccountQueue < 1 {
+		logger.Warn("Sanitizing invalid cosmos queue account queue", "provided", conf.AccountQueue, "updated", DefaultCosmosQueueConfig.AccountQueue)
+		conf.AccountQueue = DefaultCosmosQueueConfig.AccountQueue
+	}
+	if conf.GlobalQueue < 1 {
+		logger.Warn("Sanitizing invalid cosmos queue global queue", "provided", conf.GlobalQueue, "updated", DefaultCosmosQueueConfig.GlobalQueue)
+		conf.GlobalQueue = DefaultCosmosQueueConfig.GlobalQueue
+	}
+	if conf.Lifetime < 1 {
+		logger.Warn("Sanitizing invalid cosmos queue lifetime", "provided", conf.Lifetime, "updated", DefaultCosmosQueueConfig.Lifetime)
+		conf.Lifetime = DefaultCosmosQueueConfig.Lifetime
+	}
+	return conf
+}
+
+// queuedCosmosTx is a Cosmos transaction waiting for the sequence of its
+// signers to catch up.
+type queuedCosmosTx struct {
+	bytes   []byte
+	signers []sdkmempool.SignerData
+}
+
+// cosmosAccountQueue holds the queued transactions of a signer, keyed by their
+// sequence.
+type cosmosAccountQueue struct {
+	txs map[uint64]*queuedCosmosTx
+	// beat is the last time a transaction of the account was promoted, or the
+	// time the account was queued
+	beat time.Time
+}
+
+// sortedSequences returns the sequences of the queued transactions in
+// increasing order.
+func (a *cosmosAccountQueue) sortedSequences() []uint64 {
+	sequences := make([]uint64, 0, len(a.txs))
+	for sequence := range a.txs {
+		sequences = append(sequences, sequence)
+	}
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+	return sequences
+}
+
+// cosmosQueue keeps the Cosmos transactions with a future sequence, which are
+// rejected by the ante handler, until the sequence gap of their signers
+// closes. Transactions are queued under their first signer, like in the
+// Cosmos pool.
+type cosmosQueue struct {
+	config          CosmosQueueConfig
+	signerExtractor sdkmempool.SignerExtractionAdapter
+
+	mu       sync.Mutex
+	accounts map[string]*cosmosAccountQueue
+	count    uint64
+}
+
+func newCosmosQueue(config CosmosQueueConfig, signerExtractor sdkmempool.SignerExtractionAdapter) *cosmosQueue {
+	return &cosmosQueue{
+		config:          config,
+		signerExtractor: signerExtractor,
+		accounts:        make(map[string]*cosmosAccountQueue),
+	}
+}
+
+// add queues the transaction if the sequence of any of its signers is higher
+// than the current one, and none is lower. Executable transactions are not
+// queued. A queued transaction with the same sequence of the first signer is
+// replaced.
+func (q *cosmosQueue) add(tx sdk.Tx, txBytes []byte, getSequence func(sdk.AccAddress) (uint64, error)) error {
+	signers, err := q.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+	if len(signers) == 0 {
+		return ErrNoSigners
+	}
+
+	gapped := false
+	for _, signer := range signers {
+		sequence, err := getSequence(signer.Signer)
+		if err != nil {
+			return err
+		}
+		switch {
+		case signer.Sequence < sequence:
+			return fmt.Errorf("%w: signer %s, expected %d, got %d", ErrNonceLow, signer.Signer, sequence, signer.Sequence)
+		case signer.Sequence > sequence:
+			gapped = true
+		}
+	}
+	if !gapped {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	key := signers[0].Signer.String()
+	account, ok := q.accounts[key]
+	if !ok {
+		account = &cosmosAccountQueue{
+			txs:  make(map[uint64]*queuedCosmosTx),
+			beat: time.Now(),
+		}
+	}
+
+	sequence := signers[0].Sequence
+	if old, ok := account.txs[sequence]; ok {
+		if bytes.Equal(old.bytes, txBytes) {
+			return txpool.ErrAlreadyKnown
+		}
+		account.txs[sequence] = &queuedCosmosTx{bytes: txBytes, signers: signers}
+		return nil
+	}
+	if uint64(len(account.txs)) >= q.config.AccountQueue {
+		return fmt.Errorf("%w: signer %s has %d queued transactions", ErrQueueFull, key, len(account.txs))
+	}
+
+	account.txs[sequence] = &queuedCosmosTx{bytes: txBytes, signers: signers}
+	q.accounts[key] = account
+	q.count++
+
+	q.truncate()
+	return nil
+}
+
+// promote removes and returns the queued transactions that became executable,
+// in the order they must be executed. It drops the transactions with a stale
+// sequence and the ones of the accounts queued for longer than the lifetime.
+func (q *cosmosQueue) promote(getSequence func(sdk.AccAddress) (uint64, error)) [][]byte {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var executable [][]byte
+	for key, account := range q.accounts {
+		// sequences expected by the signers once the previous transactions of
+		// the account are executed
+		expected := make(map[string]uint64)
+
+	sequences:
+		for _, sequence := range account.sortedSequences() {
+			tx := account.txs[sequence]
+
+			ready := true
+			for _, signer := range tx.signers {
+				signerKey := signer.Signer.String()
+				current, ok := expected[signerKey]
+				if !ok {
+					var err error
+					if current, err = getSequence(signer.Signer); err != nil {
+						break sequences
+					}
+					expected[signerKey] = current
+				}
+				if signer.Sequence < current {
+					// stale transaction, replaced by an executed one
+					delete(account.txs, sequence)
+					q.count--
+					continue sequences
+				}
+				if signer.Sequence > current {
+					ready = false
+				}
+			}
+			if !ready {
+				// the following transactions can't be executable either
+				break
+			}
+
+			executable = append(executable, tx.bytes)
+			for _, signer := range tx.signers {
+				expected[signer.Signer.String()]++
+			}
+			delete(account.txs, sequence)
+			q.count--
+			account.beat = time.Now()
+		}
+
+		if len(account.txs) == 0 || time.Since(account.beat) > q.config.Lifetime {
+			q.count -= uint64(len(account.txs))
+			delete(q.accounts, key)
+		}
+	}
+	return executable
+}
+
+// len returns the number of queued transactions.
+func (q *cosmosQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return int(q.count) //#nosec G115 -- the count is bounded by the global queue limit
+}
+
+// truncate drops the transactions with the highest sequences of the accounts
+// with the oldest heartbeats until the queue is within the global limit.
+func (q *cosmosQueue) truncate() {
+	if q.count <= q.config.GlobalQueue {
+		return
+	}
+
+	keys := make([]string, 0, len(q.accounts))
+	for key := range q.accounts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return q.accounts[keys[i]].beat.Before(q.accounts[keys[j]].beat) })
+
+	for _, key := range keys {
+		account := q.accounts[key]
+		sequences := account.sortedSequences()
+		for i := len(sequences) - 1; i >= 0 && q.count > q.config.GlobalQueue; i-- {
+			delete(account.txs, sequences[i])
+			q.count--
+		}
+		if len(account.txs) == 0 {
+			delete(q.accounts, key)
+		}
+		if q.count <= q.config.GlobalQueue {
+			return
+		}
+	}
+}
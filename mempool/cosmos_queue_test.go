@@ -0,0 +1,176 @@
+package mempool
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/evm/mempool/txpool"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// testTx is a transaction signed by the given signers.
+type testTx struct {
+	signers []sdkmempool.SignerData
+}
+
+func (testTx) GetMsgs() []sdk.Msg                    { return nil }
+func (testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func (tx testTx) bytes() []byte {
+	return []byte(fmt.Sprintf("%s/%d", tx.signers[0].Signer, tx.signers[0].Sequence))
+}
+
+type testSignerExtractor struct{}
+
+func (testSignerExtractor) GetSigners(tx sdk.Tx) ([]sdkmempool.SignerData, error) {
+	return tx.(testTx).signers, nil
+}
+
+func newTestTx(signer sdk.AccAddress, sequence uint64) testTx {
+	return testTx{signers: []sdkmempool.SignerData{sdkmempool.NewSignerData(signer, sequence)}}
+}
+
+// testSequences returns the getter of the given account sequences.
+func testSequences(sequences map[string]uint64) func(sdk.AccAddress) (uint64, error) {
+	return func(addr sdk.AccAddress) (uint64, error) {
+		return sequences[addr.String()], nil
+	}
+}
+
+func newTestCosmosQueue(config CosmosQueueConfig) *cosmosQueue {
+	return newCosmosQueue(config, testSignerExtractor{})
+}
+
+func TestCosmosQueueAdd(t *testing.T) {
+	signer := sdk.AccAddress("signer")
+	sequences := testSequences(map[string]uint64{signer.String(): 5})
+
+	testCases := []struct {
+		name        string
+		sequence    uint64
+		expQueued   int
+		expectedErr error
+	}{
+		{"executable transaction", 5, 0, nil},
+		{"stale transaction", 4, 0, ErrNonceLow},
+		{"future transaction", 7, 1, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q := newTestCosmosQueue(DefaultCosmosQueueConfig)
+			tx := newTestTx(signer, tc.sequence)
+			err := q.add(tx, tx.bytes(), sequences)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expQueued, q.len())
+		})
+	}
+}
+
+func TestCosmosQueueReplace(t *testing.T) {
+	signer := sdk.AccAddress("signer")
+	sequences := testSequences(nil)
+	q := newTestCosmosQueue(DefaultCosmosQueueConfig)
+
+	tx := newTestTx(signer, 1)
+	require.NoError(t, q.add(tx, tx.bytes(), sequences))
+	require.ErrorIs(t, q.add(tx, tx.bytes(), sequences), txpool.ErrAlreadyKnown)
+
+	// a transaction with the same sequence replaces the queued one
+	replacement := []byte("replacement")
+	require.NoError(t, q.add(tx, replacement, sequences))
+	require.Equal(t, 1, q.len())
+
+	promoted := q.promote(testSequences(map[string]uint64{signer.String(): 1}))
+	require.Equal(t, [][]byte{replacement}, promoted)
+}
+
+func TestCosmosQueueLimits(t *testing.T) {
+	first := sdk.AccAddress("first")
+	second := sdk.AccAddress("second")
+	sequences := testSequences(nil)
+	q := newTestCosmosQueue(CosmosQueueConfig{AccountQueue: 2, GlobalQueue: 3, Lifetime: time.Hour})
+
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		tx := newTestTx(first, sequence)
+		require.NoError(t, q.add(tx, tx.bytes(), sequences))
+	}
+	tx := newTestTx(first, 3)
+	require.ErrorIs(t, q.add(tx, tx.bytes(), sequences), ErrQueueFull)
+
+	// the global limit drops the highest sequences of the oldest account
+	q.accounts[first.String()].beat = time.Now().Add(-time.Minute)
+	for sequence := uint64(1); sequence <= 2; sequence++ {
+		tx := newTestTx(second, sequence)
+		require.NoError(t, q.add(tx, tx.bytes(), sequences))
+	}
+	require.Equal(t, 3, q.len())
+	require.Len(t, q.accounts[first.String()].txs, 1)
+	require.Contains(t, q.accounts[first.String()].txs, uint64(1))
+	require.Len(t, q.accounts[second.String()].txs, 2)
+}
+
+func TestCosmosQueuePromote(t *testing.T) {
+	signer := sdk.AccAddress("signer")
+	other := sdk.AccAddress("other")
+	q := newTestCosmosQueue(DefaultCosmosQueueConfig)
+	sequences := map[string]uint64{signer.String(): 1, other.String(): 1}
+
+	var txBytes [][]byte
+	for _, sequence := range []uint64{2, 3, 5} {
+		tx := newTestTx(signer, sequence)
+		txBytes = append(txBytes, tx.bytes())
+		require.NoError(t, q.add(tx, tx.bytes(), testSequences(sequences)))
+	}
+	// a transaction of two signers, executable once both gaps close
+	multiSigner := testTx{signers: []sdkmempool.SignerData{
+		sdkmempool.NewSignerData(other, 2),
+		sdkmempool.NewSignerData(signer, 6),
+	}}
+	require.NoError(t, q.add(multiSigner, multiSigner.bytes(), testSequences(sequences)))
+
+	// nothing is executable while the gaps are open
+	require.Empty(t, q.promote(testSequences(sequences)))
+	require.Equal(t, 4, q.len())
+
+	// the contiguous transactions are promoted in order
+	sequences[signer.String()] = 2
+	require.Equal(t, txBytes[:2], q.promote(testSequences(sequences)))
+	require.Equal(t, 2, q.len())
+
+	// the transactions replaced by executed ones are dropped
+	sequences[signer.String()] = 6
+	require.Empty(t, q.promote(testSequences(sequences)))
+	require.Equal(t, 1, q.len())
+
+	sequences[other.String()] = 2
+	require.Equal(t, [][]byte{multiSigner.bytes()}, q.promote(testSequences(sequences)))
+	require.Equal(t, 0, q.len())
+	require.Empty(t, q.accounts)
+}
+
+func TestCosmosQueueLifetime(t *testing.T) {
+	signer := sdk.AccAddress("signer")
+	sequences := testSequences(nil)
+	q := newTestCosmosQueue(CosmosQueueConfig{AccountQueue: 64, GlobalQueue: 1024, Lifetime: time.Hour})
+
+	tx := newTestTx(signer, 2)
+	require.NoError(t, q.add(tx, tx.bytes(), sequences))
+	require.Empty(t, q.promote(sequences))
+	require.Equal(t, 1, q.len())
+
+	// the accounts without promotions for longer than the lifetime are evicted
+	q.accounts[signer.String()].beat = time.Now().Add(-2 * time.Hour)
+	require.Empty(t, q.promote(sequences))
+	require.Equal(t, 0, q.len())
+}
@@ -9,4 +9,6 @@ var (
 	ErrNotEVMTransaction  = errors.New("transaction is not an EVM transaction")
 	ErrNonceGap           = errors.New("tx nonce is higher than account nonce")
 	ErrNonceLow           = errors.New("tx nonce is lower than account nonce")
+	ErrNoSigners          = errors.New("transaction has no signers")
+	ErrQueueFull          = errors.New("transaction queue is full")
 )
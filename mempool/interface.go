@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -32,6 +33,14 @@ type VMKeeperI interface {
 	SetEvmMempool(evmMempool *ExperimentalEVMMempool)
 }
 
+type AccountKeeperI interface {
+	GetSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+}
+
 type FeeMarketKeeperI interface {
 	GetBlockGasWanted(ctx sdk.Context) uint64
 }
+
+type FeeTokenKeeperI interface {
+	GetFeeTokenPayment(ctx sdk.Context, payer sdk.AccAddress, fees *big.Int) (vmtypes.FeeTokenPayment, bool)
+}
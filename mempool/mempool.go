@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/holiman/uint256"
 
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -20,6 +23,7 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,6 +43,8 @@ const (
 	SubscriberName = "evm"
 	// fallbackBlockGasLimit is the default block gas limit is 0 or missing in genesis file
 	fallbackBlockGasLimit = 100_000_000
+	// chainHeadChanSize is the size of channel listening to ChainHeadEvent.
+	chainHeadChanSize = 10
 )
 
 type (
@@ -54,6 +60,7 @@ type (
 		txPool       *txpool.TxPool
 		legacyTxPool *legacypool.LegacyPool
 		cosmosPool   sdkmempool.ExtMempool
+		cosmosQueue  *cosmosQueue
 
 		/** Utils **/
 		logger        log.Logger
@@ -64,12 +71,19 @@ type (
 		minTip        *uint256.Int
 
 		/** Verification **/
-		anteHandler sdk.AnteHandler
+		anteHandler   sdk.AnteHandler
+		accountKeeper AccountKeeperI
+
+		/** Broadcasting **/
+		broadcastCosmosTxFn func(txs [][]byte) error
 
 		/** Concurrency **/
 		mtx sync.Mutex
 
 		eventBus *cmttypes.EventBus
+
+		cosmosQueueSub  event.Subscription
+		cosmosQueueDone chan struct{}
 	}
 )
 
@@ -83,6 +97,14 @@ type EVMMempoolConfig struct {
 	BroadCastTxFn    func(txs []*ethtypes.Transaction) error
 	BlockGasLimit    uint64 // Block gas limit from consensus parameters
 	MinTip           *uint256.Int
+	// FeeTokenKeeper optionally enables admitting EVM transactions whose fees are
+	// paid with whitelisted fee tokens instead of the EVM denom.
+	FeeTokenKeeper FeeTokenKeeperI
+	// AccountKeeper optionally enables queueing the Cosmos transactions with a
+	// future sequence until the sequence gap of their signers closes.
+	AccountKeeper       AccountKeeperI
+	CosmosQueueConfig   *CosmosQueueConfig
+	BroadcastCosmosTxFn func(txs [][]byte) error
 }
 
 // NewExperimentalEVMMempool creates a new unified mempool for EVM and Cosmos transactions.
@@ -126,6 +148,9 @@ func NewExperimentalEVMMempool(
 	}
 
 	legacyPool := legacypool.New(legacyConfig, blockchain)
+	if config.FeeTokenKeeper != nil {
+		legacyPool.FeeTokenPayable = feeTokenPayable(blockchain, config.FeeTokenKeeper)
+	}
 
 	txPool, err := txpool.New(uint64(0), blockchain, []txpool.SubPool{legacyPool})
 	if err != nil {
@@ -169,6 +194,11 @@ func NewExperimentalEVMMempool(
 		cosmosPoolConfig = &defaultConfig
 	}
 
+	// batches of EVM transactions are kept in the Cosmos pool, and are ordered
+	// by the sender and nonce of their first EVM transaction
+	if cosmosPoolConfig.SignerExtractor == nil {
+		cosmosPoolConfig.SignerExtractor = NewEthSignerExtractionAdapter(sdkmempool.NewDefaultSignerExtractionAdapter())
+	}
 	cosmosPoolConfig.MaxTx = cosmosPoolMaxTx
 	cosmosPool = sdkmempool.NewPriorityMempool(*cosmosPoolConfig)
 
@@ -184,6 +214,7 @@ func NewExperimentalEVMMempool(
 		blockGasLimit: config.BlockGasLimit,
 		minTip:        config.MinTip,
 		anteHandler:   config.AnteHandler,
+		accountKeeper: config.AccountKeeper,
 	}
 
 	// Set up broadcast function
@@ -196,6 +227,27 @@ func NewExperimentalEVMMempool(
 		legacyPool.BroadcastTxFn = evmMempool.defaultBroadcastTxFn
 	}
 
+	// Queue the Cosmos transactions with a future sequence, and promote them
+	// on new blocks by broadcasting them again once they are executable
+	if config.AccountKeeper != nil {
+		cosmosQueueConfig := DefaultCosmosQueueConfig
+		if config.CosmosQueueConfig != nil {
+			cosmosQueueConfig = config.CosmosQueueConfig.sanitize(logger)
+		}
+		evmMempool.cosmosQueue = newCosmosQueue(cosmosQueueConfig, cosmosPoolConfig.SignerExtractor)
+
+		if config.BroadcastCosmosTxFn != nil {
+			evmMempool.broadcastCosmosTxFn = config.BroadcastCosmosTxFn
+		} else {
+			evmMempool.broadcastCosmosTxFn = evmMempool.defaultBroadcastCosmosTxFn
+		}
+
+		headCh := make(chan core.ChainHeadEvent, chainHeadChanSize)
+		evmMempool.cosmosQueueSub = blockchain.SubscribeChainHeadEvent(headCh)
+		evmMempool.cosmosQueueDone = make(chan struct{})
+		go evmMempool.cosmosQueueLoop(headCh)
+	}
+
 	vmKeeper.SetEvmMempool(evmMempool)
 
 	return evmMempool
@@ -259,13 +311,25 @@ func (m *ExperimentalEVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error
 // InsertInvalidNonce handles transactions that failed with nonce gap errors.
 // It attempts to insert EVM transactions into the pool as non-local transactions,
 // allowing them to be queued for future execution when the nonce gap is filled.
-// Non-EVM transactions are discarded as regular Cosmos flows do not support nonce gaps.
+// Non-EVM transactions with a future sequence are queued until the sequence gap
+// closes if the Cosmos queue is enabled, and discarded otherwise.
 func (m *ExperimentalEVMMempool) InsertInvalidNonce(txBytes []byte) error {
 	tx, err := m.txConfig.TxDecoder()(txBytes)
 	if err != nil {
 		return err
 	}
 
+	if _, err := m.getEVMMessage(tx); err != nil {
+		if m.cosmosQueue == nil {
+			return nil
+		}
+		getSequence, err := m.sequenceGetter()
+		if err != nil {
+			return err
+		}
+		return m.cosmosQueue.add(tx, txBytes, getSequence)
+	}
+
 	var ethTxs []*ethtypes.Transaction
 	msgs := tx.GetMsgs()
 	if len(msgs) != 1 {
@@ -304,7 +368,8 @@ func (m *ExperimentalEVMMempool) Select(goCtx context.Context, i [][]byte) sdkme
 }
 
 // CountTx returns the total number of transactions in both EVM and Cosmos pools.
-// This provides a combined count across all mempool types.
+// This provides a combined count across all mempool types. Queued transactions
+// aren't counted.
 func (m *ExperimentalEVMMempool) CountTx() int {
 	pending, _ := m.txPool.Stats()
 	return m.cosmosPool.CountTx() + pending
@@ -448,6 +513,11 @@ func (m *ExperimentalEVMMempool) Close() error {
 		errs = append(errs, fmt.Errorf("failed to close txpool: %w", err))
 	}
 
+	if m.cosmosQueueSub != nil {
+		m.cosmosQueueSub.Unsubscribe()
+		<-m.cosmosQueueDone
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -496,6 +566,101 @@ func (m *ExperimentalEVMMempool) getIterators(goCtx context.Context, i [][]byte)
 	return orderedEVMPendingTxes, cosmosPendingTxes
 }
 
+// feeTokenPayable returns the check of whether the fees of an EVM transaction
+// can be paid with a whitelisted fee token, which mirrors the fee token
+// selection of the EVM ante handler for senders whose balance doesn't cover
+// the transaction cost.
+func feeTokenPayable(blockchain *Blockchain, feeTokenKeeper FeeTokenKeeperI) func(common.Address, *ethtypes.Transaction) bool {
+	return func(from common.Address, tx *ethtypes.Transaction) bool {
+		maxFees := new(big.Int).Sub(tx.Cost(), tx.Value())
+		if maxFees.Sign() <= 0 {
+			return false
+		}
+
+		ctx, err := blockchain.GetLatestContext()
+		if err != nil {
+			return false
+		}
+		// isolate the read-only queries from concurrent commits
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+		_, ok := feeTokenKeeper.GetFeeTokenPayment(cacheCtx, from.Bytes(), maxFees)
+		return ok
+	}
+}
+
+// cosmosQueueLoop promotes the queued Cosmos transactions that became
+// executable on every new block.
+func (m *ExperimentalEVMMempool) cosmosQueueLoop(headCh <-chan core.ChainHeadEvent) {
+	defer close(m.cosmosQueueDone)
+
+	for {
+		select {
+		case <-headCh:
+			m.promoteCosmosTxs()
+		case <-m.cosmosQueueSub.Err():
+			return
+		}
+	}
+}
+
+// promoteCosmosTxs broadcasts the queued Cosmos transactions whose sequence
+// gap closed, so that they are validated again and inserted into the Cosmos
+// pool.
+func (m *ExperimentalEVMMempool) promoteCosmosTxs() {
+	getSequence, err := m.sequenceGetter()
+	if err != nil {
+		m.logger.Debug("cannot get latest context for promotion, keeping queued Cosmos transactions", "error", err)
+		return
+	}
+
+	txs := m.cosmosQueue.promote(getSequence)
+	if len(txs) == 0 {
+		return
+	}
+
+	m.logger.Debug("promoting queued Cosmos transactions", "tx_count", len(txs))
+	if err := m.broadcastCosmosTxFn(txs); err != nil {
+		m.logger.Error("failed to broadcast promoted Cosmos transactions", "error", err)
+	}
+}
+
+// sequenceGetter returns the function querying the sequence of an account at
+// the latest block.
+func (m *ExperimentalEVMMempool) sequenceGetter() (func(sdk.AccAddress) (uint64, error), error) {
+	ctx, err := m.blockchain.GetLatestContext()
+	if err != nil {
+		return nil, err
+	}
+	// isolate the read-only queries from concurrent commits
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	return func(addr sdk.AccAddress) (uint64, error) {
+		return m.accountKeeper.GetSequence(cacheCtx, addr)
+	}, nil
+}
+
+// defaultBroadcastCosmosTxFn is the default function for broadcasting the
+// promoted Cosmos transactions using the configured client context
+func (m *ExperimentalEVMMempool) defaultBroadcastCosmosTxFn(txs [][]byte) error {
+	m.logger.Debug("broadcasting Cosmos transactions", "tx_count", len(txs))
+
+	var errs []error
+	for _, txBytes := range txs {
+		res, err := m.clientCtx.BroadcastTxSync(txBytes)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to broadcast transaction %X: %w", cmttypes.Tx(txBytes).Hash(), err))
+			continue
+		}
+		if res.Code != 0 {
+			errs = append(errs, fmt.Errorf("transaction %X rejected by mempool: code=%d, log=%s", cmttypes.Tx(txBytes).Hash(), res.Code, res.RawLog))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // defaultBroadcastTxFn is the default function for broadcasting EVM transactions
 // using the configured client context
 func (m *ExperimentalEVMMempool) defaultBroadcastTxFn(txs []*ethtypes.Transaction) error {
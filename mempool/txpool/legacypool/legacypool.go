@@ -88,6 +88,7 @@ var (
 	pendingReplaceMeter   = metrics.NewRegisteredMeter("txpool/pending/replace", nil)
 	pendingRateLimitMeter = metrics.NewRegisteredMeter("txpool/pending/ratelimit", nil) // Dropped due to rate limiting
 	pendingNofundsMeter   = metrics.NewRegisteredMeter("txpool/pending/nofunds", nil)   // Dropped due to out-of-funds
+	pendingDelegateMeter  = metrics.NewRegisteredMeter("txpool/pending/delegate", nil)  // Dropped due to a delegation change
 
 	// Metrics for the queued pool
 	queuedDiscardMeter   = metrics.NewRegisteredMeter("txpool/queued/discard", nil)
@@ -243,7 +244,11 @@ type LegacyPool struct {
 	queue   map[common.Address]*list     // Queued but non-processable transactions
 	beats   map[common.Address]time.Time // Last heartbeat from each known account
 	all     *lookup                      // All transactions to allow lookups
-	priced  *pricedList                  // All transactions sorted by price
+
+	// delegations tracks the EIP-7702 delegation targets of the senders when
+	// their transactions were validated, to invalidate them when it changes.
+	delegations map[common.Address]common.Address
+	priced      *pricedList // All transactions sorted by price
 
 	reqResetCh      chan *txpoolResetRequest
 	reqPromoteCh    chan *accountSet
@@ -256,6 +261,12 @@ type LegacyPool struct {
 	changesSinceReorg int // A counter for how many drops we've performed in-between reorg.
 
 	BroadcastTxFn func(txs []*types.Transaction) error
+
+	// FeeTokenPayable optionally reports whether the fees of a transaction whose
+	// cost is not covered by the balance of its sender can be paid with a
+	// whitelisted fee token, in which case the balance only needs to cover the
+	// transaction value.
+	FeeTokenPayable func(from common.Address, tx *types.Transaction) bool
 }
 
 type txpoolResetRequest struct {
@@ -278,6 +289,7 @@ func New(config Config, chain BlockChain) *LegacyPool {
 		queue:           make(map[common.Address]*list),
 		beats:           make(map[common.Address]time.Time),
 		all:             newLookup(),
+		delegations:     make(map[common.Address]common.Address),
 		reqResetCh:      make(chan *txpoolResetRequest),
 		reqPromoteCh:    make(chan *accountSet),
 		queueTxEventCh:  make(chan *types.Transaction),
@@ -589,18 +601,26 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 		UsedAndLeftSlots: nil, // Pool has own mechanism to limit the number of transactions
 		ExistingExpenditure: func(addr common.Address) *big.Int {
 			if list := pool.pending[addr]; list != nil {
-				return list.totalcost.ToBig()
+				if pool.FeeTokenPayable == nil {
+					return list.totalcost.ToBig()
+				}
+				spent := new(big.Int)
+				for _, tx := range list.Flatten() {
+					spent.Add(spent, pool.balanceCost(addr, tx))
+				}
+				return spent
 			}
 			return new(big.Int)
 		},
 		ExistingCost: func(addr common.Address, nonce uint64) *big.Int {
 			if list := pool.pending[addr]; list != nil {
 				if tx := list.txs.Get(nonce); tx != nil {
-					return tx.Cost()
+					return pool.balanceCost(addr, tx)
 				}
 			}
 			return nil
 		},
+		FeeTokenPayable: pool.FeeTokenPayable,
 	}
 	if err := txpool.ValidateTransactionWithState(tx, pool.signer, opts); err != nil {
 		return err
@@ -608,6 +628,27 @@ func (pool *LegacyPool) validateTx(tx *types.Transaction) error {
 	return pool.validateAuth(tx)
 }
 
+// feeTokenPayableFor returns the fee token check of the transactions sent by the
+// given account, or nil if paying fees with fee tokens is not supported.
+func (pool *LegacyPool) feeTokenPayableFor(from common.Address) func(tx *types.Transaction) bool {
+	if pool.FeeTokenPayable == nil {
+		return nil
+	}
+	return func(tx *types.Transaction) bool {
+		return pool.FeeTokenPayable(from, tx)
+	}
+}
+
+// balanceCost returns the part of the transaction cost that is paid from the
+// balance of its sender, which excludes the fees if they are paid with a fee token.
+func (pool *LegacyPool) balanceCost(from common.Address, tx *types.Transaction) *big.Int {
+	cost := tx.Cost()
+	if pool.FeeTokenPayable != nil && pool.currentState.GetBalance(from).ToBig().Cmp(cost) < 0 && pool.FeeTokenPayable(from, tx) {
+		return tx.Value()
+	}
+	return cost
+}
+
 // checkDelegationLimit determines if the tx sender is delegated or has a
 // pending delegation, and if so, ensures they have at most one in-flight
 // **executable** transaction, e.g. disallow stacked and gapped transactions
@@ -776,6 +817,11 @@ func (pool *LegacyPool) add(tx *types.Transaction) (replaced bool, err error) {
 		}
 	}
 
+	// Track the delegation the transactions of the sender are validated against
+	if _, ok := pool.delegations[from]; !ok {
+		pool.delegations[from] = pool.delegationOf(from)
+	}
+
 	// Try to replace an existing transaction in the pending pool
 	if list := pool.pending[from]; list != nil && list.Contains(tx.Nonce()) {
 		// Nonce already pending, check if required price bump is met
@@ -1307,6 +1353,7 @@ func (pool *LegacyPool) runReorg(done chan struct{}, reset *txpoolResetRequest,
 	// remove any transaction that has been included in the block or was invalidated
 	// because of another transaction (e.g. higher gas price).
 	if reset != nil {
+		pool.dropChangedDelegations()
 		pool.demoteUnexecutables()
 		if reset.newHead != nil {
 			if pool.chainconfig.IsLondon(new(big.Int).Add(reset.newHead.Number, big.NewInt(1))) {
@@ -1402,7 +1449,7 @@ func (pool *LegacyPool) promoteExecutables(accounts []common.Address) []*types.T
 		}
 		log.Trace("Removed old queued transactions", "count", len(forwards))
 		// Drop all transactions that are too costly (low balance or out of gas)
-		drops, _ := list.Filter(pool.currentState.GetBalance(addr), gasLimit)
+		drops, _ := list.Filter(pool.currentState.GetBalance(addr), gasLimit, pool.feeTokenPayableFor(addr))
 		for _, tx := range drops {
 			pool.all.Remove(tx.Hash())
 		}
@@ -1590,7 +1637,7 @@ func (pool *LegacyPool) demoteUnexecutables() {
 			log.Trace("Removed old pending transaction", "hash", hash)
 		}
 		// Drop all transactions that are too costly (low balance or out of gas), and queue any invalids back for later
-		drops, invalids := list.Filter(pool.currentState.GetBalance(addr), gasLimit)
+		drops, invalids := list.Filter(pool.currentState.GetBalance(addr), gasLimit, pool.feeTokenPayableFor(addr))
 		for _, tx := range drops {
 			hash := tx.Hash()
 			pool.all.Remove(hash)
@@ -1629,6 +1676,46 @@ func (pool *LegacyPool) demoteUnexecutables() {
 	}
 }
 
+// delegationOf returns the EIP-7702 delegation target of the account in the
+// current state, or the zero address if the account isn't delegated.
+func (pool *LegacyPool) delegationOf(addr common.Address) common.Address {
+	if codeHash := pool.currentState.GetCodeHash(addr); codeHash == (common.Hash{}) || codeHash == types.EmptyCodeHash {
+		return common.Address{}
+	}
+	target, _ := types.ParseDelegation(pool.currentState.GetCode(addr))
+	return target
+}
+
+// dropChangedDelegations removes all the transactions of the accounts whose
+// EIP-7702 delegation changed since their transactions were validated, since
+// the delegation limits and the execution of the transactions depend on it.
+func (pool *LegacyPool) dropChangedDelegations() {
+	for addr, delegation := range pool.delegations {
+		pending, queued := pool.pending[addr], pool.queue[addr]
+		if pending == nil && queued == nil {
+			delete(pool.delegations, addr)
+			continue
+		}
+		if pool.delegationOf(addr) == delegation {
+			continue
+		}
+
+		var txs types.Transactions
+		if pending != nil {
+			txs = append(txs, pending.Flatten()...)
+		}
+		if queued != nil {
+			txs = append(txs, queued.Flatten()...)
+		}
+		for _, tx := range txs {
+			pool.removeTx(tx.Hash(), true, true)
+		}
+		pendingDelegateMeter.Mark(int64(len(txs)))
+		log.Debug("Removed transactions of account with changed delegation", "address", addr, "count", len(txs))
+		delete(pool.delegations, addr)
+	}
+}
+
 // addressByHeartbeat is an account address tagged with its last activity timestamp.
 type addressByHeartbeat struct {
 	address   common.Address
@@ -1892,6 +1979,7 @@ func (pool *LegacyPool) Clear() {
 	pool.priced.Reheap()
 	pool.pending = make(map[common.Address]*list)
 	pool.queue = make(map[common.Address]*list)
+	pool.delegations = make(map[common.Address]common.Address)
 	pool.pendingNonces = newNoncer(pool.currentState)
 }
 
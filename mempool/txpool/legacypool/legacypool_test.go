@@ -2613,6 +2613,63 @@ func TestSetCodeTransactionsReorg(t *testing.T) {
 	}
 }
 
+// Tests that the transactions of an account are invalidated when its delegation
+// changes, and that the transactions of the other accounts are kept.
+func TestSetCodeTransactionsDelegationChange(t *testing.T) {
+	t.Parallel()
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	blockchain := newTestBlockChain(params.MergedTestChainConfig, 1000000, statedb, new(event.Feed))
+
+	pool := New(testTxPoolConfig, blockchain)
+	pool.Init(testTxPoolConfig.PriceLimit, blockchain.CurrentBlock(), newReserver())
+	defer pool.Close()
+
+	var (
+		keyA, _ = crypto.GenerateKey()
+		keyB, _ = crypto.GenerateKey()
+		addrA   = crypto.PubkeyToAddress(keyA.PublicKey)
+		addrB   = crypto.PubkeyToAddress(keyB.PublicKey)
+	)
+	testAddBalance(pool, addrA, big.NewInt(params.Ether))
+	testAddBalance(pool, addrB, big.NewInt(params.Ether))
+
+	// Send pending and queued transactions from both accounts
+	for _, key := range []*ecdsa.PrivateKey{keyA, keyB} {
+		for _, nonce := range []uint64{0, 1, 3} {
+			if err := pool.addRemoteSync(pricedTransaction(nonce, 100000, big.NewInt(1), key)); err != nil {
+				t.Fatalf("failed to add remote transaction: %v", err)
+			}
+		}
+	}
+	if pending, queued := pool.Stats(); pending != 4 || queued != 2 {
+		t.Fatalf("pool stats mismatch: have %d/%d, want 4/2", pending, queued)
+	}
+
+	// Delegate A through a SetCode transaction included in a block
+	blockchain.statedb.SetCode(addrA, types.AddressToDelegation(common.Address{0x42}))
+	<-pool.requestReset(nil, nil)
+
+	if pending, queued := pool.Stats(); pending != 2 || queued != 1 {
+		t.Fatalf("pool stats mismatch: have %d/%d, want 2/1", pending, queued)
+	}
+	if pool.pending[addrA] != nil || pool.queue[addrA] != nil {
+		t.Fatalf("transactions of the delegated account not removed")
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+
+	// A new transaction of A is validated against its delegation
+	if err := pool.addRemoteSync(pricedTransaction(0, 100000, big.NewInt(1), keyA)); err != nil {
+		t.Fatalf("failed to add remote transaction: %v", err)
+	}
+	<-pool.requestReset(nil, nil)
+	if pool.pending[addrA] == nil {
+		t.Fatalf("transaction of the delegated account removed without delegation change")
+	}
+}
+
 // TestRemoveTxTruncatePoolRace is a regression test for a race condition
 // between removing txs and runReorg loop. Run this with the -race flag to
 // ensure that there is no race condition between the two functions.
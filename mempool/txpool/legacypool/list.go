@@ -362,7 +362,12 @@ func (l *list) Forward(threshold uint64) types.Transactions {
 // a point in calculating all the costs or if the balance covers all. If the threshold
 // is lower than the costgas cap, the caps will be reset to a new high after removing
 // the newly invalidated transactions.
-func (l *list) Filter(costLimit *uint256.Int, gasLimit uint64) (types.Transactions, types.Transactions) {
+//
+// If feeTokenPayable is not nil, the transactions with a cost higher than the
+// threshold are kept if it reports that their fees can be paid with a fee token
+// and the threshold covers their value. The cost cap is then kept above them, so
+// that they are checked again on the next call.
+func (l *list) Filter(costLimit *uint256.Int, gasLimit uint64, feeTokenPayable func(tx *types.Transaction) bool) (types.Transactions, types.Transactions) {
 	// If all transactions are below the threshold, short circuit
 	if l.costcap.Cmp(costLimit) <= 0 && l.gascap <= gasLimit {
 		return nil, nil
@@ -372,7 +377,19 @@ func (l *list) Filter(costLimit *uint256.Int, gasLimit uint64) (types.Transactio
 
 	// Filter out all the transactions above the account's funds
 	removed := l.txs.Filter(func(tx *types.Transaction) bool {
-		return tx.Gas() > gasLimit || tx.Cost().Cmp(costLimit.ToBig()) > 0
+		if tx.Gas() > gasLimit {
+			return true
+		}
+		if tx.Cost().Cmp(costLimit.ToBig()) <= 0 {
+			return false
+		}
+		if feeTokenPayable != nil && tx.Value().Cmp(costLimit.ToBig()) <= 0 && feeTokenPayable(tx) {
+			if cost := uint256.MustFromBig(tx.Cost()); l.costcap.Cmp(cost) < 0 {
+				l.costcap = cost
+			}
+			return false
+		}
+		return true
 	})
 
 	if len(removed) == 0 {
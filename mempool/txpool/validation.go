@@ -206,6 +206,12 @@ type ValidationOptionsWithState struct {
 	// ExistingCost is a mandatory callback to retrieve an already pooled
 	// transaction's cost with the given nonce to check for overdrafts.
 	ExistingCost func(addr common.Address, nonce uint64) *big.Int
+
+	// FeeTokenPayable is an optional callback to check whether the fees of a
+	// transaction whose cost is not covered by the balance of its sender can be
+	// paid with a whitelisted fee token instead. If it returns true, the balance
+	// only needs to cover the transaction value.
+	FeeTokenPayable func(addr common.Address, tx *types.Transaction) bool
 }
 
 // ValidateTransactionWithState is a helper method to check whether a transaction
@@ -236,6 +242,9 @@ func ValidateTransactionWithState(tx *types.Transaction, signer types.Signer, op
 		balance = opts.State.GetBalance(from).ToBig()
 		cost    = tx.Cost()
 	)
+	if balance.Cmp(cost) < 0 && opts.FeeTokenPayable != nil && opts.FeeTokenPayable(from, tx) {
+		cost = tx.Value()
+	}
 	if balance.Cmp(cost) < 0 {
 		return fmt.Errorf("%w: balance %v, tx cost %v, overshot %v", core.ErrInsufficientFunds, balance, cost, new(big.Int).Sub(cost, balance))
 	}
@@ -2,6 +2,21 @@
 pragma solidity >=0.8.18;
 
 interface ICallbacks {
+    /// @dev Callback function to be called on the source chain
+    /// when a packet naming the contract as source callback is sent,
+    /// if the send callback is enabled in the callback data.
+    /// Reverting aborts the send, so the packet is never committed.
+    /// @param channelId the channnel identifier of the packet
+    /// @param portId the port identifier of the packet
+    /// @param timeoutTimestamp the timeout timestamp of the packet
+    /// @param data the data of the packet
+    function onPacketSend(
+        string memory channelId,
+        string memory portId,
+        uint64 timeoutTimestamp,
+        bytes memory data
+    ) external;
+
     /// @dev Callback function to be called on the source chain
     /// after the packet life cycle is completed and acknowledgement is processed
     /// by source chain. The contract address is passed the packet information and acknowledgmeent
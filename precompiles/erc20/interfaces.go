@@ -5,6 +5,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,3 +16,9 @@ type Erc20Keeper interface {
 	SetAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address, value *big.Int) error
 	DeleteAllowance(ctx sdk.Context, erc20 common.Address, owner common.Address, spender common.Address) error
 }
+
+// ExtendedDenomKeeper defines the expected interface of the bank keepers that
+// extend the precision of some denoms to 18 decimals, e.g. x/precisebank.
+type ExtendedDenomKeeper interface {
+	GetExtendedDenom(ctx sdk.Context, integerDenom string) (precisebanktypes.ExtendedDenom, bool)
+}
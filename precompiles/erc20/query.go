@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/cosmos/evm/ibc"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -83,9 +84,10 @@ func (p Precompile) Symbol(
 	return method.Outputs.Pack(symbol)
 }
 
-// Decimals returns the decimals places of the token. If the token metadata is registered in the
-// bank module, it returns the display denomination exponent. Otherwise, it infers the decimal
-// value from the first character of the base denomination (e.g. uatom -> 6).
+// Decimals returns the decimals places of the token. If the token is extended to 18 decimals
+// by the bank keeper, it returns 18. If the token metadata is registered in the bank module,
+// it returns the display denomination exponent. Otherwise, it infers the decimal value from
+// the first character of the base denomination (e.g. uatom -> 6).
 func (p Precompile) Decimals(
 	ctx sdk.Context,
 	_ *vm.Contract,
@@ -93,6 +95,10 @@ func (p Precompile) Decimals(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
+	if _, extended := p.denom(ctx); extended {
+		return method.Outputs.Pack(uint8(evmtypes.EighteenDecimals))
+	}
+
 	metadata, found := p.BankKeeper.GetDenomMetaData(ctx, p.tokenPair.Denom)
 	if !found {
 		denom, err := ibc.GetDenom(p.transferKeeper, ctx, p.tokenPair.Denom)
@@ -153,7 +159,8 @@ func (p Precompile) TotalSupply(
 	method *abi.Method,
 	_ []interface{},
 ) ([]byte, error) {
-	supply := p.BankKeeper.GetSupply(ctx, p.tokenPair.Denom)
+	denom, _ := p.denom(ctx)
+	supply := p.BankKeeper.GetSupply(ctx, denom)
 
 	return method.Outputs.Pack(supply.Amount.BigInt())
 }
@@ -172,7 +179,8 @@ func (p Precompile) BalanceOf(
 		return nil, err
 	}
 
-	balance := p.BankKeeper.SpendableCoin(ctx, account.Bytes(), p.tokenPair.Denom)
+	denom, _ := p.denom(ctx)
+	balance := p.BankKeeper.SpendableCoin(ctx, account.Bytes(), denom)
 
 	return method.Outputs.Pack(balance.Amount.BigInt())
 }
@@ -217,3 +225,20 @@ func (p Precompile) getBaseDenomFromIBCVoucher(ctx sdk.Context, voucherDenom str
 
 	return denom.Base, nil
 }
+
+// denom returns the bank denom used for the token balances, which is the
+// extended denom if the bank keeper extends the token pair denom to 18
+// decimals, and the token pair denom otherwise.
+func (p Precompile) denom(ctx sdk.Context) (string, bool) {
+	edk, ok := p.BankKeeper.(ExtendedDenomKeeper)
+	if !ok {
+		return p.tokenPair.Denom, false
+	}
+
+	ed, found := edk.GetExtendedDenom(ctx, p.tokenPair.Denom)
+	if !found {
+		return p.tokenPair.Denom, false
+	}
+
+	return ed.ExtendedDenom, true
+}
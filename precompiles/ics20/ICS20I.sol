@@ -73,6 +73,58 @@ interface ICS20I {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev TransferMultiHop defines a method for performing an IBC transfer whose
+    /// tokens are forwarded through intermediate chains before reaching the receiver.
+    /// Each intermediate chain must support packet-forward-middleware style memos.
+    /// @param sourcePort the port on which the packet will be sent
+    /// @param sourceChannel the channel by which the packet will be sent
+    /// @param denom the denomination of the Coin to be transferred to the receiver
+    /// @param amount the amount of the Coin to be transferred to the receiver
+    /// @param sender the hex address of the sender
+    /// @param receiver the address of the receiver on the final chain
+    /// @param route the port and channel on which each intermediate chain forwards the tokens,
+    /// in order. The transfer is sent directly to the receiver when empty
+    /// @param timeoutHeight the timeout height relative to the current block height.
+    /// The timeout is disabled when set to 0
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch.
+    /// The timeout is disabled when set to 0
+    /// @param memo optional memo, set on the packet received by the final chain.
+    /// It must be a JSON object when the route is not empty
+    /// @return nextSequence sequence number of the transfer packet sent
+    function transferMultiHop(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        address sender,
+        string memory receiver,
+        Hop[] memory route,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 nextSequence);
+
+    /// @dev TransferV2 defines a method for performing an IBC transfer over an
+    /// IBC v2 client-to-client route.
+    /// @param sourceClient the client ID by which the packet will be sent
+    /// @param denom the denomination of the Coin to be transferred to the receiver
+    /// @param amount the amount of the Coin to be transferred to the receiver
+    /// @param sender the hex address of the sender
+    /// @param receiver the bech32 address of the receiver
+    /// @param timeoutTimestamp the timeout timestamp in absolute seconds since unix epoch.
+    /// It must be set, as IBC v2 packets only time out by timestamp
+    /// @param memo optional memo
+    /// @return nextSequence sequence number of the transfer packet sent
+    function transferV2(
+        string memory sourceClient,
+        string memory denom,
+        uint256 amount,
+        address sender,
+        string memory receiver,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 nextSequence);
+
     /// @dev denoms Defines a method for returning all denoms.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denoms(
@@ -5,12 +5,16 @@ const (
 	ErrInvalidSourcePort = "invalid source port"
 	// ErrInvalidSourceChannel is raised when the source channel is invalid.
 	ErrInvalidSourceChannel = "invalid source port"
+	// ErrInvalidSourceClient is raised when the source client is invalid.
+	ErrInvalidSourceClient = "invalid source client: %s"
 	// ErrInvalidSender is raised when the sender is invalid.
 	ErrInvalidSender = "invalid sender: %s"
 	// ErrInvalidReceiver is raised when the receiver is invalid.
 	ErrInvalidReceiver = "invalid receiver: %s"
 	// ErrInvalidTimeoutTimestamp is raised when the timeout timestamp is invalid.
 	ErrInvalidTimeoutTimestamp = "invalid timeout timestamp: %d"
+	// ErrInvalidRoute is raised when the multi-hop route is invalid.
+	ErrInvalidRoute = "invalid route: %s"
 	// ErrInvalidMemo is raised when the memo is invalid.
 	ErrInvalidMemo = "invalid memo: %s"
 	// ErrInvalidHash is raised when the hash is invalid.
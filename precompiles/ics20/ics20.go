@@ -105,6 +105,10 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, contract, stateDB, method, args)
+	case TransferMultiHopMethod:
+		bz, err = p.TransferMultiHop(ctx, contract, stateDB, method, args)
+	case TransferV2Method:
+		bz, err = p.TransferV2(ctx, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomMethod:
 		bz, err = p.Denom(ctx, contract, method, args)
@@ -123,9 +127,11 @@ func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Co
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - TransferMultiHop
+//   - TransferV2
 func (Precompile) IsTransaction(method *abi.Method) bool {
 	switch method.Name {
-	case TransferMethod:
+	case TransferMethod, TransferMultiHopMethod, TransferV2Method:
 		return true
 	default:
 		return false
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
@@ -23,6 +24,12 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferMultiHopMethod defines the ABI method name for the ICS20 Transfer
+	// transaction forwarded through intermediate chains.
+	TransferMultiHopMethod = "transferMultiHop"
+	// TransferV2Method defines the ABI method name for the ICS20 Transfer
+	// transaction over an IBC v2 client-to-client route.
+	TransferV2Method = "transferV2"
 )
 
 // validateV1TransferChannel does the following validation on an ibc v1 channel specified in a MsgTransfer:
@@ -114,6 +121,59 @@ func (p *Precompile) Transfer(
 		)
 	}
 
+	return p.transfer(ctx, contract, stateDB, method, msg, sender)
+}
+
+// TransferMultiHop implements the ICS20 transfer transactions whose tokens are
+// forwarded through a route of intermediate chains, using packet-forward-middleware
+// style memos.
+func (p *Precompile) TransferMultiHop(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, sender, err := NewMsgTransferMultiHop(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// forwarding is only supported over IBC v1 channels
+	if err := p.validateV1TransferChannel(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return p.transfer(ctx, contract, stateDB, method, msg, sender)
+}
+
+// TransferV2 implements the ICS20 transfer transactions over IBC v2
+// client-to-client routes, where the packet is sent by source client ID.
+func (p *Precompile) TransferV2(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, sender, err := NewMsgTransferV2(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.transfer(ctx, contract, stateDB, method, msg, sender)
+}
+
+// transfer executes the validated ICS20 transfer message on behalf of the
+// sender and emits the IBCTransfer event.
+func (p *Precompile) transfer(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	msg *transfertypes.MsgTransfer,
+	sender common.Address,
+) ([]byte, error) {
 	msgSender := contract.Caller()
 	if msgSender != sender {
 		return nil, fmt.Errorf(cmn.ErrRequesterIsNotMsgSender, msgSender.String(), sender.String())
@@ -10,8 +10,11 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	cmn "github.com/cosmos/evm/precompiles/common"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -140,6 +143,119 @@ func NewMsgTransfer(method *abi.Method, args []interface{}) (*transfertypes.MsgT
 	return msg, sender, nil
 }
 
+// route is a struct used to parse the route parameter used as input in the
+// transferMultiHop method
+type route struct {
+	Route []transfertypes.Hop
+}
+
+// NewMsgTransferMultiHop returns a new transfer message from the given
+// arguments, whose tokens are forwarded through the given route by the
+// intermediate chains before reaching the receiver.
+func NewMsgTransferMultiHop(method *abi.Method, args []interface{}) (*transfertypes.MsgTransfer, common.Address, error) {
+	if len(args) != 10 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 10, len(args))
+	}
+
+	var input route
+	routeArg := abi.Arguments{method.Inputs[6]}
+	if err := routeArg.Copy(&input, []interface{}{args[6]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidRoute, err)
+	}
+
+	// the remaining arguments are the same as the ones of the transfer method
+	transferMethod := ABI.Methods[TransferMethod]
+	transferArgs := append(append([]interface{}{}, args[:6]...), args[7:]...)
+	msg, sender, err := NewMsgTransfer(&transferMethod, transferArgs)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	if len(input.Route) == 0 {
+		return msg, sender, nil
+	}
+
+	memo, err := erc20types.NewForwardMemo(msg.Receiver, input.Route, msg.Memo)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidRoute, err)
+	}
+
+	// the receiver on the next chain is derived from the packet when forwarding
+	msg.Receiver = erc20types.ForwardReceiverPlaceholder
+	msg.Memo = memo
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, sender, nil
+}
+
+// NewMsgTransferV2 returns a new transfer message over an IBC v2 client-to-client
+// route from the given arguments.
+func NewMsgTransferV2(args []interface{}) (*transfertypes.MsgTransfer, common.Address, error) {
+	if len(args) != 7 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+
+	sourceClient, ok := args[0].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSourceClient, args[0])
+	}
+
+	// a channel ID would route the packet over IBC v1
+	if channeltypes.IsChannelIDFormat(sourceClient) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSourceClient, sourceClient)
+	}
+	if err := host.ClientIdentifierValidator(sourceClient); err != nil {
+		return nil, common.Address{}, errorsmod.Wrapf(err, ErrInvalidSourceClient, sourceClient)
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidDenomForTransfer, cmn.ErrInvalidDenom, args[1])
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok || amount == nil {
+		return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, args[2])
+	}
+
+	sender, ok := args[3].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSender, args[3])
+	}
+
+	receiver, ok := args[4].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, args[4])
+	}
+
+	// IBC v2 packets only support timestamp based timeouts
+	timeoutTimestamp, ok := args[5].(uint64)
+	if !ok || timeoutTimestamp == 0 {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTimeoutTimestamp, args[5])
+	}
+
+	memo, ok := args[6].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[6])
+	}
+
+	// Use instance to prevent errors on denom or amount
+	token := sdk.Coin{
+		Denom:  denom,
+		Amount: math.NewIntFromBigInt(amount),
+	}
+
+	msg, err := CreateAndValidateMsgTransfer(transfertypes.PortID, sourceClient, token, sdk.AccAddress(sender.Bytes()).String(), receiver, DefaultTimeoutHeight, timeoutTimestamp, memo)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, sender, nil
+}
+
 // CreateAndValidateMsgTransfer creates a new MsgTransfer message and run validate basic.
 func CreateAndValidateMsgTransfer(
 	sourcePort, sourceChannel string,
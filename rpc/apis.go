@@ -3,10 +3,12 @@ package rpc
 import (
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	evmmempool "github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/rpc/backend"
+	"github.com/cosmos/evm/rpc/namespaces/ethereum/bundler"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/debug"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth"
 	"github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
@@ -38,6 +40,10 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// BundlerNamespace enables the ERC-4337 bundler methods, which are
+	// served under the eth namespace.
+	BundlerNamespace = "bundler"
+
 	apiVersion = "1.0"
 )
 
@@ -167,6 +173,51 @@ func init() {
 				},
 			}
 		},
+		BundlerNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *stream.RPCStream,
+			allowUnprotectedTxs bool,
+			indexer servertypes.EVMTxIndexer,
+			mempool *evmmempool.ExperimentalEVMMempool,
+		) []rpc.API {
+			if mempool == nil {
+				ctx.Logger.Error("the ERC-4337 bundler requires the EVM mempool")
+				return nil
+			}
+
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, mempool)
+			cfg := evmBackend.GetConfig().JSONRPC.Bundler
+			if clientCtx.Keyring == nil {
+				ctx.Logger.Error("the ERC-4337 bundler requires the node's keyring")
+				return nil
+			}
+			key, err := clientCtx.Keyring.Key(cfg.Key)
+			if err != nil {
+				ctx.Logger.Error("failed to find the ERC-4337 bundler key in the keyring", "key", cfg.Key, "error", err.Error())
+				return nil
+			}
+			addr, err := key.GetAddress()
+			if err != nil {
+				ctx.Logger.Error("failed to get the ERC-4337 bundler address", "key", cfg.Key, "error", err.Error())
+				return nil
+			}
+
+			return []rpc.API{
+				{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service: bundler.NewPublicAPI(
+						ctx.Logger,
+						evmBackend,
+						mempool.GetTxPool(),
+						clientCtx.Keyring,
+						common.BytesToAddress(addr),
+						cfg,
+					),
+					Public: true,
+				},
+			}
+		},
 	}
 }
 
@@ -0,0 +1,302 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// jwtExpiryTimeout is the maximum difference between the issuance time of
+	// a JWT token and the current time, as in the Engine API.
+	jwtExpiryTimeout = 60 * time.Second
+	// maxRequestSize is the maximum size of the HTTP requests read to check
+	// their methods, the body limit of the rpc server.
+	maxRequestSize = 5 * 1024 * 1024
+	// apiKeyHeader is the header holding the API key of a request.
+	apiKeyHeader = "X-API-Key"
+)
+
+// JSON-RPC error codes of the requests rejected by the Authenticator.
+const (
+	errCodeUnauthorized   = -32001
+	errCodeMethodDenied   = -32601
+	errCodeInvalidRequest = -32600
+)
+
+// authError is returned when a request is rejected by the Authenticator.
+type authError struct {
+	code    int
+	status  int
+	message string
+}
+
+func (e *authError) Error() string { return e.message }
+
+// ErrorCode returns the JSON-RPC error code of the error.
+func (e *authError) ErrorCode() int { return e.code }
+
+// Authenticator authenticates the JSON-RPC requests with JWT tokens or static
+// API keys, and controls the methods they can call.
+type Authenticator struct {
+	jwtSecret     []byte
+	apiKeys       [][]byte
+	publicMethods []string
+	deniedMethods []string
+	// proxyToken authenticates the requests forwarded by the websocket server,
+	// whose methods are checked before forwarding.
+	proxyToken string
+}
+
+// NewAuthenticator creates the Authenticator of the configuration, it returns
+// nil if the authentication is disabled.
+func NewAuthenticator(cfg config.AuthConfig) (*Authenticator, error) {
+	if !cfg.Enable {
+		return nil, nil
+	}
+
+	a := &Authenticator{
+		publicMethods: cfg.PublicMethods,
+		deniedMethods: cfg.DeniedMethods,
+	}
+	if cfg.JWTSecretFile != "" {
+		secret, err := loadJWTSecret(cfg.JWTSecretFile)
+		if err != nil {
+			return nil, err
+		}
+		a.jwtSecret = secret
+	}
+	for _, key := range cfg.APIKeys {
+		a.apiKeys = append(a.apiKeys, []byte(key))
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, errors.Wrap(err, "failed to generate the websocket proxy token")
+	}
+	a.proxyToken = hex.EncodeToString(token)
+	return a, nil
+}
+
+// loadJWTSecret reads the hex-encoded 32 bytes secret of the file.
+func loadJWTSecret(file string) ([]byte, error) {
+	bz, err := os.ReadFile(file) //#nosec G304 -- the file is set by the node operator
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the JWT secret file")
+	}
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid JWT secret")
+	}
+	if len(secret) != 32 {
+		return nil, fmt.Errorf("invalid JWT secret length, expected 32 bytes, got %d", len(secret))
+	}
+	return secret, nil
+}
+
+// apiKeyContextKey is the context key of the API key which authenticated a
+// request.
+type apiKeyContextKey struct{}
+
+// Authenticate returns true if the request carries valid credentials, and an
+// error if it carries invalid ones.
+func (a *Authenticator) Authenticate(r *http.Request) (bool, error) {
+	_, authenticated, err := a.authenticate(r)
+	return authenticated, err
+}
+
+// authenticateRequest authenticates the request as Authenticate, and returns
+// it with the API key which authenticated it in its context.
+func (a *Authenticator) authenticateRequest(r *http.Request) (*http.Request, bool, error) {
+	apiKey, authenticated, err := a.authenticate(r)
+	if apiKey != "" {
+		r = r.WithContext(context.WithValue(r.Context(), apiKeyContextKey{}, apiKey))
+	}
+	return r, authenticated, err
+}
+
+// authenticate returns true if the request carries valid credentials, along
+// with the API key if it authenticated the request.
+func (a *Authenticator) authenticate(r *http.Request) (string, bool, error) {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(a.proxyToken)) == 1 {
+			return "", true, nil
+		}
+		if err := a.verifyJWT(token); err != nil {
+			return "", false, &authError{code: errCodeUnauthorized, status: http.StatusUnauthorized, message: err.Error()}
+		}
+		return "", true, nil
+	}
+	if key := r.Header.Get(apiKeyHeader); key != "" {
+		for _, apiKey := range a.apiKeys {
+			if subtle.ConstantTimeCompare([]byte(key), apiKey) == 1 {
+				return key, true, nil
+			}
+		}
+		return "", false, &authError{code: errCodeUnauthorized, status: http.StatusUnauthorized, message: "invalid API key"}
+	}
+	return "", false, nil
+}
+
+// verifyJWT returns an error if the token is not an HS256 token signed with
+// the JWT secret and issued within the expiry timeout.
+func (a *Authenticator) verifyJWT(token string) error {
+	if a.jwtSecret == nil {
+		return errors.New("JWT authentication is not enabled")
+	}
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return a.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return errors.Wrap(err, "invalid JWT token")
+	}
+	if claims.IssuedAt == nil {
+		return errors.New("missing JWT issued-at claim")
+	}
+	if diff := time.Since(claims.IssuedAt.Time); diff > jwtExpiryTimeout || diff < -jwtExpiryTimeout {
+		return errors.New("stale JWT token")
+	}
+	return nil
+}
+
+// CheckMethod returns an error if the method is denied, or if it is not
+// public and the client is not authenticated.
+func (a *Authenticator) CheckMethod(method string, authenticated bool) error {
+	if matchMethod(a.deniedMethods, method) {
+		return &authError{code: errCodeMethodDenied, status: http.StatusForbidden, message: fmt.Sprintf("method %s is not allowed", method)}
+	}
+	if !authenticated && !matchMethod(a.publicMethods, method) {
+		return &authError{code: errCodeUnauthorized, status: http.StatusUnauthorized, message: fmt.Sprintf("method %s requires authentication", method)}
+	}
+	return nil
+}
+
+// CheckRequest returns an error if one of the methods of the single or batch
+// request cannot be called.
+func (a *Authenticator) CheckRequest(body []byte, authenticated bool) error {
+	methods, err := requestMethods(body)
+	if err != nil {
+		return &authError{code: errCodeInvalidRequest, status: http.StatusBadRequest, message: err.Error()}
+	}
+	for _, method := range methods {
+		if err := a.CheckMethod(method, authenticated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Handler returns the handler authenticating the requests and checking their
+// methods before serving them with next.
+func (a *Authenticator) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, authenticated, err := a.authenticateRequest(r)
+		if err != nil {
+			writeAuthError(w, err)
+			return
+		}
+
+		body, err := readRequestBody(r)
+		if err != nil {
+			writeAuthError(w, err)
+			return
+		}
+		if err := a.CheckRequest(body, authenticated); err != nil {
+			writeAuthError(w, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// readRequestBody reads the body of the request and replaces it with a copy,
+// so it can be read again by the next handlers.
+func readRequestBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestSize+1))
+	if err != nil {
+		return nil, &authError{code: errCodeInvalidRequest, status: http.StatusBadRequest, message: err.Error()}
+	}
+	if len(body) > maxRequestSize {
+		return nil, &authError{code: errCodeInvalidRequest, status: http.StatusRequestEntityTooLarge, message: "request too large"}
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// writeAuthError writes the JSON-RPC error response of the rejected request.
+func writeAuthError(w http.ResponseWriter, err error) {
+	status, code := http.StatusUnauthorized, errCodeUnauthorized
+	var authErr *authError
+	if errors.As(err, &authErr) {
+		status, code = authErr.status, authErr.code
+	}
+	writeErrorResponse(w, status, code, err.Error())
+}
+
+// writeErrorResponse writes a JSON-RPC error response with the HTTP status.
+func writeErrorResponse(w http.ResponseWriter, status, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(&ErrorResponseJSON{ // #nosec G703
+		Jsonrpc: "2.0",
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(int64(code)),
+			Message: msg,
+		},
+	})
+}
+
+// requestMethods returns the methods of the single or batch request.
+func requestMethods(body []byte) ([]string, error) {
+	type request struct {
+		Method string `json:"method"`
+	}
+
+	if isBatch(body) {
+		var reqs []request
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return nil, errors.Wrap(err, "invalid batch request")
+		}
+		methods := make([]string, len(reqs))
+		for i, req := range reqs {
+			methods[i] = req.Method
+		}
+		return methods, nil
+	}
+
+	var req request
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, errors.Wrap(err, "invalid request")
+	}
+	return []string{req.Method}, nil
+}
+
+// matchMethod returns true if the method matches one of the method names or
+// wildcards of the patterns.
+func matchMethod(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if pattern == "*" || pattern == method {
+			return true
+		}
+		if namespace, ok := strings.CutSuffix(pattern, "_*"); ok && strings.HasPrefix(method, namespace+"_") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+var testJWTSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestAuthenticator(t *testing.T) *Authenticator {
+	t.Helper()
+	secretFile := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(secretFile, []byte("0x"+hex.EncodeToString(testJWTSecret)+"\n"), 0o600))
+
+	auth, err := NewAuthenticator(config.AuthConfig{
+		Enable:        true,
+		JWTSecretFile: secretFile,
+		APIKeys:       []string{"key1", "key2"},
+		PublicMethods: []string{"eth_*", "web3_clientVersion"},
+		DeniedMethods: []string{"eth_sign", "personal_*"},
+	})
+	require.NoError(t, err)
+	return auth
+}
+
+func newTestJWT(t *testing.T, secret []byte, issuedAt time.Time) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		IssuedAt: jwt.NewNumericDate(issuedAt),
+	}).SignedString(secret)
+	require.NoError(t, err)
+	return token
+}
+
+func TestNewAuthenticatorDisabled(t *testing.T) {
+	auth, err := NewAuthenticator(config.DefaultAuthConfig())
+	require.NoError(t, err)
+	require.Nil(t, auth)
+}
+
+func TestAuthenticate(t *testing.T) {
+	auth := newTestAuthenticator(t)
+
+	testCases := []struct {
+		name     string
+		header   string
+		value    string
+		expAuth  bool
+		expError bool
+	}{
+		{"no credentials", "", "", false, false},
+		{"valid API key", apiKeyHeader, "key2", true, false},
+		{"invalid API key", apiKeyHeader, "key3", false, true},
+		{"valid JWT", "Authorization", "Bearer " + newTestJWT(t, testJWTSecret, time.Now()), true, false},
+		{"stale JWT", "Authorization", "Bearer " + newTestJWT(t, testJWTSecret, time.Now().Add(-2*time.Minute)), false, true},
+		{"JWT with another secret", "Authorization", "Bearer " + newTestJWT(t, []byte("another secret"), time.Now()), false, true},
+		{"websocket proxy token", "Authorization", "Bearer " + auth.proxyToken, true, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.header != "" {
+				req.Header.Set(tc.header, tc.value)
+			}
+			authenticated, err := auth.Authenticate(req)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expAuth, authenticated)
+		})
+	}
+}
+
+func TestCheckMethod(t *testing.T) {
+	auth := newTestAuthenticator(t)
+
+	testCases := []struct {
+		method        string
+		authenticated bool
+		expCode       int
+	}{
+		{"eth_blockNumber", false, 0},
+		{"web3_clientVersion", false, 0},
+		{"web3_sha3", false, errCodeUnauthorized},
+		{"debug_traceTransaction", false, errCodeUnauthorized},
+		{"debug_traceTransaction", true, 0},
+		{"eth_sign", true, errCodeMethodDenied},
+		{"personal_listAccounts", true, errCodeMethodDenied},
+	}
+	for _, tc := range testCases {
+		err := auth.CheckMethod(tc.method, tc.authenticated)
+		if tc.expCode == 0 {
+			require.NoError(t, err, tc.method)
+			continue
+		}
+		var authErr *authError
+		require.ErrorAs(t, err, &authErr, tc.method)
+		require.Equal(t, tc.expCode, authErr.ErrorCode(), tc.method)
+	}
+}
+
+func TestAuthHandler(t *testing.T) {
+	auth := newTestAuthenticator(t)
+	handler := auth.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		_, _ = w.Write(body)
+	}))
+
+	testCases := []struct {
+		name      string
+		body      string
+		apiKey    string
+		expStatus int
+	}{
+		{"public method", `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, "", http.StatusOK},
+		{"private method", `{"jsonrpc":"2.0","id":1,"method":"debug_getRawBlock"}`, "", http.StatusUnauthorized},
+		{"authenticated private method", `{"jsonrpc":"2.0","id":1,"method":"debug_getRawBlock"}`, "key1", http.StatusOK},
+		{"batch with a private method", `[{"method":"eth_chainId"},{"method":"debug_getRawBlock"}]`, "", http.StatusUnauthorized},
+		{"denied method", `{"jsonrpc":"2.0","id":1,"method":"eth_sign"}`, "key1", http.StatusForbidden},
+		{"invalid API key", `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, "invalid", http.StatusUnauthorized},
+		{"invalid request", `{"jsonrpc"`, "", http.StatusBadRequest},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.body))
+			if tc.apiKey != "" {
+				req.Header.Set(apiKeyHeader, tc.apiKey)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tc.expStatus, rec.Code)
+			if tc.expStatus == http.StatusOK {
+				// the request is served with its body
+				require.Equal(t, tc.body, rec.Body.String())
+				return
+			}
+			var res ErrorResponseJSON
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.NotNil(t, res.Error)
+		})
+	}
+}
+
+func TestWebsocketAuth(t *testing.T) {
+	srv := newTestWebsocketServer()
+	srv.auth = newTestAuthenticator(t)
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	// invalid credentials are rejected before the upgrade
+	_, httpResp, err := websocket.DefaultDialer.Dial(u.String(), http.Header{apiKeyHeader: []string{"invalid"}})
+	require.Error(t, err)
+	require.Equal(t, http.StatusUnauthorized, httpResp.StatusCode)
+	httpResp.Body.Close()
+
+	conn, httpResp, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	require.NoError(t, err)
+	httpResp.Body.Close()
+	defer conn.Close()
+
+	// the private methods of the unauthenticated connections are rejected
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"debug_getRawBlock"}`)))
+	var res ErrorResponseJSON
+	require.NoError(t, conn.ReadJSON(&res))
+	require.NotNil(t, res.Error)
+	require.Equal(t, int64(errCodeUnauthorized), res.Error.Code.Int64())
+}
@@ -116,6 +116,7 @@ type EVMBackend interface {
 	// Send Transaction
 	Resend(ctx context.Context, args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionBatch(ctx context.Context, data []hexutil.Bytes) ([]common.Hash, error)
 	SetTxDefaults(ctx context.Context, args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash *types.BlockNumberOrHash, overrides *json.RawMessage) (hexutil.Uint64, error)
 	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr types.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
@@ -124,6 +125,7 @@ type EVMBackend interface {
 	// Filter API
 	GetLogs(ctx context.Context, hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	GetLogsFromIndexer(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) ([]*ethtypes.Log, int64, error)
 	BloomStatus() (uint64, uint64)
 
 	// TxPool API
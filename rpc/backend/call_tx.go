@@ -111,37 +111,13 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 	ctx, span := tracer.Start(ctx, "SendRawTransaction")
 	defer func() { evmtrace.EndSpanErr(span, err) }()
 
-	// RLP decode raw transaction bytes
-	tx := &ethtypes.Transaction{}
-	if err := tx.UnmarshalBinary(data); err != nil {
-		b.Logger.Error("transaction decoding failed", "error", err.Error())
+	tx, ethereumTx, err := b.decodeRawTransaction(data)
+	if err != nil {
 		return common.Hash{}, err
 	}
 
 	span.SetAttributes(attribute.String("tx_hash", tx.Hash().Hex()))
-
-	// check the local node config in case unprotected txs are disabled
-	if !b.UnprotectedAllowed() {
-		if !tx.Protected() {
-			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
-			return common.Hash{}, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
-		}
-		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
-			return common.Hash{}, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
-		}
-	}
-
-	ethereumTx := &evmtypes.MsgEthereumTx{}
 	ethSigner := ethtypes.LatestSigner(b.ChainConfig())
-	if err := ethereumTx.FromSignedEthereumTx(tx, ethSigner); err != nil {
-		b.Logger.Error("transaction converting failed", "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to convert ethereum transaction: %w", err)
-	}
-
-	if err := ethereumTx.ValidateBasic(); err != nil {
-		b.Logger.Debug("tx failed basic validation", "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to validate transaction: %w", err)
-	}
 
 	baseDenom := evmtypes.GetEVMCoinDenom()
 
@@ -204,6 +180,90 @@ func (b *Backend) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (r
 	return txHash, nil
 }
 
+// SendRawTransactionBatch sends raw Ethereum transactions as an atomic batch
+// in a single Cosmos transaction. It returns the hashes of the Ethereum
+// transactions, which can be used to query their receipts.
+func (b *Backend) SendRawTransactionBatch(ctx context.Context, data []hexutil.Bytes) (result []common.Hash, err error) {
+	_, span := tracer.Start(ctx, "SendRawTransactionBatch")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if len(data) == 0 {
+		return nil, errors.New("empty transaction batch")
+	}
+
+	msgs := make([]*evmtypes.MsgEthereumTx, len(data))
+	hashes := make([]common.Hash, len(data))
+	for i, raw := range data {
+		tx, ethereumTx, err := b.decodeRawTransaction(raw)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+		msgs[i] = ethereumTx
+		hashes[i] = tx.Hash()
+	}
+
+	span.SetAttributes(attribute.Int("batch_size", len(data)))
+
+	cosmosTx, err := evmtypes.BuildBatchTx(b.ClientCtx.TxConfig.NewTxBuilder(), evmtypes.GetEVMCoinDenom(), msgs...)
+	if err != nil {
+		b.Logger.Error("failed to build cosmos tx", "error", err.Error())
+		return nil, fmt.Errorf("failed to build cosmos tx: %w", err)
+	}
+
+	txBytes, err := b.ClientCtx.TxConfig.TxEncoder()(cosmosTx)
+	if err != nil {
+		b.Logger.Error("failed to encode eth tx batch using default encoder", "error", err.Error())
+		return nil, fmt.Errorf("failed to encode transaction: %w", err)
+	}
+
+	syncCtx := b.ClientCtx.WithBroadcastMode(flags.BroadcastSync)
+	rsp, err := syncCtx.BroadcastTx(txBytes)
+	if rsp != nil && rsp.Code != 0 {
+		err = errorsmod.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+	}
+	if err != nil {
+		b.Logger.Error("failed to broadcast tx batch", "error", err.Error())
+		return nil, fmt.Errorf("failed to broadcast transaction batch: %w", err)
+	}
+
+	return hashes, nil
+}
+
+// decodeRawTransaction decodes a raw Ethereum transaction and converts it into
+// a validated MsgEthereumTx.
+func (b *Backend) decodeRawTransaction(data hexutil.Bytes) (*ethtypes.Transaction, *evmtypes.MsgEthereumTx, error) {
+	// RLP decode raw transaction bytes
+	tx := &ethtypes.Transaction{}
+	if err := tx.UnmarshalBinary(data); err != nil {
+		b.Logger.Error("transaction decoding failed", "error", err.Error())
+		return nil, nil, err
+	}
+
+	// check the local node config in case unprotected txs are disabled
+	if !b.UnprotectedAllowed() {
+		if !tx.Protected() {
+			// Ensure only eip155 signed transactions are submitted if EIP155Required is set.
+			return nil, nil, errors.New("only replay-protected (EIP-155) transactions allowed over RPC")
+		}
+		if tx.ChainId().Uint64() != b.EvmChainID.Uint64() {
+			return nil, nil, fmt.Errorf("incorrect chain-id; expected %d, got %d", b.EvmChainID, tx.ChainId())
+		}
+	}
+
+	ethereumTx := &evmtypes.MsgEthereumTx{}
+	if err := ethereumTx.FromSignedEthereumTx(tx, ethtypes.LatestSigner(b.ChainConfig())); err != nil {
+		b.Logger.Error("transaction converting failed", "error", err.Error())
+		return nil, nil, fmt.Errorf("failed to convert ethereum transaction: %w", err)
+	}
+
+	if err := ethereumTx.ValidateBasic(); err != nil {
+		b.Logger.Debug("tx failed basic validation", "error", err.Error())
+		return nil, nil, fmt.Errorf("failed to validate transaction: %w", err)
+	}
+
+	return tx, ethereumTx, nil
+}
+
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(ctx context.Context, args evmtypes.TransactionArgs) (result evmtypes.TransactionArgs, err error) {
@@ -228,9 +228,17 @@ func (b *Backend) FeeHistory(
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	// the base fee and gas used ratio are served from the fee market history
+	// kept in state, which survives block pruning, while the rewards need the
+	// block transactions
+	stateFeeHistory := b.feeHistoryFromState(ctx, blockStart, blockEnd)
+	rewardCount := len(rewardPercentiles)
+	if stateFeeHistory != nil && rewardCount == 0 {
+		return stateFeeHistory, nil
+	}
+
 	// prepare space
 	reward := make([][]*hexutil.Big, blocks)
-	rewardCount := len(rewardPercentiles)
 	for i := 0; i < int(blocks); i++ {
 		reward[i] = make([]*hexutil.Big, rewardCount)
 	}
@@ -341,6 +349,13 @@ func (b *Backend) FeeHistory(
 		feeHistory.Reward = reward
 	}
 
+	if stateFeeHistory != nil {
+		feeHistory.BaseFee = stateFeeHistory.BaseFee
+		feeHistory.GasUsedRatio = stateFeeHistory.GasUsedRatio
+		feeHistory.BlobBaseFee = stateFeeHistory.BlobBaseFee
+		feeHistory.BlobGasUsedRatio = stateFeeHistory.BlobGasUsedRatio
+	}
+
 	return &feeHistory, nil
 }
 
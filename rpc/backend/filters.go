@@ -50,3 +50,18 @@ func (b *Backend) GetLogsByHeight(ctx context.Context, height *int64) (result []
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// GetLogsFromIndexer returns the logs of the blocks in the [from, to] range
+// matching the addresses and topics from the log indexes of the EVM indexer,
+// along with the last block covered. The blocks after the returned height must
+// be searched by the caller. It returns from-1 if the indexer is disabled or
+// doesn't cover the first block of the range.
+func (b *Backend) GetLogsFromIndexer(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) (logs []*ethtypes.Log, indexedTo int64, err error) {
+	_, span := tracer.Start(ctx, "GetLogsFromIndexer", trace.WithAttributes(attribute.Int64("from", from), attribute.Int64("to", to)))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if b.Indexer == nil {
+		return nil, from - 1, nil
+	}
+	return b.Indexer.GetLogs(from, to, addresses, topics)
+}
@@ -284,6 +284,80 @@ func (_m *EVMQueryClient) Code(ctx context.Context, in *types.QueryCodeRequest,
 	return r0, r1
 }
 
+// CodeDelegation provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) CodeDelegation(ctx context.Context, in *types.QueryCodeDelegationRequest, opts ...grpc.CallOption) (*types.QueryCodeDelegationResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CodeDelegation")
+	}
+
+	var r0 *types.QueryCodeDelegationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCodeDelegationRequest, ...grpc.CallOption) (*types.QueryCodeDelegationResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryCodeDelegationRequest, ...grpc.CallOption) *types.QueryCodeDelegationResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryCodeDelegationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryCodeDelegationRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EVMQueryClient_CodeDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CodeDelegation'
+type EVMQueryClient_CodeDelegation_Call struct {
+	*mock.Call
+}
+
+// CodeDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryCodeDelegationRequest
+//   - opts ...grpc.CallOption
+func (_e *EVMQueryClient_Expecter) CodeDelegation(ctx interface{}, in interface{}, opts ...interface{}) *EVMQueryClient_CodeDelegation_Call {
+	return &EVMQueryClient_CodeDelegation_Call{Call: _e.mock.On("CodeDelegation",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *EVMQueryClient_CodeDelegation_Call) Run(run func(ctx context.Context, in *types.QueryCodeDelegationRequest, opts ...grpc.CallOption)) *EVMQueryClient_CodeDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryCodeDelegationRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *EVMQueryClient_CodeDelegation_Call) Return(_a0 *types.QueryCodeDelegationResponse, _a1 error) *EVMQueryClient_CodeDelegation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EVMQueryClient_CodeDelegation_Call) RunAndReturn(run func(context.Context, *types.QueryCodeDelegationRequest, ...grpc.CallOption) (*types.QueryCodeDelegationResponse, error)) *EVMQueryClient_CodeDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EVMQueryClient_Code_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Code'
 type EVMQueryClient_Code_Call struct {
 	*mock.Call
@@ -173,6 +173,80 @@ func (_c *FeeMarketQueryClient_BlockGas_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// FeeHistory provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeHistory(ctx context.Context, in *types.QueryFeeHistoryRequest, opts ...grpc.CallOption) (*types.QueryFeeHistoryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeeHistory")
+	}
+
+	var r0 *types.QueryFeeHistoryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) (*types.QueryFeeHistoryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) *types.QueryFeeHistoryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeHistoryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FeeMarketQueryClient_FeeHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeeHistory'
+type FeeMarketQueryClient_FeeHistory_Call struct {
+	*mock.Call
+}
+
+// FeeHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryFeeHistoryRequest
+//   - opts ...grpc.CallOption
+func (_e *FeeMarketQueryClient_Expecter) FeeHistory(ctx interface{}, in interface{}, opts ...interface{}) *FeeMarketQueryClient_FeeHistory_Call {
+	return &FeeMarketQueryClient_FeeHistory_Call{Call: _e.mock.On("FeeHistory",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *FeeMarketQueryClient_FeeHistory_Call) Run(run func(ctx context.Context, in *types.QueryFeeHistoryRequest, opts ...grpc.CallOption)) *FeeMarketQueryClient_FeeHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryFeeHistoryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *FeeMarketQueryClient_FeeHistory_Call) Return(_a0 *types.QueryFeeHistoryResponse, _a1 error) *FeeMarketQueryClient_FeeHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FeeMarketQueryClient_FeeHistory_Call) RunAndReturn(run func(context.Context, *types.QueryFeeHistoryRequest, ...grpc.CallOption) (*types.QueryFeeHistoryResponse, error)) *FeeMarketQueryClient_FeeHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
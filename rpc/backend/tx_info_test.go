@@ -430,6 +430,10 @@ func (m *MockIndexer) LastIndexedBlock() (int64, error) {
 	return 0, nil
 }
 
+func (m *MockIndexer) FirstIndexedBlock() (int64, error) {
+	return 0, nil
+}
+
 func (m *MockIndexer) IndexBlock(block *tmtypes.Block, txResults []*abcitypes.ExecTxResult) error {
 	return nil
 }
@@ -445,6 +449,22 @@ func (m *MockIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ser
 	return nil, nil
 }
 
+func (m *MockIndexer) GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]*ethtypes.Log, int64, error) {
+	return nil, from - 1, nil
+}
+
+func (m *MockIndexer) LogIndexedRange() (int64, int64, error) {
+	return -1, -1, nil
+}
+
+func (m *MockIndexer) MissingBlocks(_, _ int64) ([]int64, error) {
+	return nil, nil
+}
+
+func (m *MockIndexer) PruneBlocks(_ int64) error {
+	return nil
+}
+
 func TestReceiptsFromCometBlock(t *testing.T) {
 	backend := setupMockBackend(t)
 	height := int64(100)
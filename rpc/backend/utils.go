@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -294,6 +295,97 @@ func (b *Backend) ProcessBlock(
 	return nil
 }
 
+// feeHistoryFromState builds the fee history of the [blockStart, blockEnd]
+// range from the fee market history kept in state. It returns nil if the
+// history is disabled or doesn't cover the whole range, in which case the
+// caller should fall back to processing the blocks.
+func (b *Backend) feeHistoryFromState(ctx context.Context, blockStart, blockEnd int64) *types.FeeHistoryResult {
+	blocks := blockEnd - blockStart + 1
+	if blockStart < 0 || blocks <= 0 {
+		return nil
+	}
+
+	// request one extra block to get the base fee of the block after blockEnd
+	res, err := b.QueryClient.FeeMarket.FeeHistory(ctx, &feemarkettypes.QueryFeeHistoryRequest{
+		LastBlock:  blockEnd + 1,
+		BlockCount: uint64(blocks) + 1, // #nosec G115 -- blocks is positive
+	})
+	if err != nil || res == nil {
+		return nil
+	}
+
+	entries := make(map[int64]feemarkettypes.BlockFeeHistory, len(res.FeeHistory))
+	for _, entry := range res.FeeHistory {
+		entries[entry.Height] = entry
+	}
+
+	cfg := b.ChainConfig()
+	feeHistory := &types.FeeHistoryResult{
+		OldestBlock:      (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:          make([]*hexutil.Big, blocks+1),
+		GasUsedRatio:     make([]float64, blocks),
+		BlobBaseFee:      make([]*hexutil.Big, blocks+1),
+		BlobGasUsedRatio: make([]float64, blocks),
+	}
+
+	var last feemarkettypes.BlockFeeHistory
+	for i := int64(0); i < blocks; i++ {
+		entry, found := entries[blockStart+i]
+		if !found {
+			return nil
+		}
+		feeHistory.BaseFee[i] = (*hexutil.Big)(evmtypes.ConvertAmountTo18DecimalsLegacy(entry.BaseFee).TruncateInt().BigInt())
+		feeHistory.GasUsedRatio[i] = safeRatio(entry.GasUsed, entry.GasLimit)
+		feeHistory.BlobBaseFee[i] = (*hexutil.Big)(blobBaseFee(cfg, entry.Height, entry.BlockTime))
+		last = entry
+	}
+	// the block after blockEnd is assumed to have the same timestamp as the
+	// last block if it is not committed yet
+	nextBlockTime := last.BlockTime
+	if next, found := entries[blockEnd+1]; found {
+		nextBlockTime = next.BlockTime
+	}
+	feeHistory.BlobBaseFee[blocks] = (*hexutil.Big)(blobBaseFee(cfg, blockEnd+1, nextBlockTime))
+
+	// use the recorded base fee of the next block if it is already committed,
+	// otherwise estimate it from the last block of the range
+	if next, found := entries[blockEnd+1]; found {
+		feeHistory.BaseFee[blocks] = (*hexutil.Big)(evmtypes.ConvertAmountTo18DecimalsLegacy(next.BaseFee).TruncateInt().BigInt())
+		return feeHistory
+	}
+
+	nextBaseFee := new(big.Int)
+	if cfg.IsLondon(big.NewInt(blockEnd + 1)) {
+		params, err := b.QueryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+		if err != nil {
+			return nil
+		}
+		header := &ethtypes.Header{
+			Number:   big.NewInt(blockEnd),
+			GasLimit: last.GasLimit,
+			GasUsed:  last.GasUsed,
+			BaseFee:  feeHistory.BaseFee[blocks-1].ToInt(),
+		}
+		nextBaseFee, err = types.CalcBaseFee(cfg, header, params.Params)
+		if err != nil {
+			return nil
+		}
+	}
+	feeHistory.BaseFee[blocks] = (*hexutil.Big)(nextBaseFee)
+
+	return feeHistory
+}
+
+// blobBaseFee returns the blob base fee of the block with the given height and
+// timestamp. Cosmos EVM doesn't support blob transactions, so the excess blob
+// gas is always zero and the blob base fee is the minimum once Cancun is active.
+func blobBaseFee(cfg *ethparams.ChainConfig, height int64, blockTime uint64) *big.Int {
+	if !cfg.IsCancun(big.NewInt(height), blockTime) {
+		return big.NewInt(0)
+	}
+	return eip4844.CalcBlobFee(cfg, &ethtypes.Header{ExcessBlobGas: new(uint64)})
+}
+
 func safeRatio(num, denom uint64) float64 {
 	if denom == 0 || num == 0 {
 		return 0
@@ -0,0 +1,451 @@
+package bundler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	"github.com/cosmos/evm/server/config"
+	evmtrace "github.com/cosmos/evm/trace"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+var tracer = otel.Tracer("evm/rpc/namespaces/ethereum/bundler")
+
+const (
+	// estimationGasLimit is the call and verification gas limit of the user
+	// operations simulated to estimate their gas.
+	estimationGasLimit = 5_000_000
+	// estimationGasMargin is the margin, in percent, added to the estimated
+	// call and verification gas limits.
+	estimationGasMargin = 10
+)
+
+// estimationBalance is the balance of the sender of the user operations
+// simulated to estimate their gas, which covers their prefund.
+var estimationBalance = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// Backend defines the JSON-RPC backend methods used by the bundler.
+type Backend interface {
+	ChainConfig() *params.ChainConfig
+	SetTxDefaults(ctx context.Context, args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
+	DoCall(ctx context.Context, args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error)
+	GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
+}
+
+// TxPool defines the EVM mempool methods used to submit the bundle
+// transactions.
+type TxPool interface {
+	Add(txs []*ethtypes.Transaction, sync bool) []error
+	Has(hash common.Hash) bool
+	PoolNonce(addr common.Address) uint64
+}
+
+// PublicAPI is the ERC-4337 bundler API, served under the eth namespace. The
+// user operations are validated by simulating their execution by the
+// EntryPoint, kept in an in-process mempool, and periodically submitted in
+// bundle transactions signed by the bundler key of the node's keyring.
+//
+// NOTE: the user operations are only known by the node receiving them, so the
+// eth_getUserOperationByHash and eth_getUserOperationReceipt methods only
+// return the user operations bundled by this node.
+type PublicAPI struct {
+	logger        log.Logger
+	backend       Backend
+	txPool        TxPool
+	signer        keyring.Signer
+	bundler       common.Address
+	entryPoints   []common.Address
+	maxBundleSize int
+	pool          *pool
+}
+
+// NewPublicAPI creates a new ERC-4337 bundler API and starts bundling the
+// user operations.
+func NewPublicAPI(
+	logger log.Logger,
+	backend Backend,
+	txPool TxPool,
+	signer keyring.Signer,
+	bundler common.Address,
+	cfg config.BundlerConfig,
+) *PublicAPI {
+	entryPoints := make([]common.Address, len(cfg.EntryPoints))
+	for i, entryPoint := range cfg.EntryPoints {
+		entryPoints[i] = common.HexToAddress(entryPoint)
+	}
+
+	api := &PublicAPI{
+		logger:        logger.With("module", "bundler"),
+		backend:       backend,
+		txPool:        txPool,
+		signer:        signer,
+		bundler:       bundler,
+		entryPoints:   entryPoints,
+		maxBundleSize: cfg.MaxBundleSize,
+		pool:          newPool(cfg.MaxPoolSize),
+	}
+
+	go api.bundleLoop(cfg.BundleInterval)
+
+	return api
+}
+
+// SupportedEntryPoints returns the addresses of the EntryPoint contracts
+// supported by the bundler.
+func (api *PublicAPI) SupportedEntryPoints() []common.Address {
+	api.logger.Debug("eth_supportedEntryPoints")
+	return api.entryPoints
+}
+
+// SendUserOperation validates the user operation by simulating its execution,
+// and adds it to the bundler mempool. It returns the hash of the user
+// operation.
+func (api *PublicAPI) SendUserOperation(op UserOperation, entryPoint common.Address) (_ common.Hash, err error) {
+	api.logger.Debug("eth_sendUserOperation", "sender", op.Sender, "entry_point", entryPoint)
+	ctx, span := tracer.Start(context.Background(), "SendUserOperation", trace.WithAttributes(attribute.String("sender", op.Sender.Hex()), attribute.String("entry_point", entryPoint.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := api.checkEntryPoint(entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+	if err := op.Validate(); err != nil {
+		return common.Hash{}, &invalidParamsError{message: err.Error()}
+	}
+
+	hash, err := userOperationHash(op, entryPoint, api.backend.ChainConfig().ChainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := api.simulateHandleOps(ctx, []UserOperation{op}, entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := api.pool.add(&poolEntry{hash: hash, op: op, entryPoint: entryPoint}); err != nil {
+		return common.Hash{}, &invalidParamsError{message: err.Error()}
+	}
+	return hash, nil
+}
+
+// EstimateUserOperationGas estimates the gas limits of the user operation by
+// simulating its execution with EntryPoint.simulateHandleOp. The gas limits
+// and the signature of the given user operation are ignored.
+func (api *PublicAPI) EstimateUserOperationGas(op UserOperation, entryPoint common.Address) (_ *UserOperationGasEstimate, err error) {
+	api.logger.Debug("eth_estimateUserOperationGas", "sender", op.Sender, "entry_point", entryPoint)
+	ctx, span := tracer.Start(context.Background(), "EstimateUserOperationGas", trace.WithAttributes(attribute.String("sender", op.Sender.Hex()), attribute.String("entry_point", entryPoint.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := api.checkEntryPoint(entryPoint); err != nil {
+		return nil, err
+	}
+	if op.Sender == (common.Address{}) || op.Nonce == nil {
+		return nil, &invalidParamsError{message: "missing user operation sender or nonce"}
+	}
+
+	// the simulated user operation pays a gas price of 1, so that the amount
+	// paid for its execution is the gas it used
+	op.MaxFeePerGas = (*hexutil.Big)(big.NewInt(1))
+	op.MaxPriorityFeePerGas = (*hexutil.Big)(big.NewInt(1))
+	op.CallGasLimit = (*hexutil.Big)(big.NewInt(estimationGasLimit))
+	op.VerificationGasLimit = (*hexutil.Big)(big.NewInt(estimationGasLimit))
+	op.PreVerificationGas = (*hexutil.Big)(new(big.Int))
+
+	preVerification, err := preVerificationGas(op)
+	if err != nil {
+		return nil, err
+	}
+	op.PreVerificationGas = (*hexutil.Big)(new(big.Int).SetUint64(preVerification))
+
+	data, err := packSimulateHandleOp(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// the sender balance is overridden to cover the prefund of the simulated
+	// user operation
+	balance := (*hexutil.Big)(estimationBalance)
+	overrides, err := json.Marshal(rpctypes.StateOverride{op.Sender: {Balance: &balance}})
+	if err != nil {
+		return nil, err
+	}
+	rawOverrides := json.RawMessage(overrides)
+
+	_, err = api.backend.DoCall(ctx, api.callArgs(entryPoint, data), rpctypes.EthPendingBlockNumber, &rawOverrides)
+	if err == nil {
+		return nil, errors.New("simulateHandleOp did not revert")
+	}
+	ret, ok := revertData(err)
+	if !ok {
+		return nil, err
+	}
+	if _, reason, ok := unpackFailedOp(ret); ok {
+		return nil, newEntryPointError(reason, -1)
+	}
+	preOpGas, paid, ok := unpackExecutionResult(ret)
+	if !ok {
+		return nil, newEntryPointError(err.Error(), -1)
+	}
+
+	verificationGas := new(big.Int).Sub(preOpGas, op.PreVerificationGas.ToInt())
+	callGas := new(big.Int).Sub(paid, preOpGas)
+	if callGas.Sign() < 0 {
+		callGas.SetUint64(0)
+	}
+
+	return &UserOperationGasEstimate{
+		PreVerificationGas:   hexutil.Uint64(preVerification),
+		VerificationGasLimit: hexutil.Uint64(withGasMargin(verificationGas)),
+		CallGasLimit:         hexutil.Uint64(withGasMargin(callGas)),
+	}, nil
+}
+
+// GetUserOperationByHash returns the user operation with the given hash, and
+// the bundle transaction including it once it is executed.
+func (api *PublicAPI) GetUserOperationByHash(hash common.Hash) (_ *UserOperationByHash, err error) {
+	api.logger.Debug("eth_getUserOperationByHash", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "GetUserOperationByHash", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	entry, ok := api.pool.get(hash)
+	if !ok {
+		return nil, nil
+	}
+
+	result := &UserOperationByHash{
+		UserOperation: entry.op,
+		EntryPoint:    entry.entryPoint,
+	}
+
+	receipt, err := api.bundleReceipt(ctx, entry)
+	if err != nil || receipt == nil {
+		return result, err
+	}
+
+	blockNumber, _ := receipt["blockNumber"].(hexutil.Uint64)
+	blockHash, _ := receipt["blockHash"].(common.Hash)
+	result.BlockNumber = &blockNumber
+	result.BlockHash = &blockHash
+	result.TransactionHash = &entry.txHash
+	return result, nil
+}
+
+// GetUserOperationReceipt returns the receipt of the user operation with the
+// given hash, once the bundle transaction including it is executed.
+func (api *PublicAPI) GetUserOperationReceipt(hash common.Hash) (_ *UserOperationReceipt, err error) {
+	api.logger.Debug("eth_getUserOperationReceipt", "hash", hash)
+	ctx, span := tracer.Start(context.Background(), "GetUserOperationReceipt", trace.WithAttributes(attribute.String("hash", hash.Hex())))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	entry, ok := api.pool.get(hash)
+	if !ok {
+		return nil, nil
+	}
+
+	receipt, err := api.bundleReceipt(ctx, entry)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	logs, _ := receipt["logs"].([]*ethtypes.Log)
+	eventLog, opLogs := findUserOperationLogs(logs, entry.entryPoint, hash)
+	if eventLog == nil || len(eventLog.Topics) < 4 {
+		// the bundle transaction failed, or the user operation wasn't
+		// executed
+		return nil, nil
+	}
+	event, err := unpackUserOperationEvent(eventLog)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UserOperationReceipt{
+		UserOpHash:    hash,
+		EntryPoint:    entry.entryPoint,
+		Sender:        common.BytesToAddress(eventLog.Topics[2].Bytes()),
+		Nonce:         (*hexutil.Big)(event.Nonce),
+		Paymaster:     common.BytesToAddress(eventLog.Topics[3].Bytes()),
+		ActualGasCost: (*hexutil.Big)(event.ActualGasCost),
+		ActualGasUsed: (*hexutil.Big)(event.ActualGasUsed),
+		Success:       event.Success,
+		Logs:          opLogs,
+		Receipt:       receipt,
+	}
+	if !event.Success {
+		result.Reason = revertReason(opLogs, hash)
+	}
+	return result, nil
+}
+
+// bundleReceipt returns the receipt of the bundle transaction including the
+// user operation, or nil if it isn't executed yet.
+func (api *PublicAPI) bundleReceipt(ctx context.Context, entry poolEntry) (map[string]interface{}, error) {
+	if entry.txHash == (common.Hash{}) || api.txPool.Has(entry.txHash) {
+		return nil, nil
+	}
+	return api.backend.GetTransactionReceipt(ctx, entry.txHash)
+}
+
+// bundleLoop periodically submits the pending user operations of every
+// supported entry point.
+func (api *PublicAPI) bundleLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for _, entryPoint := range api.entryPoints {
+			if _, err := api.bundle(context.Background(), entryPoint); err != nil {
+				api.logger.Error("failed to submit user operation bundle", "entry_point", entryPoint, "error", err.Error())
+			}
+		}
+	}
+}
+
+// bundle submits a bundle transaction executing the pending user operations
+// of the entry point, and returns its hash. The user operations failing the
+// validation of the EntryPoint are dropped from the bundle and the mempool.
+func (api *PublicAPI) bundle(ctx context.Context, entryPoint common.Address) (common.Hash, error) {
+	batch := api.pool.pendingBatch(entryPoint, api.maxBundleSize)
+
+	ops := make([]UserOperation, 0, len(batch))
+	hashes := make([]common.Hash, 0, len(batch))
+	for _, entry := range batch {
+		ops = append(ops, entry.op)
+		hashes = append(hashes, entry.hash)
+	}
+
+	for len(ops) > 0 {
+		err := api.simulateHandleOps(ctx, ops, entryPoint)
+		if err == nil {
+			break
+		}
+
+		var epErr *entryPointError
+		if !errors.As(err, &epErr) {
+			return common.Hash{}, err
+		}
+
+		// the FailedOp revert reports the index of the failing user
+		// operation, any other revert fails the whole bundle
+		index := epErr.opIndex
+		if index < 0 || index >= len(ops) {
+			api.pool.remove(hashes...)
+			return common.Hash{}, err
+		}
+		api.logger.Debug("dropping invalid user operation", "hash", hashes[index], "reason", epErr.reason)
+		api.pool.remove(hashes[index])
+		ops = append(ops[:index], ops[index+1:]...)
+		hashes = append(hashes[:index], hashes[index+1:]...)
+	}
+
+	if len(ops) == 0 {
+		return common.Hash{}, nil
+	}
+
+	data, err := packHandleOps(ops, api.bundler)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	args := api.callArgs(entryPoint, data)
+	nonce := hexutil.Uint64(api.txPool.PoolNonce(api.bundler))
+	args.Nonce = &nonce
+	args, err = api.backend.SetTxDefaults(ctx, args)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	msg := evmtypes.NewTxFromArgs(&args)
+	if err := msg.Sign(ethtypes.LatestSigner(api.backend.ChainConfig()), api.signer); err != nil {
+		return common.Hash{}, err
+	}
+
+	tx := msg.AsTransaction()
+	if err := api.txPool.Add([]*ethtypes.Transaction{tx}, true)[0]; err != nil {
+		return common.Hash{}, fmt.Errorf("failed to add bundle transaction to the mempool: %w", err)
+	}
+
+	api.pool.markSubmitted(tx.Hash(), hashes...)
+	api.logger.Debug("submitted user operation bundle", "tx_hash", tx.Hash(), "entry_point", entryPoint, "user_operations", len(hashes))
+	return tx.Hash(), nil
+}
+
+// simulateHandleOps simulates the execution of the user operations by the
+// EntryPoint.handleOps function, and returns an entryPointError if it reverts.
+func (api *PublicAPI) simulateHandleOps(ctx context.Context, ops []UserOperation, entryPoint common.Address) error {
+	data, err := packHandleOps(ops, api.bundler)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.backend.DoCall(ctx, api.callArgs(entryPoint, data), rpctypes.EthPendingBlockNumber, nil)
+	if err == nil {
+		return nil
+	}
+
+	ret, ok := revertData(err)
+	if !ok {
+		return err
+	}
+	if index, reason, ok := unpackFailedOp(ret); ok {
+		return newEntryPointError(reason, index)
+	}
+	return newEntryPointError(err.Error(), -1)
+}
+
+// callArgs returns the arguments of a call from the bundler to the entry
+// point.
+func (api *PublicAPI) callArgs(entryPoint common.Address, data []byte) evmtypes.TransactionArgs {
+	input := hexutil.Bytes(data)
+	return evmtypes.TransactionArgs{
+		From:  &api.bundler,
+		To:    &entryPoint,
+		Input: &input,
+	}
+}
+
+// checkEntryPoint returns an error if the entry point isn't supported.
+func (api *PublicAPI) checkEntryPoint(entryPoint common.Address) error {
+	for _, supported := range api.entryPoints {
+		if supported == entryPoint {
+			return nil
+		}
+	}
+	return &invalidParamsError{message: fmt.Sprintf("unsupported entry point %s", entryPoint.Hex())}
+}
+
+// revertData returns the data of a reverted call.
+func revertData(err error) ([]byte, bool) {
+	var revertErr *evmtypes.RevertError
+	if !errors.As(err, &revertErr) {
+		return nil, false
+	}
+	reason, ok := revertErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	ret, decodeErr := hexutil.Decode(reason)
+	return ret, decodeErr == nil
+}
+
+// withGasMargin returns the gas increased by estimationGasMargin percent.
+func withGasMargin(gas *big.Int) uint64 {
+	gas = new(big.Int).Mul(gas, big.NewInt(100+estimationGasMargin))
+	return gas.Div(gas, big.NewInt(100)).Uint64()
+}
@@ -0,0 +1,285 @@
+package bundler
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/cosmos/evm/rpc/types"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+)
+
+type mockBackend struct {
+	calls    [][]byte
+	doCall   func(data []byte) error
+	receipts map[common.Hash]map[string]interface{}
+}
+
+func (b *mockBackend) ChainConfig() *params.ChainConfig {
+	return params.TestChainConfig
+}
+
+func (b *mockBackend) SetTxDefaults(_ context.Context, args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
+	gas := hexutil.Uint64(1_000_000)
+	args.Gas = &gas
+	args.GasPrice = (*hexutil.Big)(big.NewInt(1))
+	args.ChainID = (*hexutil.Big)(params.TestChainConfig.ChainID)
+	return args, nil
+}
+
+func (b *mockBackend) DoCall(_ context.Context, args evmtypes.TransactionArgs, _ rpctypes.BlockNumber, _ *json.RawMessage) (*evmtypes.MsgEthereumTxResponse, error) {
+	b.calls = append(b.calls, *args.Input)
+	if b.doCall == nil {
+		return &evmtypes.MsgEthereumTxResponse{}, nil
+	}
+	return &evmtypes.MsgEthereumTxResponse{}, b.doCall(*args.Input)
+}
+
+func (b *mockBackend) GetTransactionReceipt(_ context.Context, hash common.Hash) (map[string]interface{}, error) {
+	return b.receipts[hash], nil
+}
+
+type mockTxPool struct {
+	txs []*ethtypes.Transaction
+}
+
+func (p *mockTxPool) Add(txs []*ethtypes.Transaction, _ bool) []error {
+	p.txs = append(p.txs, txs...)
+	return make([]error, len(txs))
+}
+
+func (p *mockTxPool) Has(common.Hash) bool {
+	return false
+}
+
+func (p *mockTxPool) PoolNonce(common.Address) uint64 {
+	return 3
+}
+
+func newTestAPI(t *testing.T) (*PublicAPI, *mockBackend, *mockTxPool) {
+	t.Helper()
+	bundler, privKey := utiltx.NewAddrKey()
+	backend := &mockBackend{receipts: make(map[common.Hash]map[string]interface{})}
+	txPool := &mockTxPool{}
+	return &PublicAPI{
+		logger:        log.NewNopLogger(),
+		backend:       backend,
+		txPool:        txPool,
+		signer:        utiltx.NewSigner(privKey),
+		bundler:       bundler,
+		entryPoints:   []common.Address{testEntryPoint},
+		maxBundleSize: 10,
+		pool:          newPool(10),
+	}, backend, txPool
+}
+
+func failedOpRevert(t *testing.T, index int64, reason string) error {
+	t.Helper()
+	packed, err := entryPointABI.Errors["FailedOp"].Inputs.Pack(big.NewInt(index), reason)
+	require.NoError(t, err)
+	return evmtypes.NewExecErrorWithReason(append(failedOpErrorID[:], packed...))
+}
+
+func TestSendUserOperation(t *testing.T) {
+	op := newTestUserOperation(common.HexToAddress("0x01"), 0, 10)
+
+	testCases := []struct {
+		name         string
+		entryPoint   common.Address
+		op           UserOperation
+		doCall       func(data []byte) error
+		expectedCode int
+	}{
+		{
+			"valid user operation",
+			testEntryPoint,
+			op,
+			nil,
+			0,
+		},
+		{
+			"unsupported entry point",
+			common.HexToAddress("0x02"),
+			op,
+			nil,
+			errCodeInvalidParams,
+		},
+		{
+			"missing fees",
+			testEntryPoint,
+			UserOperation{Sender: op.Sender, Nonce: op.Nonce},
+			nil,
+			errCodeInvalidParams,
+		},
+		{
+			"rejected by the entry point",
+			testEntryPoint,
+			op,
+			func([]byte) error { return failedOpRevert(t, 0, "AA24 signature error") },
+			errCodeRejectedByEntryPoint,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api, backend, _ := newTestAPI(t)
+			backend.doCall = tc.doCall
+
+			hash, err := api.SendUserOperation(tc.op, tc.entryPoint)
+			if tc.expectedCode != 0 {
+				var rpcErr interface{ ErrorCode() int }
+				require.ErrorAs(t, err, &rpcErr)
+				require.Equal(t, tc.expectedCode, rpcErr.ErrorCode())
+				return
+			}
+			require.NoError(t, err)
+
+			// the simulation executes the user operation with handleOps
+			expectedData, err := packHandleOps([]UserOperation{tc.op}, api.bundler)
+			require.NoError(t, err)
+			require.Equal(t, [][]byte{expectedData}, backend.calls)
+
+			res, err := api.GetUserOperationByHash(hash)
+			require.NoError(t, err)
+			require.Equal(t, tc.op, res.UserOperation)
+			require.Equal(t, testEntryPoint, res.EntryPoint)
+			require.Nil(t, res.TransactionHash)
+		})
+	}
+}
+
+func TestBundle(t *testing.T) {
+	api, backend, txPool := newTestAPI(t)
+
+	valid := newTestUserOperation(common.HexToAddress("0x01"), 0, 20)
+	invalid := newTestUserOperation(common.HexToAddress("0x02"), 0, 10)
+	validHash, err := api.SendUserOperation(valid, testEntryPoint)
+	require.NoError(t, err)
+	invalidHash, err := api.SendUserOperation(invalid, testEntryPoint)
+	require.NoError(t, err)
+
+	// the second user operation of the bundle fails the validation
+	bothData, err := packHandleOps([]UserOperation{valid, invalid}, api.bundler)
+	require.NoError(t, err)
+	backend.doCall = func(data []byte) error {
+		if string(data) == string(bothData) {
+			return failedOpRevert(t, 1, "AA21 didn't pay prefund")
+		}
+		return nil
+	}
+
+	txHash, err := api.bundle(context.Background(), testEntryPoint)
+	require.NoError(t, err)
+
+	require.Len(t, txPool.txs, 1)
+	tx := txPool.txs[0]
+	require.Equal(t, txHash, tx.Hash())
+	require.Equal(t, testEntryPoint, *tx.To())
+	require.Equal(t, uint64(3), tx.Nonce())
+	from, err := ethtypes.Sender(ethtypes.LatestSigner(params.TestChainConfig), tx)
+	require.NoError(t, err)
+	require.Equal(t, api.bundler, from)
+
+	expectedData, err := packHandleOps([]UserOperation{valid}, api.bundler)
+	require.NoError(t, err)
+	require.Equal(t, expectedData, tx.Data())
+
+	// the invalid user operation is dropped
+	res, err := api.GetUserOperationByHash(invalidHash)
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	// no pending user operations left
+	txHash, err = api.bundle(context.Background(), testEntryPoint)
+	require.NoError(t, err)
+	require.Equal(t, common.Hash{}, txHash)
+
+	// the receipt is built from the logs of the bundle transaction
+	accountLog := &ethtypes.Log{Address: valid.Sender, Topics: []common.Hash{common.HexToHash("0x01")}}
+	eventData, err := entryPointABI.Events["UserOperationEvent"].Inputs.NonIndexed().Pack(
+		valid.Nonce.ToInt(), true, big.NewInt(1000), big.NewInt(100),
+	)
+	require.NoError(t, err)
+	eventLog := &ethtypes.Log{
+		Address: testEntryPoint,
+		Topics: []common.Hash{
+			userOperationEventID,
+			validHash,
+			common.BytesToHash(valid.Sender.Bytes()),
+			{},
+		},
+		Data: eventData,
+	}
+	backend.receipts[tx.Hash()] = map[string]interface{}{
+		"blockNumber": hexutil.Uint64(10),
+		"blockHash":   common.HexToHash("0xb1"),
+		"logs":        []*ethtypes.Log{accountLog, eventLog},
+	}
+
+	byHash, err := api.GetUserOperationByHash(validHash)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Uint64(10), *byHash.BlockNumber)
+	require.Equal(t, common.HexToHash("0xb1"), *byHash.BlockHash)
+	require.Equal(t, tx.Hash(), *byHash.TransactionHash)
+
+	receipt, err := api.GetUserOperationReceipt(validHash)
+	require.NoError(t, err)
+	require.Equal(t, validHash, receipt.UserOpHash)
+	require.Equal(t, valid.Sender, receipt.Sender)
+	require.True(t, receipt.Success)
+	require.Equal(t, big.NewInt(1000), receipt.ActualGasCost.ToInt())
+	require.Equal(t, big.NewInt(100), receipt.ActualGasUsed.ToInt())
+	require.Equal(t, []*ethtypes.Log{accountLog}, receipt.Logs)
+}
+
+func TestEstimateUserOperationGas(t *testing.T) {
+	api, backend, _ := newTestAPI(t)
+
+	op := newTestUserOperation(common.HexToAddress("0x01"), 0, 10)
+	backend.doCall = func([]byte) error {
+		packed, err := entryPointABI.Errors["ExecutionResult"].Inputs.Pack(
+			big.NewInt(150_000), big.NewInt(200_000), big.NewInt(0), big.NewInt(0), true, []byte{},
+		)
+		require.NoError(t, err)
+		return evmtypes.NewExecErrorWithReason(append(executionResultErrorID[:], packed...))
+	}
+
+	res, err := api.EstimateUserOperationGas(op, testEntryPoint)
+	require.NoError(t, err)
+
+	preVerification := uint64(res.PreVerificationGas)
+	require.Greater(t, preVerification, uint64(fixedGasOverhead+perUserOpOverhead))
+	require.Equal(t, withGasMargin(big.NewInt(int64(150_000-preVerification))), uint64(res.VerificationGasLimit))
+	require.Equal(t, withGasMargin(big.NewInt(50_000)), uint64(res.CallGasLimit))
+}
+
+func TestUserOperationHash(t *testing.T) {
+	op := newTestUserOperation(common.HexToAddress("0x01"), 0, 10)
+	hash, err := userOperationHash(op, testEntryPoint, big.NewInt(1))
+	require.NoError(t, err)
+
+	// the signature isn't part of the hash
+	signed := op
+	signed.Signature = []byte{0x03}
+	signedHash, err := userOperationHash(signed, testEntryPoint, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, hash, signedHash)
+
+	// the hash is bound to the entry point and the chain
+	otherHash, err := userOperationHash(op, common.HexToAddress("0x02"), big.NewInt(1))
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+	otherHash, err = userOperationHash(op, testEntryPoint, big.NewInt(2))
+	require.NoError(t, err)
+	require.NotEqual(t, hash, otherHash)
+}
@@ -0,0 +1,317 @@
+package bundler
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const userOperationComponents = `[
+	{"name": "sender", "type": "address"},
+	{"name": "nonce", "type": "uint256"},
+	{"name": "initCode", "type": "bytes"},
+	{"name": "callData", "type": "bytes"},
+	{"name": "callGasLimit", "type": "uint256"},
+	{"name": "verificationGasLimit", "type": "uint256"},
+	{"name": "preVerificationGas", "type": "uint256"},
+	{"name": "maxFeePerGas", "type": "uint256"},
+	{"name": "maxPriorityFeePerGas", "type": "uint256"},
+	{"name": "paymasterAndData", "type": "bytes"},
+	{"name": "signature", "type": "bytes"}
+]`
+
+// entryPointABIJSON is the subset of the v0.6 EntryPoint ABI used by the
+// bundler.
+const entryPointABIJSON = `[
+	{
+		"type": "function",
+		"name": "handleOps",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "ops", "type": "tuple[]", "components": ` + userOperationComponents + `},
+			{"name": "beneficiary", "type": "address"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "simulateHandleOp",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "op", "type": "tuple", "components": ` + userOperationComponents + `},
+			{"name": "target", "type": "address"},
+			{"name": "targetCallData", "type": "bytes"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "event",
+		"name": "UserOperationEvent",
+		"anonymous": false,
+		"inputs": [
+			{"name": "userOpHash", "type": "bytes32", "indexed": true},
+			{"name": "sender", "type": "address", "indexed": true},
+			{"name": "paymaster", "type": "address", "indexed": true},
+			{"name": "nonce", "type": "uint256", "indexed": false},
+			{"name": "success", "type": "bool", "indexed": false},
+			{"name": "actualGasCost", "type": "uint256", "indexed": false},
+			{"name": "actualGasUsed", "type": "uint256", "indexed": false}
+		]
+	},
+	{
+		"type": "event",
+		"name": "UserOperationRevertReason",
+		"anonymous": false,
+		"inputs": [
+			{"name": "userOpHash", "type": "bytes32", "indexed": true},
+			{"name": "sender", "type": "address", "indexed": true},
+			{"name": "nonce", "type": "uint256", "indexed": false},
+			{"name": "revertReason", "type": "bytes", "indexed": false}
+		]
+	},
+	{
+		"type": "error",
+		"name": "FailedOp",
+		"inputs": [
+			{"name": "opIndex", "type": "uint256"},
+			{"name": "reason", "type": "string"}
+		]
+	},
+	{
+		"type": "error",
+		"name": "ExecutionResult",
+		"inputs": [
+			{"name": "preOpGas", "type": "uint256"},
+			{"name": "paid", "type": "uint256"},
+			{"name": "validAfter", "type": "uint48"},
+			{"name": "validUntil", "type": "uint48"},
+			{"name": "targetSuccess", "type": "bool"},
+			{"name": "targetResult", "type": "bytes"}
+		]
+	}
+]`
+
+// Gas overheads of the calldata and the execution of a user operation in a
+// bundle, used to compute its pre-verification gas.
+const (
+	fixedGasOverhead   = 21000
+	perUserOpOverhead  = 18300
+	perUserOpWordGas   = 4
+	zeroByteGas        = 4
+	nonZeroByteGas     = 16
+	maxSignatureLength = 65
+)
+
+var (
+	entryPointABI abi.ABI
+
+	userOperationEventID        common.Hash
+	userOperationRevertReasonID common.Hash
+	failedOpErrorID             [4]byte
+	executionResultErrorID      [4]byte
+
+	userOperationHashArgs abi.Arguments
+)
+
+func init() {
+	var err error
+	entryPointABI, err = abi.JSON(strings.NewReader(entryPointABIJSON))
+	if err != nil {
+		panic(err)
+	}
+
+	userOperationEventID = entryPointABI.Events["UserOperationEvent"].ID
+	userOperationRevertReasonID = entryPointABI.Events["UserOperationRevertReason"].ID
+	copy(failedOpErrorID[:], entryPointABI.Errors["FailedOp"].ID.Bytes())
+	copy(executionResultErrorID[:], entryPointABI.Errors["ExecutionResult"].ID.Bytes())
+
+	addressType, _ := abi.NewType("address", "", nil)
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	userOperationHashArgs = abi.Arguments{
+		{Type: addressType}, // sender
+		{Type: uint256Type}, // nonce
+		{Type: bytes32Type}, // keccak(initCode)
+		{Type: bytes32Type}, // keccak(callData)
+		{Type: uint256Type}, // callGasLimit
+		{Type: uint256Type}, // verificationGasLimit
+		{Type: uint256Type}, // preVerificationGas
+		{Type: uint256Type}, // maxFeePerGas
+		{Type: uint256Type}, // maxPriorityFeePerGas
+		{Type: bytes32Type}, // keccak(paymasterAndData)
+	}
+}
+
+// userOperationHash returns the hash of the user operation, which is the one
+// computed by the EntryPoint.getUserOpHash function.
+func userOperationHash(op UserOperation, entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	packed := op.pack()
+	bz, err := userOperationHashArgs.Pack(
+		packed.Sender,
+		packed.Nonce,
+		crypto.Keccak256Hash(packed.InitCode),
+		crypto.Keccak256Hash(packed.CallData),
+		packed.CallGasLimit,
+		packed.VerificationGasLimit,
+		packed.PreVerificationGas,
+		packed.MaxFeePerGas,
+		packed.MaxPriorityFeePerGas,
+		crypto.Keccak256Hash(packed.PaymasterAndData),
+	)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	encoded := make([]byte, 0, 3*common.HashLength)
+	encoded = append(encoded, crypto.Keccak256(bz)...)
+	encoded = append(encoded, common.LeftPadBytes(entryPoint.Bytes(), common.HashLength)...)
+	encoded = append(encoded, common.LeftPadBytes(chainID.Bytes(), common.HashLength)...)
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// packHandleOps returns the calldata of the EntryPoint.handleOps call
+// executing the user operations.
+func packHandleOps(ops []UserOperation, beneficiary common.Address) ([]byte, error) {
+	packed := make([]packedUserOperation, len(ops))
+	for i, op := range ops {
+		packed[i] = op.pack()
+	}
+	return entryPointABI.Pack("handleOps", packed, beneficiary)
+}
+
+// packSimulateHandleOp returns the calldata of the
+// EntryPoint.simulateHandleOp call simulating the user operation.
+func packSimulateHandleOp(op UserOperation) ([]byte, error) {
+	return entryPointABI.Pack("simulateHandleOp", op.pack(), common.Address{}, []byte{})
+}
+
+// preVerificationGas returns the gas paid for the calldata and the bundle
+// overhead of the user operation, which isn't metered by the EntryPoint.
+func preVerificationGas(op UserOperation) (uint64, error) {
+	// the signature of the estimated user operations is a placeholder, so the
+	// gas is computed with a signature of at least the ECDSA signature length
+	if len(op.Signature) < maxSignatureLength {
+		op.Signature = bytes.Repeat([]byte{0xff}, maxSignatureLength)
+	}
+	if op.CallGasLimit == nil || op.VerificationGasLimit == nil || op.PreVerificationGas == nil {
+		return 0, fmt.Errorf("missing user operation gas limits")
+	}
+
+	// the encoding of the operation is the one of the handleOps arguments
+	bz, err := entryPointABI.Methods["handleOps"].Inputs[:1].Pack([]packedUserOperation{op.pack()})
+	if err != nil {
+		return 0, err
+	}
+	// skip the offset and length of the array
+	bz = bz[2*common.HashLength:]
+
+	var calldataGas uint64
+	for _, b := range bz {
+		if b == 0 {
+			calldataGas += zeroByteGas
+		} else {
+			calldataGas += nonZeroByteGas
+		}
+	}
+
+	words := uint64((len(bz) + common.HashLength - 1) / common.HashLength)
+	return calldataGas + fixedGasOverhead + perUserOpOverhead + perUserOpWordGas*words, nil
+}
+
+// userOperationEvent is the data of the UserOperationEvent log of a user
+// operation.
+type userOperationEvent struct {
+	Nonce         *big.Int
+	Success       bool
+	ActualGasCost *big.Int
+	ActualGasUsed *big.Int
+}
+
+// unpackUserOperationEvent returns the data of a UserOperationEvent log.
+func unpackUserOperationEvent(log *ethtypes.Log) (userOperationEvent, error) {
+	var event userOperationEvent
+	if err := entryPointABI.UnpackIntoInterface(&event, "UserOperationEvent", log.Data); err != nil {
+		return userOperationEvent{}, err
+	}
+	return event, nil
+}
+
+// findUserOperationLogs returns the UserOperationEvent of the user operation
+// and the logs emitted during its execution, which are the ones between the
+// previous UserOperationEvent and its own.
+func findUserOperationLogs(logs []*ethtypes.Log, entryPoint common.Address, userOpHash common.Hash) (*ethtypes.Log, []*ethtypes.Log) {
+	start := 0
+	for i, log := range logs {
+		if log.Address != entryPoint || len(log.Topics) < 2 || log.Topics[0] != userOperationEventID {
+			continue
+		}
+		if log.Topics[1] == userOpHash {
+			return log, logs[start:i]
+		}
+		start = i + 1
+	}
+	return nil, nil
+}
+
+// revertReason returns the revert reason of the user operation, emitted in a
+// UserOperationRevertReason log.
+func revertReason(logs []*ethtypes.Log, userOpHash common.Hash) string {
+	for _, log := range logs {
+		if len(log.Topics) < 2 || log.Topics[0] != userOperationRevertReasonID || log.Topics[1] != userOpHash {
+			continue
+		}
+		values, err := entryPointABI.Events["UserOperationRevertReason"].Inputs.NonIndexed().Unpack(log.Data)
+		if err != nil || len(values) != 2 {
+			return ""
+		}
+		reason, _ := values[1].([]byte)
+		if msg, err := abi.UnpackRevert(reason); err == nil {
+			return msg
+		}
+		return fmt.Sprintf("0x%x", reason)
+	}
+	return ""
+}
+
+// unpackFailedOp returns the index of the failing user operation and the
+// reason of a FailedOp revert of the EntryPoint.
+func unpackFailedOp(ret []byte) (int, string, bool) {
+	if len(ret) < 4 || [4]byte(ret[:4]) != failedOpErrorID {
+		return 0, "", false
+	}
+	values, err := entryPointABI.Errors["FailedOp"].Inputs.Unpack(ret[4:])
+	if err != nil || len(values) != 2 {
+		return 0, "", false
+	}
+	index, ok := values[0].(*big.Int)
+	if !ok || !index.IsInt64() {
+		return 0, "", false
+	}
+	reason, ok := values[1].(string)
+	return int(index.Int64()), reason, ok
+}
+
+// unpackExecutionResult returns the gas used before the execution of the user
+// operation and the amount paid for it, from the ExecutionResult revert of
+// EntryPoint.simulateHandleOp.
+func unpackExecutionResult(ret []byte) (preOpGas, paid *big.Int, ok bool) {
+	if len(ret) < 4 || [4]byte(ret[:4]) != executionResultErrorID {
+		return nil, nil, false
+	}
+	values, err := entryPointABI.Errors["ExecutionResult"].Inputs.Unpack(ret[4:])
+	if err != nil || len(values) != 6 {
+		return nil, nil, false
+	}
+	preOpGas, ok = values[0].(*big.Int)
+	if !ok {
+		return nil, nil, false
+	}
+	paid, ok = values[1].(*big.Int)
+	return preOpGas, paid, ok
+}
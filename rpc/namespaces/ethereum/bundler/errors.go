@@ -0,0 +1,37 @@
+package bundler
+
+// JSON-RPC error codes of the ERC-4337 bundler API.
+const (
+	errCodeInvalidParams        = -32602
+	errCodeRejectedByEntryPoint = -32500
+)
+
+// invalidParamsError is returned when the request parameters are invalid.
+type invalidParamsError struct {
+	message string
+}
+
+func (e *invalidParamsError) Error() string { return e.message }
+
+// ErrorCode returns the JSON-RPC error code of the error.
+func (e *invalidParamsError) ErrorCode() int { return errCodeInvalidParams }
+
+// entryPointError is returned when the simulation of user operations is
+// rejected by the EntryPoint.
+type entryPointError struct {
+	reason string
+	// opIndex is the index of the rejected user operation reported by a
+	// FailedOp revert, or -1 for any other revert.
+	opIndex int
+}
+
+func newEntryPointError(reason string, opIndex int) *entryPointError {
+	return &entryPointError{reason: reason, opIndex: opIndex}
+}
+
+func (e *entryPointError) Error() string {
+	return "user operation rejected by the entry point: " + e.reason
+}
+
+// ErrorCode returns the JSON-RPC error code of the error.
+func (e *entryPointError) ErrorCode() int { return errCodeRejectedByEntryPoint }
@@ -0,0 +1,204 @@
+package bundler
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// priceBump is the minimum fee increase, in percent, of a user operation
+// replacing a pending one with the same sender and nonce.
+const priceBump = 10
+
+var (
+	// errUserOperationKnown is returned when the user operation is already in the pool.
+	errUserOperationKnown = errors.New("user operation already known")
+	// errReplacementUnderpriced is returned when the fees of a replacement user
+	// operation are not high enough.
+	errReplacementUnderpriced = errors.New("replacement user operation underpriced")
+	// errPoolFull is returned when the pool reached its maximum size.
+	errPoolFull = errors.New("user operation pool is full")
+)
+
+// poolEntry is a user operation tracked by the pool.
+type poolEntry struct {
+	hash       common.Hash
+	op         UserOperation
+	entryPoint common.Address
+	// txHash is the hash of the bundle transaction including the user
+	// operation, set once the user operation is submitted.
+	txHash common.Hash
+}
+
+// senderNonce identifies the user operations replacing each other.
+type senderNonce struct {
+	entryPoint common.Address
+	sender     common.Address
+	nonce      string
+}
+
+func newSenderNonce(entryPoint common.Address, op UserOperation) senderNonce {
+	return senderNonce{entryPoint: entryPoint, sender: op.Sender, nonce: op.Nonce.String()}
+}
+
+// pool is the in-process mempool of the user operations. It keeps the pending
+// user operations until they are bundled, and a bounded history of the
+// submitted ones to serve the eth_getUserOperationByHash and
+// eth_getUserOperationReceipt queries.
+type pool struct {
+	mtx     sync.Mutex
+	maxSize int
+
+	pending  map[common.Hash]*poolEntry
+	bySender map[senderNonce]common.Hash
+
+	submitted      map[common.Hash]*poolEntry
+	submittedOrder []common.Hash
+}
+
+func newPool(maxSize int) *pool {
+	return &pool{
+		maxSize:   maxSize,
+		pending:   make(map[common.Hash]*poolEntry),
+		bySender:  make(map[senderNonce]common.Hash),
+		submitted: make(map[common.Hash]*poolEntry),
+	}
+}
+
+// add adds a pending user operation to the pool, replacing the pending user
+// operation with the same sender and nonce if its fees are bumped enough.
+func (p *pool) add(entry *poolEntry) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.pending[entry.hash]; ok {
+		return errUserOperationKnown
+	}
+	if _, ok := p.submitted[entry.hash]; ok {
+		return errUserOperationKnown
+	}
+
+	key := newSenderNonce(entry.entryPoint, entry.op)
+	if hash, ok := p.bySender[key]; ok {
+		if !isReplacement(p.pending[hash].op, entry.op) {
+			return errReplacementUnderpriced
+		}
+		delete(p.pending, hash)
+	} else if len(p.pending) >= p.maxSize {
+		return errPoolFull
+	}
+
+	p.pending[entry.hash] = entry
+	p.bySender[key] = entry.hash
+	return nil
+}
+
+// get returns the pending or submitted user operation with the given hash.
+func (p *pool) get(hash common.Hash) (poolEntry, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if entry, ok := p.pending[hash]; ok {
+		return *entry, true
+	}
+	if entry, ok := p.submitted[hash]; ok {
+		return *entry, true
+	}
+	return poolEntry{}, false
+}
+
+// pendingBatch returns up to maxSize pending user operations of the entry
+// point. It selects the user operation with the lowest nonce of each sender,
+// sorted by decreasing priority fee.
+func (p *pool) pendingBatch(entryPoint common.Address, maxSize int) []poolEntry {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	bySender := make(map[common.Address]*poolEntry)
+	for _, entry := range p.pending {
+		if entry.entryPoint != entryPoint {
+			continue
+		}
+		if lowest, ok := bySender[entry.op.Sender]; ok && lowest.op.Nonce.ToInt().Cmp(entry.op.Nonce.ToInt()) <= 0 {
+			continue
+		}
+		bySender[entry.op.Sender] = entry
+	}
+
+	batch := make([]poolEntry, 0, len(bySender))
+	for _, entry := range bySender {
+		batch = append(batch, *entry)
+	}
+	sort.Slice(batch, func(i, j int) bool {
+		if c := batch[i].op.MaxPriorityFeePerGas.ToInt().Cmp(batch[j].op.MaxPriorityFeePerGas.ToInt()); c != 0 {
+			return c > 0
+		}
+		return batch[i].hash.Cmp(batch[j].hash) < 0
+	})
+
+	if len(batch) > maxSize {
+		batch = batch[:maxSize]
+	}
+	return batch
+}
+
+// remove removes pending user operations from the pool.
+func (p *pool) remove(hashes ...common.Hash) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, hash := range hashes {
+		p.removePending(hash)
+	}
+}
+
+// markSubmitted moves pending user operations to the submitted ones, recording
+// the hash of the bundle transaction including them. The oldest submitted
+// user operations are pruned once the history exceeds the pool size.
+func (p *pool) markSubmitted(txHash common.Hash, hashes ...common.Hash) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	for _, hash := range hashes {
+		entry := p.removePending(hash)
+		if entry == nil {
+			continue
+		}
+		entry.txHash = txHash
+		p.submitted[hash] = entry
+		p.submittedOrder = append(p.submittedOrder, hash)
+	}
+
+	for len(p.submittedOrder) > p.maxSize {
+		delete(p.submitted, p.submittedOrder[0])
+		p.submittedOrder = p.submittedOrder[1:]
+	}
+}
+
+func (p *pool) removePending(hash common.Hash) *poolEntry {
+	entry, ok := p.pending[hash]
+	if !ok {
+		return nil
+	}
+	delete(p.pending, hash)
+	key := newSenderNonce(entry.entryPoint, entry.op)
+	if p.bySender[key] == hash {
+		delete(p.bySender, key)
+	}
+	return entry
+}
+
+// isReplacement returns true if both fees of the new user operation are at
+// least priceBump percent higher than the ones of the old one.
+func isReplacement(old, replacement UserOperation) bool {
+	return isBumped(old.MaxFeePerGas.ToInt(), replacement.MaxFeePerGas.ToInt()) &&
+		isBumped(old.MaxPriorityFeePerGas.ToInt(), replacement.MaxPriorityFeePerGas.ToInt())
+}
+
+func isBumped(old, replacement *big.Int) bool {
+	threshold := new(big.Int).Mul(old, big.NewInt(100+priceBump))
+	return new(big.Int).Mul(replacement, big.NewInt(100)).Cmp(threshold) >= 0
+}
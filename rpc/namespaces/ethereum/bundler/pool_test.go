@@ -0,0 +1,139 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+var testEntryPoint = common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+
+func newTestUserOperation(sender common.Address, nonce, priorityFee int64) UserOperation {
+	return UserOperation{
+		Sender:               sender,
+		Nonce:                (*hexutil.Big)(big.NewInt(nonce)),
+		CallData:             []byte{0x01},
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(priorityFee + 100)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(priorityFee)),
+		Signature:            []byte{0x02},
+	}
+}
+
+func newTestPoolEntry(t *testing.T, op UserOperation) *poolEntry {
+	t.Helper()
+	hash, err := userOperationHash(op, testEntryPoint, big.NewInt(1))
+	require.NoError(t, err)
+	return &poolEntry{hash: hash, op: op, entryPoint: testEntryPoint}
+}
+
+func TestPoolAdd(t *testing.T) {
+	sender := common.HexToAddress("0x01")
+	op := newTestUserOperation(sender, 0, 10)
+	otherCallData := newTestUserOperation(sender, 0, 10)
+	otherCallData.CallData = []byte{0x03}
+
+	testCases := []struct {
+		name        string
+		replacement UserOperation
+		expectedErr error
+	}{
+		{
+			"same user operation",
+			op,
+			errUserOperationKnown,
+		},
+		{
+			"replacement underpriced",
+			otherCallData,
+			errReplacementUnderpriced,
+		},
+		{
+			"replacement with bumped fees",
+			newTestUserOperation(sender, 0, 50),
+			nil,
+		},
+		{
+			"other nonce",
+			newTestUserOperation(sender, 1, 1),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newPool(10)
+			entry := newTestPoolEntry(t, op)
+			require.NoError(t, p.add(entry))
+
+			replacement := newTestPoolEntry(t, tc.replacement)
+			err := p.add(replacement)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			_, ok := p.get(replacement.hash)
+			require.True(t, ok)
+		})
+	}
+}
+
+func TestPoolFull(t *testing.T) {
+	p := newPool(1)
+	require.NoError(t, p.add(newTestPoolEntry(t, newTestUserOperation(common.HexToAddress("0x01"), 0, 10))))
+	require.ErrorIs(t, p.add(newTestPoolEntry(t, newTestUserOperation(common.HexToAddress("0x02"), 0, 10))), errPoolFull)
+
+	// a replacement doesn't increase the pool size
+	require.NoError(t, p.add(newTestPoolEntry(t, newTestUserOperation(common.HexToAddress("0x01"), 0, 50))))
+}
+
+func TestPoolPendingBatch(t *testing.T) {
+	p := newPool(10)
+	low := newTestPoolEntry(t, newTestUserOperation(common.HexToAddress("0x01"), 0, 1))
+	high := newTestPoolEntry(t, newTestUserOperation(common.HexToAddress("0x02"), 0, 5))
+	sameSender := newTestPoolEntry(t, newTestUserOperation(common.HexToAddress("0x02"), 1, 10))
+	for _, entry := range []*poolEntry{low, high, sameSender} {
+		require.NoError(t, p.add(entry))
+	}
+
+	// the user operation with the lowest nonce of each sender, sorted by
+	// priority fee
+	batch := p.pendingBatch(testEntryPoint, 10)
+	require.Len(t, batch, 2)
+	require.Equal(t, high.hash, batch[0].hash)
+	require.Equal(t, low.hash, batch[1].hash)
+
+	require.Len(t, p.pendingBatch(testEntryPoint, 1), 1)
+	require.Empty(t, p.pendingBatch(common.HexToAddress("0x03"), 10))
+}
+
+func TestPoolMarkSubmitted(t *testing.T) {
+	p := newPool(1)
+	first := newTestPoolEntry(t, newTestUserOperation(common.HexToAddress("0x01"), 0, 1))
+	second := newTestPoolEntry(t, newTestUserOperation(common.HexToAddress("0x02"), 0, 1))
+
+	require.NoError(t, p.add(first))
+	p.markSubmitted(common.HexToHash("0x01"), first.hash)
+	require.Empty(t, p.pendingBatch(testEntryPoint, 10))
+
+	entry, ok := p.get(first.hash)
+	require.True(t, ok)
+	require.Equal(t, common.HexToHash("0x01"), entry.txHash)
+
+	// the submitted user operations can't be added again
+	require.ErrorIs(t, p.add(first), errUserOperationKnown)
+
+	// the oldest submitted user operations are pruned
+	require.NoError(t, p.add(second))
+	p.markSubmitted(common.HexToHash("0x02"), second.hash)
+	_, ok = p.get(first.hash)
+	require.False(t, ok)
+	_, ok = p.get(second.hash)
+	require.True(t, ok)
+}
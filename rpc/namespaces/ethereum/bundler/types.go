@@ -0,0 +1,118 @@
+package bundler
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// UserOperation is the RPC representation of an ERC-4337 user operation, as
+// defined by the v0.6 EntryPoint.
+type UserOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+// Validate returns an error if a required field of the user operation is
+// missing.
+func (op UserOperation) Validate() error {
+	if op.Sender == (common.Address{}) {
+		return errors.New("missing user operation sender")
+	}
+	if op.Nonce == nil {
+		return errors.New("missing user operation nonce")
+	}
+	if op.MaxFeePerGas == nil || op.MaxPriorityFeePerGas == nil {
+		return errors.New("missing user operation fees")
+	}
+	if op.MaxPriorityFeePerGas.ToInt().Cmp(op.MaxFeePerGas.ToInt()) > 0 {
+		return errors.New("user operation max priority fee per gas higher than max fee per gas")
+	}
+	if op.CallGasLimit == nil || op.VerificationGasLimit == nil || op.PreVerificationGas == nil {
+		return errors.New("missing user operation gas limits")
+	}
+	return nil
+}
+
+// UserOperationGasEstimate is the result of eth_estimateUserOperationGas.
+type UserOperationGasEstimate struct {
+	PreVerificationGas   hexutil.Uint64 `json:"preVerificationGas"`
+	VerificationGasLimit hexutil.Uint64 `json:"verificationGasLimit"`
+	CallGasLimit         hexutil.Uint64 `json:"callGasLimit"`
+}
+
+// UserOperationByHash is the result of eth_getUserOperationByHash. The block
+// and transaction fields are nil until the bundle transaction is included.
+type UserOperationByHash struct {
+	UserOperation   UserOperation   `json:"userOperation"`
+	EntryPoint      common.Address  `json:"entryPoint"`
+	BlockNumber     *hexutil.Uint64 `json:"blockNumber"`
+	BlockHash       *common.Hash    `json:"blockHash"`
+	TransactionHash *common.Hash    `json:"transactionHash"`
+}
+
+// UserOperationReceipt is the result of eth_getUserOperationReceipt.
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash            `json:"userOpHash"`
+	EntryPoint    common.Address         `json:"entryPoint"`
+	Sender        common.Address         `json:"sender"`
+	Nonce         *hexutil.Big           `json:"nonce"`
+	Paymaster     common.Address         `json:"paymaster"`
+	ActualGasCost *hexutil.Big           `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big           `json:"actualGasUsed"`
+	Success       bool                   `json:"success"`
+	Reason        string                 `json:"reason"`
+	Logs          []*ethtypes.Log        `json:"logs"`
+	Receipt       map[string]interface{} `json:"receipt"`
+}
+
+// packedUserOperation is the ABI representation of a user operation.
+type packedUserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+// pack returns the ABI representation of the user operation.
+func (op UserOperation) pack() packedUserOperation {
+	return packedUserOperation{
+		Sender:               op.Sender,
+		Nonce:                op.Nonce.ToInt(),
+		InitCode:             nonNilBytes(op.InitCode),
+		CallData:             nonNilBytes(op.CallData),
+		CallGasLimit:         op.CallGasLimit.ToInt(),
+		VerificationGasLimit: op.VerificationGasLimit.ToInt(),
+		PreVerificationGas:   op.PreVerificationGas.ToInt(),
+		MaxFeePerGas:         op.MaxFeePerGas.ToInt(),
+		MaxPriorityFeePerGas: op.MaxPriorityFeePerGas.ToInt(),
+		PaymasterAndData:     nonNilBytes(op.PaymasterAndData),
+		Signature:            nonNilBytes(op.Signature),
+	}
+}
+
+func nonNilBytes(bz []byte) []byte {
+	if bz == nil {
+		return []byte{}
+	}
+	return bz
+}
@@ -54,6 +54,7 @@ type EthereumAPI interface {
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionBatch(data []hexutil.Bytes) ([]common.Hash, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
@@ -265,6 +266,15 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (_ common.Hash, err e
 	return e.backend.SendRawTransaction(ctx, data)
 }
 
+// SendRawTransactionBatch sends raw Ethereum transactions that are executed
+// atomically in a single Cosmos transaction.
+func (e *PublicAPI) SendRawTransactionBatch(data []hexutil.Bytes) (_ []common.Hash, err error) {
+	ctx, span := tracer.Start(context.Background(), "eth_sendRawTransactionBatch")
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+	e.logger.Debug("eth_sendRawTransactionBatch", "count", len(data))
+	return e.backend.SendRawTransactionBatch(ctx, data)
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (_ common.Hash, err error) {
 	ctx, span := tracer.Start(context.Background(), "eth_sendTransaction")
@@ -51,6 +51,7 @@ type Backend interface {
 	CometBlockResultByNumber(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(ctx context.Context, blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(ctx context.Context, height *int64) ([][]*ethtypes.Log, error)
+	GetLogsFromIndexer(ctx context.Context, from, to int64, addresses []common.Address, topics [][]common.Hash) ([]*ethtypes.Log, int64, error)
 	BlockBloomFromCometBlock(ctx context.Context, blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
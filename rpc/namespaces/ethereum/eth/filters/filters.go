@@ -172,7 +172,16 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) (logs
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
-	for height := from; height <= to; height++ {
+	// serve the blocks covered by the log indexes of the EVM indexer first
+	logs, indexedTo, err := f.backend.GetLogsFromIndexer(ctx, int64(from), int64(to), f.criteria.Addresses, f.criteria.Topics) //#nosec G115
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch logs from the indexer: %w", err)
+	}
+	if len(logs) > logLimit {
+		return nil, fmt.Errorf("query returned more than %d results", logLimit)
+	}
+
+	for height := uint64(indexedTo + 1); height <= to; height++ { //#nosec G115
 		h := int64(height) //#nosec G115
 		blockRes, err := f.backend.CometBlockResultByNumber(ctx, &h)
 		if err != nil {
@@ -58,6 +58,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *filtermocks.Backend {
 				backend := &filtermocks.Backend{}
 				backend.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(fakeHeader, nil)
+				backend.EXPECT().GetLogsFromIndexer(mock.Anything, blockHeight, blockHeight, mock.Anything, mock.Anything).Return(nil, blockHeight-1, nil)
 				backend.EXPECT().CometBlockResultByNumber(mock.Anything, &blockHeight).Return((*cmtrpctypes.ResultBlockResults)(nil), errors.New("block result error"))
 				return backend
 			},
@@ -74,6 +75,7 @@ func TestLogs(t *testing.T) {
 			prepare: func() *filtermocks.Backend {
 				backend := &filtermocks.Backend{}
 				backend.EXPECT().HeaderByNumber(mock.Anything, mock.Anything).Return(fakeHeader, nil)
+				backend.EXPECT().GetLogsFromIndexer(mock.Anything, blockHeight, blockHeight, mock.Anything, mock.Anything).Return(nil, blockHeight-1, nil)
 				backend.EXPECT().CometBlockResultByNumber(mock.Anything, &blockHeight).Return(fakeBlockRes, nil)
 				backend.EXPECT().BlockBloomFromCometBlock(mock.Anything, fakeBlockRes).Return(ethtypes.Bloom{}, errors.New("bloom error"))
 				return backend
@@ -146,6 +148,37 @@ func TestFilter(t *testing.T) {
 			},
 			expErr: "invalid block range params",
 		},
+		{
+			name:   "logs served by the indexer",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(5)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(5)}, nil)
+				b.EXPECT().GetLogsFromIndexer(mock.Anything, int64(1), int64(5), mock.Anything, mock.Anything).Return([]*ethtypes.Log{{BlockNumber: 2}}, int64(5), nil)
+			},
+			expLogs: []*ethtypes.Log{{BlockNumber: 2}},
+		},
+		{
+			name:   "blocks after the indexed range are searched",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(5)},
+			expectations: func(b *filtermocks.Backend) {
+				height := int64(5)
+				blockRes := &cmtrpctypes.ResultBlockResults{Height: height}
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(5)}, nil)
+				b.EXPECT().GetLogsFromIndexer(mock.Anything, int64(1), int64(5), mock.Anything, mock.Anything).Return([]*ethtypes.Log{{BlockNumber: 2}}, int64(4), nil)
+				b.EXPECT().CometBlockResultByNumber(mock.Anything, &height).Return(blockRes, nil)
+				b.EXPECT().BlockBloomFromCometBlock(mock.Anything, blockRes).Return(ethtypes.Bloom{}, nil)
+			},
+			expLogs: []*ethtypes.Log{{BlockNumber: 2}},
+		},
+		{
+			name:   "indexer logs over the limit return error",
+			filter: filters.FilterCriteria{FromBlock: big.NewInt(1), ToBlock: big.NewInt(5)},
+			expectations: func(b *filtermocks.Backend) {
+				b.EXPECT().HeaderByNumber(mock.Anything, rpctypes.EthLatestBlockNumber).Return(&ethtypes.Header{Number: big.NewInt(5)}, nil)
+				b.EXPECT().GetLogsFromIndexer(mock.Anything, int64(1), int64(5), mock.Anything, mock.Anything).Return(make([]*ethtypes.Log, 16), int64(5), nil)
+			},
+			expErr: "query returned more than 15 results",
+		},
 	}
 
 	for _, tc := range testCases {
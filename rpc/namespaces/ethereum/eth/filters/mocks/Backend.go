@@ -439,6 +439,75 @@ func (_c *Backend_GetLogsByHeight_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// GetLogsFromIndexer provides a mock function with given fields: ctx, from, to, addresses, topics
+func (_m *Backend) GetLogsFromIndexer(ctx context.Context, from int64, to int64, addresses []common.Address, topics [][]common.Hash) ([]*types.Log, int64, error) {
+	ret := _m.Called(ctx, from, to, addresses, topics)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLogsFromIndexer")
+	}
+
+	var r0 []*types.Log
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) ([]*types.Log, int64, error)); ok {
+		return rf(ctx, from, to, addresses, topics)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) []*types.Log); ok {
+		r0 = rf(ctx, from, to, addresses, topics)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) int64); ok {
+		r1 = rf(ctx, from, to, addresses, topics)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int64, int64, []common.Address, [][]common.Hash) error); ok {
+		r2 = rf(ctx, from, to, addresses, topics)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Backend_GetLogsFromIndexer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLogsFromIndexer'
+type Backend_GetLogsFromIndexer_Call struct {
+	*mock.Call
+}
+
+// GetLogsFromIndexer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from int64
+//   - to int64
+//   - addresses []common.Address
+//   - topics [][]common.Hash
+func (_e *Backend_Expecter) GetLogsFromIndexer(ctx interface{}, from interface{}, to interface{}, addresses interface{}, topics interface{}) *Backend_GetLogsFromIndexer_Call {
+	return &Backend_GetLogsFromIndexer_Call{Call: _e.mock.On("GetLogsFromIndexer", ctx, from, to, addresses, topics)}
+}
+
+func (_c *Backend_GetLogsFromIndexer_Call) Run(run func(ctx context.Context, from int64, to int64, addresses []common.Address, topics [][]common.Hash)) *Backend_GetLogsFromIndexer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].([]common.Address), args[4].([][]common.Hash))
+	})
+	return _c
+}
+
+func (_c *Backend_GetLogsFromIndexer_Call) Return(_a0 []*types.Log, _a1 int64, _a2 error) *Backend_GetLogsFromIndexer_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Backend_GetLogsFromIndexer_Call) RunAndReturn(run func(context.Context, int64, int64, []common.Address, [][]common.Hash) ([]*types.Log, int64, error)) *Backend_GetLogsFromIndexer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HeaderByHash provides a mock function with given fields: ctx, blockHash
 func (_m *Backend) HeaderByHash(ctx context.Context, blockHash common.Hash) (*types.Header, error) {
 	ret := _m.Called(ctx, blockHash)
@@ -0,0 +1,230 @@
+package rpc
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+const (
+	// errCodeLimitExceeded is the EIP-1474 JSON-RPC error code of the
+	// requests exceeding the rate limit.
+	errCodeLimitExceeded = -32005
+	// rateLimitProxyHeader is the header holding the proxy token of the
+	// requests forwarded by the websocket server.
+	rateLimitProxyHeader = "X-Rate-Limit-Proxy"
+	// clientExpiry is the duration after which the bucket of an idle client
+	// is dropped, it is refilled by then with the default configuration.
+	clientExpiry = 10 * time.Minute
+)
+
+var (
+	rateLimitAllowedMeter = metrics.NewRegisteredMeter("rpc/ratelimit/allowed", nil)
+	rateLimitLimitedMeter = metrics.NewRegisteredMeter("rpc/ratelimit/limited", nil)
+	rateLimitCostMeter    = metrics.NewRegisteredMeter("rpc/ratelimit/cost", nil)
+	rateLimitClientsGauge = metrics.NewRegisteredGauge("rpc/ratelimit/clients", nil)
+)
+
+// clientBucket is the token bucket of a client.
+type clientBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter limits the rate of the JSON-RPC requests of every client,
+// identified by its API key or its IP address, with token buckets consumed by
+// the costs of the requested methods.
+type RateLimiter struct {
+	rate        rate.Limit
+	burst       int
+	defaultCost int
+	// methodCosts and namespaceCosts hold the lower-cased method names and
+	// namespaces of the configured costs, as the config keys are lower-cased.
+	methodCosts    map[string]int
+	namespaceCosts map[string]int
+	wildcardCost   int
+	// proxyToken marks the requests forwarded by the websocket server, which
+	// are limited by the websocket server.
+	proxyToken string
+
+	mtx       sync.Mutex
+	clients   map[string]*clientBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewRateLimiter creates the RateLimiter of the configuration, it returns nil
+// if the rate limits are disabled.
+func NewRateLimiter(cfg config.RateLimitConfig) (*RateLimiter, error) {
+	if !cfg.Enable {
+		return nil, nil
+	}
+
+	rl := &RateLimiter{
+		rate:           rate.Limit(cfg.Rate),
+		burst:          cfg.Burst,
+		defaultCost:    cfg.DefaultCost,
+		methodCosts:    make(map[string]int),
+		namespaceCosts: make(map[string]int),
+		wildcardCost:   -1,
+		clients:        make(map[string]*clientBucket),
+		now:            time.Now,
+	}
+	for pattern, cost := range cfg.MethodCosts {
+		pattern = strings.ToLower(pattern)
+		switch {
+		case pattern == "*":
+			rl.wildcardCost = cost
+		case strings.HasSuffix(pattern, "_*"):
+			rl.namespaceCosts[strings.TrimSuffix(pattern, "_*")] = cost
+		default:
+			rl.methodCosts[pattern] = cost
+		}
+	}
+
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, errors.Wrap(err, "failed to generate the websocket proxy token")
+	}
+	rl.proxyToken = hex.EncodeToString(token)
+	rl.lastSweep = rl.now()
+	return rl, nil
+}
+
+// MethodCost returns the cost of the method.
+func (rl *RateLimiter) MethodCost(method string) int {
+	method = strings.ToLower(method)
+	if cost, ok := rl.methodCosts[method]; ok {
+		return cost
+	}
+	if namespace, _, ok := strings.Cut(method, "_"); ok {
+		if cost, ok := rl.namespaceCosts[namespace]; ok {
+			return cost
+		}
+	}
+	if rl.wildcardCost >= 0 {
+		return rl.wildcardCost
+	}
+	return rl.defaultCost
+}
+
+// RequestCost returns the total cost of the methods of the single or batch
+// request, an invalid request costs the default cost.
+func (rl *RateLimiter) RequestCost(body []byte) int {
+	methods, err := requestMethods(body)
+	if err != nil {
+		return rl.defaultCost
+	}
+	var cost int
+	for _, method := range methods {
+		cost += rl.MethodCost(method)
+	}
+	return cost
+}
+
+// ClientKey returns the key identifying the client of the request, the API
+// key which authenticated it, or its IP address otherwise.
+func (rl *RateLimiter) ClientKey(r *http.Request) string {
+	if key, ok := r.Context().Value(apiKeyContextKey{}).(string); ok {
+		return "key:" + key
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// Allow consumes the cost from the bucket of the client, it returns an error
+// with the delay before the cost is available if the bucket doesn't hold it.
+func (rl *RateLimiter) Allow(client string, cost int) error {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	now := rl.now()
+	rl.sweep(now)
+	bucket, ok := rl.clients[client]
+	if !ok {
+		bucket = &clientBucket{limiter: rate.NewLimiter(rl.rate, rl.burst)}
+		rl.clients[client] = bucket
+		rateLimitClientsGauge.Update(int64(len(rl.clients)))
+	}
+	bucket.lastSeen = now
+
+	// a batch may cost more than the burst, it is charged the burst
+	cost = min(cost, rl.burst)
+	if !bucket.limiter.AllowN(now, cost) {
+		rateLimitLimitedMeter.Mark(1)
+		tokens := bucket.limiter.TokensAt(now)
+		retryAfter := time.Duration(math.Ceil((float64(cost) - tokens) / float64(rl.rate) * float64(time.Second)))
+		return &limitError{retryAfter: retryAfter}
+	}
+	rateLimitAllowedMeter.Mark(1)
+	rateLimitCostMeter.Mark(int64(cost))
+	return nil
+}
+
+// sweep drops the buckets of the clients idle for the client expiry.
+func (rl *RateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < clientExpiry {
+		return
+	}
+	for client, bucket := range rl.clients {
+		if now.Sub(bucket.lastSeen) >= clientExpiry {
+			delete(rl.clients, client)
+		}
+	}
+	rl.lastSweep = now
+	rateLimitClientsGauge.Update(int64(len(rl.clients)))
+}
+
+// Handler returns the handler rate limiting the requests before serving them
+// with next. The requests forwarded by the websocket server are not limited.
+func (rl *RateLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(rateLimitProxyHeader)), []byte(rl.proxyToken)) == 1 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := readRequestBody(r)
+		if err != nil {
+			writeAuthError(w, err)
+			return
+		}
+		if err := rl.Allow(rl.ClientKey(r), rl.RequestCost(body)); err != nil {
+			var limitErr *limitError
+			if errors.As(err, &limitErr) {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(limitErr.retryAfter.Seconds()))))
+			}
+			writeErrorResponse(w, http.StatusTooManyRequests, errCodeLimitExceeded, err.Error())
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limitError is returned when a request exceeds the rate limit.
+type limitError struct {
+	retryAfter time.Duration
+}
+
+func (e *limitError) Error() string {
+	return "request rate limit exceeded, retry after " + e.retryAfter.Round(time.Millisecond).String()
+}
+
+// ErrorCode returns the JSON-RPC error code of the error.
+func (e *limitError) ErrorCode() int { return errCodeLimitExceeded }
@@ -0,0 +1,144 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/server/config"
+)
+
+func newTestRateLimiter(t *testing.T) *RateLimiter {
+	t.Helper()
+	cfg := config.DefaultRateLimitConfig()
+	cfg.Enable = true
+	cfg.Rate = 10
+	cfg.Burst = 20
+	// the config keys are lower-cased by viper
+	cfg.MethodCosts = map[string]int{"debug_*": 20, "eth_getlogs": 10, "eth_chainid": 0}
+	rl, err := NewRateLimiter(cfg)
+	require.NoError(t, err)
+	return rl
+}
+
+func TestNewRateLimiterDisabled(t *testing.T) {
+	rl, err := NewRateLimiter(config.DefaultRateLimitConfig())
+	require.NoError(t, err)
+	require.Nil(t, rl)
+}
+
+func TestRateLimiterCosts(t *testing.T) {
+	rl := newTestRateLimiter(t)
+
+	require.Equal(t, 20, rl.MethodCost("debug_traceTransaction"))
+	require.Equal(t, 10, rl.MethodCost("eth_getLogs"))
+	require.Equal(t, 0, rl.MethodCost("eth_chainId"))
+	require.Equal(t, 1, rl.MethodCost("eth_blockNumber"))
+
+	require.Equal(t, 10, rl.RequestCost([]byte(`{"method":"eth_getLogs"}`)))
+	require.Equal(t, 11, rl.RequestCost([]byte(`[{"method":"eth_getLogs"},{"method":"eth_chainId"},{"method":"net_version"}]`)))
+	require.Equal(t, 1, rl.RequestCost([]byte(`{"method"`)))
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	rl := newTestRateLimiter(t)
+	now := time.Now()
+	rl.now = func() time.Time { return now }
+
+	// the bucket holds the burst
+	require.NoError(t, rl.Allow("ip:1.1.1.1", 10))
+	require.NoError(t, rl.Allow("ip:1.1.1.1", 10))
+	err := rl.Allow("ip:1.1.1.1", 5)
+	var limitErr *limitError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, errCodeLimitExceeded, limitErr.ErrorCode())
+	require.Equal(t, 500*time.Millisecond, limitErr.retryAfter)
+
+	// the free methods are always allowed
+	require.NoError(t, rl.Allow("ip:1.1.1.1", 0))
+	// the other clients have their own bucket
+	require.NoError(t, rl.Allow("ip:2.2.2.2", 20))
+
+	// the bucket is refilled at the rate
+	now = now.Add(500 * time.Millisecond)
+	require.NoError(t, rl.Allow("ip:1.1.1.1", 5))
+	require.Error(t, rl.Allow("ip:1.1.1.1", 1))
+
+	// the buckets of the idle clients are dropped
+	now = now.Add(clientExpiry)
+	require.NoError(t, rl.Allow("ip:3.3.3.3", 1))
+	require.Len(t, rl.clients, 1)
+}
+
+func TestRateLimiterHandler(t *testing.T) {
+	rl := newTestRateLimiter(t)
+	auth := newTestAuthenticator(t)
+	handler := auth.Handler(rl.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	serve := func(body, apiKey string, proxied bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.RemoteAddr = "1.1.1.1:1234"
+		if apiKey != "" {
+			req.Header.Set(apiKeyHeader, apiKey)
+		}
+		if proxied {
+			req.Header.Set(rateLimitProxyHeader, rl.proxyToken)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	trace := `{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`
+	require.Equal(t, http.StatusOK, serve(trace, "key1", false).Code)
+	rec := serve(trace, "key1", false)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "2", rec.Header().Get("Retry-After"))
+	require.Contains(t, rec.Body.String(), "-32005")
+
+	// the clients are identified by their API key
+	require.Equal(t, http.StatusOK, serve(trace, "key2", false).Code)
+	// the requests forwarded by the websocket server are not limited
+	require.Equal(t, http.StatusOK, serve(trace, "key1", true).Code)
+	// the unauthenticated clients are identified by their IP address
+	require.Equal(t, http.StatusOK, serve(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"}`, "", false).Code)
+	require.Equal(t, http.StatusOK, serve(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"}`, "", false).Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"}`, "", false).Code)
+}
+
+func TestWebsocketRateLimit(t *testing.T) {
+	srv := newTestWebsocketServer()
+	srv.limiter = newTestRateLimiter(t)
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	u, _ := url.Parse(ts.URL)
+	u.Scheme = "ws"
+
+	conn, httpResp, err := websocket.DefaultDialer.DialContext(context.Background(), u.String(), nil)
+	require.NoError(t, err)
+	httpResp.Body.Close()
+	defer conn.Close()
+
+	// the first request consumes the burst, it fails as the rpc server isn't
+	// running
+	trace := []byte(`{"jsonrpc":"2.0","id":1,"method":"debug_traceTransaction"}`)
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, trace))
+	var res ErrorResponseJSON
+	require.NoError(t, conn.ReadJSON(&res))
+	require.NotEqual(t, int64(errCodeLimitExceeded), res.Error.Code.Int64())
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, trace))
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Equal(t, int64(errCodeLimitExceeded), res.Error.Code.Int64())
+}
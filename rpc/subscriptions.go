@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpcfilters "github.com/cosmos/evm/rpc/namespaces/ethereum/eth/filters"
+	"github.com/cosmos/evm/rpc/stream"
+
+	"cosmossdk.io/log"
+)
+
+// SubscriptionAPI serves the eth_subscribe subscriptions of the rpc/stream
+// events on the connections supporting notifications, such as the IPC ones.
+// The websocket server handles its subscriptions itself.
+type SubscriptionAPI struct {
+	events *stream.RPCStream
+	logger log.Logger
+}
+
+// NewSubscriptionAPI creates an instance of the subscription API.
+func NewSubscriptionAPI(logger log.Logger, events *stream.RPCStream) *SubscriptionAPI {
+	return &SubscriptionAPI{
+		events: events,
+		logger: logger.With("module", "subscriptions"),
+	}
+}
+
+// NewHeads sends a notification each time a new block header is appended to
+// the chain.
+func (api *SubscriptionAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	return subscribe(ctx, api, api.events.HeaderStream(), func(header stream.RPCHeader) []any {
+		return []any{header.EthHeader}
+	})
+}
+
+// Logs sends a notification for each log of the new blocks matching the
+// criteria.
+func (api *SubscriptionAPI) Logs(ctx context.Context, crit filters.FilterCriteria) (*rpc.Subscription, error) {
+	return subscribe(ctx, api, api.events.LogStream(), func(txLog *ethtypes.Log) []any {
+		logs := rpcfilters.FilterLogs([]*ethtypes.Log{txLog}, crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
+		results := make([]any, len(logs))
+		for i, ethLog := range logs {
+			results[i] = ethLog
+		}
+		return results
+	})
+}
+
+// NewPendingTransactions sends a notification with the hash of each
+// transaction entering the mempool.
+func (api *SubscriptionAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+	return subscribe(ctx, api, api.events.PendingTxStream(), func(hash common.Hash) []any {
+		return []any{hash}
+	})
+}
+
+// subscribe creates a subscription sending the results of the new items of
+// the stream until it is unsubscribed or its connection is closed.
+func subscribe[V any](ctx context.Context, api *SubscriptionAPI, s *stream.Stream[V], results func(V) []any) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+
+	sub := notifier.CreateSubscription()
+	subCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-sub.Err()
+		cancel()
+	}()
+
+	//nolint: errcheck
+	go s.Subscribe(subCtx, func(items []V, _ int) error {
+		for _, item := range items {
+			for _, result := range results(item) {
+				if err := notifier.Notify(sub.ID, result); err != nil {
+					api.logger.Debug("failed to send the subscription notification", "id", sub.ID, "error", err.Error())
+					cancel()
+					return err
+				}
+			}
+		}
+		return nil
+	})
+
+	return sub, nil
+}
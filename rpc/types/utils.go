@@ -360,10 +360,17 @@ func TxStateDBCommitError(res *abci.ExecTxResult) bool {
 	return strings.Contains(res.Log, StateDBCommitError)
 }
 
+// TxBatchReverted returns true if the tx is an atomic batch of evm txs reverted
+// because one of them failed.
+func TxBatchReverted(res *abci.ExecTxResult) bool {
+	return res.Codespace == evmtypes.ModuleName && res.Code == evmtypes.ErrBatchReverted.ABCICode()
+}
+
 // TxSucessOrExpectedFailure returns true if the transaction was successful
-// or if it failed with an ExceedBlockGasLimit error or TxStateDBCommitError error
+// or if it failed with an ExceedBlockGasLimit, TxStateDBCommitError or
+// TxBatchReverted error
 func TxSucessOrExpectedFailure(res *abci.ExecTxResult) bool {
-	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res)
+	return res.Code == 0 || TxExceedBlockGasLimit(res) || TxStateDBCommitError(res) || TxBatchReverted(res)
 }
 
 // CalcBaseFee calculates the basefee of the header.
@@ -76,9 +76,22 @@ type websocketsServer struct {
 	allowedOrigins []string // allowed origins for WebSocket connections
 	api            *pubSubAPI
 	logger         log.Logger
+	// auth authenticates the connections and checks the methods of their
+	// requests, nil if the authentication is disabled
+	auth *Authenticator
+	// limiter limits the rate of the requests of the clients, nil if the
+	// rate limits are disabled
+	limiter *RateLimiter
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *stream.RPCStream, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	stream *stream.RPCStream,
+	cfg *config.Config,
+	auth *Authenticator,
+	limiter *RateLimiter,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	return &websocketsServer{
 		rpcAddr:        cfg.JSONRPC.Address,
@@ -88,6 +101,8 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, stream *st
 		allowedOrigins: cfg.JSONRPC.WSOrigins,
 		api:            newPubSubAPI(clientCtx, logger, stream),
 		logger:         logger,
+		auth:           auth,
+		limiter:        limiter,
 	}
 }
 
@@ -181,6 +196,15 @@ func (s *websocketsServer) checkOrigin(r *http.Request) bool {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var authenticated bool
+	if s.auth != nil {
+		var err error
+		if r, authenticated, err = s.auth.authenticateRequest(r); err != nil {
+			writeAuthError(w, err)
+			return
+		}
+	}
+
 	upgrader := websocket.Upgrader{
 		CheckOrigin: s.checkOrigin,
 	}
@@ -194,18 +218,26 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(maxMessageSize)
 
 	ws := &wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:           new(sync.Mutex),
+		conn:          conn,
+		authenticated: authenticated,
+	}
+	if s.limiter != nil {
+		ws.client = s.limiter.ClientKey(r)
 	}
 
 	s.readLoop(ws)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
+	s.sendErrCodeResponse(wsConn, -32600, msg)
+}
+
+func (s *websocketsServer) sendErrCodeResponse(wsConn *wsConn, code int, msg string) {
 	res := &ErrorResponseJSON{
 		Jsonrpc: "2.0",
 		Error: &ErrorMessageJSON{
-			Code:    big.NewInt(-32600),
+			Code:    big.NewInt(int64(code)),
 			Message: msg,
 		},
 		ID: nil,
@@ -214,9 +246,44 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res) // #nosec G703
 }
 
+// checkRequest sends an error response to the client and returns false if
+// one of the methods of the request cannot be called by the connection.
+func (s *websocketsServer) checkRequest(wsConn *wsConn, mb []byte) bool {
+	if s.auth == nil {
+		return true
+	}
+	if err := s.auth.CheckRequest(mb, wsConn.authenticated); err != nil {
+		var authErr *authError
+		if errors.As(err, &authErr) {
+			s.sendErrCodeResponse(wsConn, authErr.ErrorCode(), authErr.Error())
+		} else {
+			s.sendErrResponse(wsConn, err.Error())
+		}
+		return false
+	}
+	return true
+}
+
+// checkRateLimit sends an error response to the client and returns false if
+// the request exceeds the rate limit of the connection's client.
+func (s *websocketsServer) checkRateLimit(wsConn *wsConn, mb []byte) bool {
+	if s.limiter == nil {
+		return true
+	}
+	if err := s.limiter.Allow(wsConn.client, s.limiter.RequestCost(mb)); err != nil {
+		s.sendErrCodeResponse(wsConn, errCodeLimitExceeded, err.Error())
+		return false
+	}
+	return true
+}
+
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+	// authenticated is true if the connection was opened with valid credentials
+	authenticated bool
+	// client is the key of the rate limit bucket of the connection
+	client string
 }
 
 func (w *wsConn) WriteJSON(v any) error {
@@ -259,6 +326,10 @@ readLoop:
 			return
 		}
 
+		if !s.checkRequest(wsConn, mb) || !s.checkRateLimit(wsConn, mb) {
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -388,6 +459,14 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.auth != nil {
+		// the methods of the request are checked by the websocket server
+		req.Header.Set("Authorization", "Bearer "+s.auth.proxyToken)
+	}
+	if s.limiter != nil {
+		// the request is rate limited by the websocket server
+		req.Header.Set(rateLimitProxyHeader, s.limiter.proxyToken)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
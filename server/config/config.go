@@ -5,12 +5,13 @@ import (
 	"fmt"
 	"net/netip"
 	"path"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/viper"
 
-	"github.com/cometbft/cometbft/libs/strings"
-
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -114,6 +115,13 @@ const (
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
 
+	// DefaultIndexerBackend is the default backend of the custom indexer
+	DefaultIndexerBackend = IndexerBackendKV
+
+	// DefaultIndexerLogRetention is the default number of recent blocks whose logs are kept
+	// in the log indexes of the custom indexer (all = 0)
+	DefaultIndexerLogRetention = 0
+
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
@@ -122,8 +130,36 @@ const (
 
 	// DefaultEnableProfiling toggles whether profiling is enabled in the `debug` namespace
 	DefaultEnableProfiling = false
+
+	// DefaultBundleInterval is the default interval between the ERC-4337 bundle transactions
+	DefaultBundleInterval = 2 * time.Second
+
+	// DefaultMaxBundleSize is the default maximum number of user operations in a bundle
+	DefaultMaxBundleSize = 10
+
+	// DefaultMaxBundlerPoolSize is the default maximum number of user operations kept by the bundler
+	DefaultMaxBundlerPoolSize = 4096
+
+	// DefaultRateLimitRate is the default number of tokens added to the rate limit bucket of a client per second
+	DefaultRateLimitRate = 100
+
+	// DefaultRateLimitBurst is the default size of the rate limit bucket of a client
+	DefaultRateLimitBurst = 200
+
+	// DefaultRateLimitCost is the default rate limit cost of the methods
+	DefaultRateLimitCost = 1
 )
 
+const (
+	// IndexerBackendKV is the custom indexer backend storing the index in a local db
+	IndexerBackendKV = "kv"
+	// IndexerBackendPSQL is the custom indexer backend storing the index in a PostgreSQL database
+	IndexerBackendPSQL = "psql"
+)
+
+// methodCostsKey is the config key of the JSON-RPC rate limit method costs.
+const methodCostsKey = "json-rpc.rate-limit.method-costs"
+
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
 // Config defines the server's top level configuration. It includes the default app config
@@ -171,6 +207,12 @@ type MempoolConfig struct {
 	GlobalQueue uint64 `mapstructure:"global-queue"`
 	// Lifetime is the maximum amount of time non-executable transaction are queued
 	Lifetime time.Duration `mapstructure:"lifetime"`
+	// CosmosAccountQueue is the maximum number of Cosmos transactions with a future sequence queued per account
+	CosmosAccountQueue uint64 `mapstructure:"cosmos-account-queue"`
+	// CosmosGlobalQueue is the maximum number of Cosmos transactions with a future sequence queued for all accounts
+	CosmosGlobalQueue uint64 `mapstructure:"cosmos-global-queue"`
+	// CosmosLifetime is the maximum amount of time Cosmos transactions with a future sequence are queued
+	CosmosLifetime time.Duration `mapstructure:"cosmos-lifetime"`
 }
 
 // DefaultMempoolConfig returns the default mempool configuration
@@ -183,6 +225,10 @@ func DefaultMempoolConfig() MempoolConfig {
 		AccountQueue: 64,            // 64 non-executable transaction slots per account
 		GlobalQueue:  1024,          // 1024 global non-executable slots
 		Lifetime:     3 * time.Hour, // 3 hour lifetime for queued transactions
+
+		CosmosAccountQueue: 64,            // 64 queued Cosmos transactions per account
+		CosmosGlobalQueue:  1024,          // 1024 global queued Cosmos transactions
+		CosmosLifetime:     3 * time.Hour, // 3 hour lifetime for queued Cosmos transactions
 	}
 }
 
@@ -209,6 +255,15 @@ func (c MempoolConfig) Validate() error {
 	if c.Lifetime < 1 {
 		return fmt.Errorf("lifetime must be at least 1 nanosecond, got %s", c.Lifetime)
 	}
+	if c.CosmosAccountQueue < 1 {
+		return fmt.Errorf("cosmos account queue must be at least 1, got %d", c.CosmosAccountQueue)
+	}
+	if c.CosmosGlobalQueue < 1 {
+		return fmt.Errorf("cosmos global queue must be at least 1, got %d", c.CosmosGlobalQueue)
+	}
+	if c.CosmosLifetime < 1 {
+		return fmt.Errorf("cosmos lifetime must be at least 1 nanosecond, got %s", c.CosmosLifetime)
+	}
 	return nil
 }
 
@@ -220,6 +275,9 @@ type JSONRPCConfig struct {
 	Address string `mapstructure:"address"`
 	// WsAddress defines the WebSocket server to listen on
 	WsAddress string `mapstructure:"ws-address"`
+	// IPCPath defines the path of the unix socket of the IPC endpoint, a relative path is
+	// resolved under the node home directory. The IPC endpoint is disabled if empty.
+	IPCPath string `mapstructure:"ipc-path"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// AllowInsecureUnlock toggles if account unlocking is enabled when account-related RPCs are exposed by http.
@@ -254,12 +312,74 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerBackend defines the backend of the custom indexer, kv or psql.
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// IndexerPSQLConn defines the connection string of the PostgreSQL database of the psql indexer backend.
+	IndexerPSQLConn string `mapstructure:"indexer-psql-conn"`
+	// IndexerLogRetention defines the number of recent blocks whose logs are kept in the
+	// log indexes of the custom indexer, 0 keeps the logs of all the blocks.
+	IndexerLogRetention uint64 `mapstructure:"indexer-log-retention"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// WSOrigins defines the allowed origins for WebSocket connections
 	WSOrigins []string `mapstructure:"ws-origins"`
 	// EnableProfiling enables the profiling in the `debug` namespace. SHOULD NOT be used on public tracing nodes
 	EnableProfiling bool `mapstructure:"enable-profiling"`
+	// Bundler defines the configuration of the ERC-4337 bundler, enabled with the `bundler` API namespace.
+	Bundler BundlerConfig `mapstructure:"bundler"`
+	// Auth defines the authentication and the method access control of the JSON-RPC servers.
+	Auth AuthConfig `mapstructure:"auth"`
+	// RateLimit defines the per-client rate limits of the JSON-RPC servers.
+	RateLimit RateLimitConfig `mapstructure:"rate-limit"`
+}
+
+// BundlerConfig defines the configuration of the ERC-4337 bundler.
+type BundlerConfig struct {
+	// EntryPoints defines the addresses of the supported EntryPoint contracts.
+	EntryPoints []string `mapstructure:"entry-points"`
+	// Key defines the name of the node's keyring key signing the bundle transactions.
+	Key string `mapstructure:"key"`
+	// BundleInterval defines the interval between the bundle transactions.
+	BundleInterval time.Duration `mapstructure:"bundle-interval"`
+	// MaxBundleSize defines the maximum number of user operations in a bundle.
+	MaxBundleSize int `mapstructure:"max-bundle-size"`
+	// MaxPoolSize defines the maximum number of user operations kept by the bundler.
+	MaxPoolSize int `mapstructure:"max-pool-size"`
+}
+
+// AuthConfig defines the authentication and the method access control of the
+// HTTP and WebSocket JSON-RPC servers.
+type AuthConfig struct {
+	// Enable defines if the requests are authenticated and the methods access controlled.
+	Enable bool `mapstructure:"enable"`
+	// JWTSecretFile defines the file holding the hex-encoded 32 bytes secret of the HS256 JWT
+	// tokens authenticating the clients, as in the Engine API.
+	JWTSecretFile string `mapstructure:"jwt-secret-file"`
+	// APIKeys defines the static API keys authenticating the clients.
+	APIKeys []string `mapstructure:"api-keys"`
+	// PublicMethods defines the methods which can be called without authentication,
+	// as method names or namespace wildcards such as "eth_*".
+	PublicMethods []string `mapstructure:"public-methods"`
+	// DeniedMethods defines the methods which cannot be called, even with authentication.
+	DeniedMethods []string `mapstructure:"denied-methods"`
+}
+
+// RateLimitConfig defines the per-client rate limits of the HTTP and WebSocket
+// JSON-RPC servers. Every client, identified by its API key or its IP address,
+// has a token bucket refilled at the given rate, and every request consumes
+// the cost of its methods.
+type RateLimitConfig struct {
+	// Enable defines if the requests are rate limited.
+	Enable bool `mapstructure:"enable"`
+	// Rate defines the number of tokens added to the bucket of a client per second.
+	Rate float64 `mapstructure:"rate"`
+	// Burst defines the size of the bucket of a client.
+	Burst int `mapstructure:"burst"`
+	// DefaultCost defines the cost of the methods without a cost in MethodCosts.
+	DefaultCost int `mapstructure:"default-cost"`
+	// MethodCosts defines the costs of the methods, by method name or namespace wildcard
+	// such as "debug_*". The method names take precedence over the wildcards.
+	MethodCosts map[string]int `mapstructure:"method-costs"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -285,7 +405,7 @@ func DefaultEVMConfig() *EVMConfig {
 
 // Validate returns an error if the tracer type is invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
+	if c.Tracer != "" && !slices.Contains(evmTracers, c.Tracer) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
@@ -300,6 +420,145 @@ func (c EVMConfig) Validate() error {
 	return nil
 }
 
+// DefaultBundlerConfig returns the default ERC-4337 bundler configuration
+func DefaultBundlerConfig() BundlerConfig {
+	return BundlerConfig{
+		EntryPoints:    []string{},
+		BundleInterval: DefaultBundleInterval,
+		MaxBundleSize:  DefaultMaxBundleSize,
+		MaxPoolSize:    DefaultMaxBundlerPoolSize,
+	}
+}
+
+// Validate returns an error if the bundler configuration is invalid
+func (c BundlerConfig) Validate() error {
+	if len(c.EntryPoints) == 0 {
+		return errors.New("bundler entry points cannot be empty")
+	}
+	for _, entryPoint := range c.EntryPoints {
+		if !common.IsHexAddress(entryPoint) {
+			return fmt.Errorf("invalid bundler entry point address %s", entryPoint)
+		}
+	}
+	if c.Key == "" {
+		return errors.New("bundler key cannot be empty")
+	}
+	if c.BundleInterval <= 0 {
+		return fmt.Errorf("bundler bundle interval must be positive, got %s", c.BundleInterval)
+	}
+	if c.MaxBundleSize < 1 {
+		return fmt.Errorf("bundler max bundle size must be at least 1, got %d", c.MaxBundleSize)
+	}
+	if c.MaxPoolSize < 1 {
+		return fmt.Errorf("bundler max pool size must be at least 1, got %d", c.MaxPoolSize)
+	}
+	return nil
+}
+
+// DefaultAuthConfig returns the default JSON-RPC authentication configuration
+func DefaultAuthConfig() AuthConfig {
+	return AuthConfig{
+		Enable:        false,
+		APIKeys:       []string{},
+		PublicMethods: GetDefaultPublicMethods(),
+		DeniedMethods: []string{},
+	}
+}
+
+// Validate returns an error if the JSON-RPC authentication configuration is invalid
+func (c AuthConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.JWTSecretFile == "" && len(c.APIKeys) == 0 {
+		return errors.New("either a JWT secret file or API keys must be defined")
+	}
+	for _, key := range c.APIKeys {
+		if key == "" {
+			return errors.New("API keys cannot be empty")
+		}
+	}
+	for _, pattern := range append(slices.Clone(c.PublicMethods), c.DeniedMethods...) {
+		if !IsValidMethodPattern(pattern) {
+			return fmt.Errorf("invalid method pattern %q, expected a method name, a namespace wildcard such as \"eth_*\" or \"*\"", pattern)
+		}
+	}
+	return nil
+}
+
+// IsValidMethodPattern returns true if the pattern is a method name, a
+// namespace wildcard such as "eth_*" or the "*" wildcard.
+func IsValidMethodPattern(pattern string) bool {
+	if pattern == "*" {
+		return true
+	}
+	namespace, method, ok := strings.Cut(pattern, "_")
+	if !ok || namespace == "" || method == "" || strings.Contains(namespace, "*") {
+		return false
+	}
+	return method == "*" || !strings.Contains(method, "*")
+}
+
+// GetDefaultPublicMethods returns the default list of JSON-RPC methods which can be called
+// without authentication
+func GetDefaultPublicMethods() []string {
+	return []string{"eth_*", "net_*", "web3_*"}
+}
+
+// DefaultRateLimitConfig returns the default JSON-RPC rate limit configuration
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Enable:      false,
+		Rate:        DefaultRateLimitRate,
+		Burst:       DefaultRateLimitBurst,
+		DefaultCost: DefaultRateLimitCost,
+		MethodCosts: GetDefaultMethodCosts(),
+	}
+}
+
+// Validate returns an error if the JSON-RPC rate limit configuration is invalid
+func (c RateLimitConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.Rate <= 0 {
+		return fmt.Errorf("rate must be positive, got %f", c.Rate)
+	}
+	if c.Burst < 1 {
+		return fmt.Errorf("burst must be at least 1, got %d", c.Burst)
+	}
+	if c.DefaultCost < 1 || c.DefaultCost > c.Burst {
+		return fmt.Errorf("default cost must be between 1 and the burst %d, got %d", c.Burst, c.DefaultCost)
+	}
+	for pattern, cost := range c.MethodCosts {
+		if !IsValidMethodPattern(pattern) {
+			return fmt.Errorf("invalid method pattern %q, expected a method name, a namespace wildcard such as \"eth_*\" or \"*\"", pattern)
+		}
+		if cost < 0 || cost > c.Burst {
+			return fmt.Errorf("cost of %s must be between 0 and the burst %d, got %d", pattern, c.Burst, cost)
+		}
+	}
+	return nil
+}
+
+// GetDefaultMethodCosts returns the default JSON-RPC rate limit costs of the methods
+func GetDefaultMethodCosts() map[string]int {
+	return map[string]int{
+		"debug_*":                50,
+		"eth_getLogs":            20,
+		"eth_getFilterLogs":      20,
+		"eth_call":               5,
+		"eth_estimateGas":        5,
+		"eth_createAccessList":   5,
+		"eth_simulateV1":         10,
+		"eth_getBlockReceipts":   5,
+		"eth_sendRawTransaction": 2,
+		"eth_chainId":            0,
+		"net_version":            0,
+		"web3_clientVersion":     0,
+	}
+}
+
 // GetDefaultAPINamespaces returns the default list of JSON-RPC namespaces that should be enabled
 func GetDefaultAPINamespaces() []string {
 	return []string{"eth", "net", "web3"}
@@ -307,7 +566,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "bundler"}
 }
 
 // GetDefaultWSOrigins returns the default WebSocket origins.
@@ -337,9 +596,14 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		BatchResponseMaxSize: DefaultBatchResponseMaxSize,
 		MaxOpenConnections:   DefaultMaxOpenConnections,
 		EnableIndexer:        false,
+		IndexerBackend:       DefaultIndexerBackend,
+		IndexerLogRetention:  DefaultIndexerLogRetention,
 		MetricsAddress:       DefaultJSONRPCMetricsAddress,
 		WSOrigins:            GetDefaultWSOrigins(),
 		EnableProfiling:      DefaultEnableProfiling,
+		Bundler:              DefaultBundlerConfig(),
+		Auth:                 DefaultAuthConfig(),
+		RateLimit:            DefaultRateLimitConfig(),
 	}
 }
 
@@ -389,6 +653,16 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC batch response max size cannot be negative")
 	}
 
+	switch c.IndexerBackend {
+	case IndexerBackendKV:
+	case IndexerBackendPSQL:
+		if c.IndexerPSQLConn == "" {
+			return errors.New("JSON-RPC indexer-psql-conn cannot be empty with the psql indexer backend")
+		}
+	default:
+		return fmt.Errorf("invalid JSON-RPC indexer backend %q, expected %q or %q", c.IndexerBackend, IndexerBackendKV, IndexerBackendPSQL)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -399,6 +673,20 @@ func (c JSONRPCConfig) Validate() error {
 		seenAPIs[api] = true
 	}
 
+	if seenAPIs["bundler"] {
+		if err := c.Bundler.Validate(); err != nil {
+			return fmt.Errorf("invalid bundler config: %w", err)
+		}
+	}
+
+	if err := c.Auth.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC auth config: %w", err)
+	}
+
+	if err := c.RateLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid JSON-RPC rate limit config: %w", err)
+	}
+
 	return nil
 }
 
@@ -446,6 +734,11 @@ func DefaultConfig() *Config {
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	conf := DefaultConfig()
+	if v.IsSet(methodCostsKey) {
+		// the configured method costs replace the default ones instead of
+		// being merged with them
+		conf.JSONRPC.RateLimit.MethodCosts = nil
+	}
 	if err := v.Unmarshal(conf); err != nil {
 		return Config{}, fmt.Errorf("error extracting app config: %w", err)
 	}
@@ -3,6 +3,7 @@ package config_test
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -69,3 +70,135 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONRPCConfigValidateIndexerBackend(t *testing.T) {
+	tests := []struct {
+		name    string
+		backend string
+		conn    string
+		wantErr bool
+	}{
+		{"kv backend", serverconfig.IndexerBackendKV, "", false},
+		{"psql backend", serverconfig.IndexerBackendPSQL, "postgresql://localhost:5432/evmindexer", false},
+		{"psql backend without connection string", serverconfig.IndexerBackendPSQL, "", true},
+		{"unknown backend", "sqlite", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultJSONRPCConfig()
+			cfg.IndexerBackend = tt.backend
+			cfg.IndexerPSQLConn = tt.conn
+			if tt.wantErr {
+				require.Error(t, cfg.Validate())
+			} else {
+				require.NoError(t, cfg.Validate())
+			}
+		})
+	}
+}
+
+func TestAuthConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*serverconfig.AuthConfig)
+		wantErr bool
+	}{
+		{"disabled", func(*serverconfig.AuthConfig) {}, false},
+		{"api keys", func(c *serverconfig.AuthConfig) {
+			c.Enable = true
+			c.APIKeys = []string{"key"}
+		}, false},
+		{"jwt secret", func(c *serverconfig.AuthConfig) {
+			c.Enable = true
+			c.JWTSecretFile = "jwt.hex"
+		}, false},
+		{"no credentials", func(c *serverconfig.AuthConfig) {
+			c.Enable = true
+		}, true},
+		{"empty api key", func(c *serverconfig.AuthConfig) {
+			c.Enable = true
+			c.APIKeys = []string{""}
+		}, true},
+		{"denied methods", func(c *serverconfig.AuthConfig) {
+			c.Enable = true
+			c.APIKeys = []string{"key"}
+			c.DeniedMethods = []string{"debug_*", "eth_sign"}
+		}, false},
+		{"invalid method pattern", func(c *serverconfig.AuthConfig) {
+			c.Enable = true
+			c.APIKeys = []string{"key"}
+			c.PublicMethods = []string{"eth_get*"}
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultAuthConfig()
+			tt.modify(&cfg)
+			if tt.wantErr {
+				require.Error(t, cfg.Validate())
+			} else {
+				require.NoError(t, cfg.Validate())
+			}
+		})
+	}
+}
+
+func TestRateLimitConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*serverconfig.RateLimitConfig)
+		wantErr bool
+	}{
+		{"disabled", func(*serverconfig.RateLimitConfig) {}, false},
+		{"enabled", func(c *serverconfig.RateLimitConfig) { c.Enable = true }, false},
+		{"zero rate", func(c *serverconfig.RateLimitConfig) {
+			c.Enable = true
+			c.Rate = 0
+		}, true},
+		{"zero burst", func(c *serverconfig.RateLimitConfig) {
+			c.Enable = true
+			c.Burst = 0
+		}, true},
+		{"cost above the burst", func(c *serverconfig.RateLimitConfig) {
+			c.Enable = true
+			c.MethodCosts = map[string]int{"debug_*": c.Burst + 1}
+		}, true},
+		{"invalid method pattern", func(c *serverconfig.RateLimitConfig) {
+			c.Enable = true
+			c.MethodCosts = map[string]int{"debug_trace*": 10}
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := serverconfig.DefaultRateLimitConfig()
+			tt.modify(&cfg)
+			if tt.wantErr {
+				require.Error(t, cfg.Validate())
+			} else {
+				require.NoError(t, cfg.Validate())
+			}
+		})
+	}
+}
+
+func TestGetConfigRateLimit(t *testing.T) {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(`
+[json-rpc.rate-limit]
+enable = true
+rate = 10
+burst = 20
+
+[json-rpc.rate-limit.method-costs]
+"eth_getLogs" = 10
+`)))
+
+	cfg, err := serverconfig.GetConfig(v)
+	require.NoError(t, err)
+	require.True(t, cfg.JSONRPC.RateLimit.Enable)
+	require.Equal(t, float64(10), cfg.JSONRPC.RateLimit.Rate)
+	require.Equal(t, 20, cfg.JSONRPC.RateLimit.Burst)
+	// the method names are lower-cased by viper
+	require.Equal(t, map[string]int{"eth_getlogs": 10}, cfg.JSONRPC.RateLimit.MethodCosts)
+}
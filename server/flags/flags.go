@@ -41,6 +41,7 @@ const (
 	JSONRPCAPI                  = "json-rpc.api"
 	JSONRPCAddress              = "json-rpc.address"
 	JSONWsAddress               = "json-rpc.ws-address"
+	JSONRPCIPCPath              = "json-rpc.ipc-path"
 	JSONRPCWSOrigins            = "json-rpc.ws-origins"
 	JSONRPCGasCap               = "json-rpc.gas-cap"
 	JSONRPCAllowInsecureUnlock  = "json-rpc.allow-insecure-unlock"
@@ -54,9 +55,30 @@ const (
 	JSONRPCAllowUnprotectedTxs  = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections   = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer        = "json-rpc.enable-indexer"
+	JSONRPCIndexerBackend       = "json-rpc.indexer-backend"
+	JSONRPCIndexerPSQLConn      = "json-rpc.indexer-psql-conn"
+	JSONRPCIndexerLogRetention  = "json-rpc.indexer-log-retention"
 	JSONRPCBatchRequestLimit    = "json-rpc.batch-request-limit"
 	JSONRPCBatchResponseMaxSize = "json-rpc.batch-response-max-size"
 	JSONRPCEnableProfiling      = "json-rpc.enable-profiling"
+
+	JSONRPCBundlerEntryPoints    = "json-rpc.bundler.entry-points"
+	JSONRPCBundlerKey            = "json-rpc.bundler.key"
+	JSONRPCBundlerBundleInterval = "json-rpc.bundler.bundle-interval"
+	JSONRPCBundlerMaxBundleSize  = "json-rpc.bundler.max-bundle-size"
+	JSONRPCBundlerMaxPoolSize    = "json-rpc.bundler.max-pool-size"
+
+	JSONRPCAuthEnable        = "json-rpc.auth.enable"
+	JSONRPCAuthJWTSecretFile = "json-rpc.auth.jwt-secret-file"
+	JSONRPCAuthAPIKeys       = "json-rpc.auth.api-keys"
+	JSONRPCAuthPublicMethods = "json-rpc.auth.public-methods"
+	JSONRPCAuthDeniedMethods = "json-rpc.auth.denied-methods"
+
+	JSONRPCRateLimitEnable      = "json-rpc.rate-limit.enable"
+	JSONRPCRateLimitRate        = "json-rpc.rate-limit.rate"
+	JSONRPCRateLimitBurst       = "json-rpc.rate-limit.burst"
+	JSONRPCRateLimitDefaultCost = "json-rpc.rate-limit.default-cost"
+
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -79,6 +101,10 @@ const (
 	EVMMempoolAccountQueue = "evm.mempool.account-queue"
 	EVMMempoolGlobalQueue  = "evm.mempool.global-queue"
 	EVMMempoolLifetime     = "evm.mempool.lifetime"
+
+	EVMMempoolCosmosAccountQueue = "evm.mempool.cosmos-account-queue"
+	EVMMempoolCosmosGlobalQueue  = "evm.mempool.cosmos-global-queue"
+	EVMMempoolCosmosLifetime     = "evm.mempool.cosmos-lifetime"
 )
 
 // TLS flags
@@ -1,103 +1,95 @@
 package server
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"sync"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtconfig "github.com/cometbft/cometbft/config"
 	sm "github.com/cometbft/cometbft/state"
 	cmtstore "github.com/cometbft/cometbft/store"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/evm/indexer"
+	cosmosevmserverconfig "github.com/cosmos/evm/server/config"
+	servertypes "github.com/cosmos/evm/server/types"
+
+	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagIndexWorkers    = "workers"
+	flagIndexFrom       = "from"
+	flagIndexTo         = "to"
+	flagIndexKeepRecent = "keep-recent"
 )
 
 // NewIndexTxCmd creates a new Cobra command to index historical Ethereum transactions.
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
 		- forward: index the blocks from the latest indexed block to latest block in the chain.
 
-		When start the node, the indexer start from the latest indexed block to avoid creating gap.
+		When start the node, the indexer start from the latest indexed block to avoid creating gap, and indexes the blocks missing between the first and the latest indexed blocks.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
-		`,
-		Args: cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			serverCtx := server.GetServerContextFromCmd(cmd)
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-
-			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
-			}
 
-			cfg := serverCtx.Config
-			home := cfg.RootDir
-			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
-			if err != nil {
-				logger.Error("failed to open evm indexer DB", "error", err.Error())
-				return err
-			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+		The indexed blocks can be checked against the local blocks with verify, the missing and mismatching ones indexed again with repair, and the old ones removed with prune.
+		`,
+	}
+	cmd.AddCommand(
+		newIndexDirectionCmd("backward"),
+		newIndexDirectionCmd("forward"),
+		newIndexVerifyCmd(),
+		newIndexRepairCmd(),
+		newIndexPruneCmd(),
+	)
+	return cmd
+}
 
-			// open local CometBFT db, because the local rpc won't be available.
-			tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+// newIndexDirectionCmd creates the command indexing the blocks in the given
+// direction, see NewIndexTxCmd.
+func newIndexDirectionCmd(direction string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   direction,
+		Short: fmt.Sprintf("Index historical eth txs %s", direction),
+		Long: fmt.Sprintf(`Index historical eth txs %s.
+With more than one worker, the blocks are indexed in parallel and an interrupted run may leave gaps, which are indexed by repair or at node start.`, direction),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ic, err := newIndexTxContext(cmd)
 			if err != nil {
 				return err
 			}
-			blockStore := cmtstore.NewBlockStore(tmdb)
-
-			stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+			workers, err := cmd.Flags().GetInt(flagIndexWorkers)
 			if err != nil {
 				return err
 			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
 
-			indexBlock := func(height int64) error {
-				blk := blockStore.LoadBlock(height)
-				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
-				}
-				resBlk, err := stateStore.LoadFinalizeBlockResponse(height)
-				if err != nil {
-					return err
-				}
-				if err := idxer.IndexBlock(blk, resBlk.TxResults); err != nil {
-					return err
-				}
-				fmt.Println(height)
-				return nil
-			}
-
-			switch args[0] {
+			switch direction {
 			case "backward":
-				first, err := idxer.FirstIndexedBlock()
+				first, err := ic.idxer.FirstIndexedBlock()
 				if err != nil {
 					return err
 				}
 				if first == -1 {
 					// start from the latest block if indexer db is empty
-					first = blockStore.Height()
-				}
-				for i := first - 1; i > 0; i-- {
-					if err := indexBlock(i); err != nil {
-						return err
-					}
+					first = ic.blockStore.Height()
 				}
+				return forEachBlock(cmd.Context(), workers, first-1, func(i int64) int64 { return first - 1 - i }, ic.indexBlock)
 			case "forward":
-				latest, err := idxer.LastIndexedBlock()
+				latest, err := ic.idxer.LastIndexedBlock()
 				if err != nil {
 					return err
 				}
@@ -105,17 +97,303 @@ func NewIndexTxCmd() *cobra.Command {
 					// start from genesis if empty
 					latest = 0
 				}
-				for i := latest + 1; i <= blockStore.Height(); i++ {
-					if err := indexBlock(i); err != nil {
-						return err
-					}
-				}
+				return forEachBlock(cmd.Context(), workers, ic.blockStore.Height()-latest, func(i int64) int64 { return latest + 1 + i }, ic.indexBlock)
 			default:
-				return fmt.Errorf("unknown direction %s", args[0])
+				return fmt.Errorf("unknown direction %s", direction)
+			}
+		},
+	}
+	cmd.Flags().Int(flagIndexWorkers, 1, "Number of blocks indexed in parallel")
+	return cmd
+}
+
+// newIndexVerifyCmd creates the command checking the indexed blocks.
+func newIndexVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify the indexed eth txs against the local blocks",
+		Long: `Verify the indexed eth txs against the local blocks, reporting the blocks which are missing from the indexer db and the ones whose indexed eth txs don't match the block results.
+The range defaults to the first and the latest indexed blocks.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ic, err := newIndexTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from, to, err := ic.blockRange(cmd)
+			if err != nil {
+				return err
+			}
+			workers, err := cmd.Flags().GetInt(flagIndexWorkers)
+			if err != nil {
+				return err
+			}
+			if from > to {
+				fmt.Println("no indexed block to verify")
+				return nil
 			}
 
+			invalid, err := ic.invalidBlocks(cmd.Context(), workers, from, to, true)
+			if err != nil {
+				return err
+			}
+			if len(invalid) > 0 {
+				return fmt.Errorf("%d invalid blocks in range %d-%d", len(invalid), from, to)
+			}
+			fmt.Printf("verified blocks %d-%d\n", from, to)
 			return nil
 		},
 	}
+	cmd.Flags().Int64(flagIndexFrom, 0, "First block to verify, defaults to the first indexed block")
+	cmd.Flags().Int64(flagIndexTo, 0, "Last block to verify, defaults to the latest indexed block")
+	cmd.Flags().Int(flagIndexWorkers, 1, "Number of blocks verified in parallel")
 	return cmd
 }
+
+// newIndexRepairCmd creates the command indexing again the missing and
+// mismatching blocks.
+func newIndexRepairCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repair",
+		Short: "Index the missing and mismatching blocks again",
+		Long: `Verify the indexed eth txs against the local blocks, and index again the blocks which are missing from the indexer db and the ones whose indexed eth txs don't match the block results.
+The range defaults to the first and the latest indexed blocks.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ic, err := newIndexTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from, to, err := ic.blockRange(cmd)
+			if err != nil {
+				return err
+			}
+			workers, err := cmd.Flags().GetInt(flagIndexWorkers)
+			if err != nil {
+				return err
+			}
+			if from > to {
+				fmt.Println("no indexed block to repair")
+				return nil
+			}
+
+			invalid, err := ic.invalidBlocks(cmd.Context(), workers, from, to, false)
+			if err != nil {
+				return err
+			}
+			if err := forEachBlock(cmd.Context(), workers, int64(len(invalid)), func(i int64) int64 { return invalid[i] }, ic.indexBlock); err != nil {
+				return err
+			}
+			fmt.Printf("repaired %d blocks in range %d-%d\n", len(invalid), from, to)
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagIndexFrom, 0, "First block to repair, defaults to the first indexed block")
+	cmd.Flags().Int64(flagIndexTo, 0, "Last block to repair, defaults to the latest indexed block")
+	cmd.Flags().Int(flagIndexWorkers, 1, "Number of blocks verified and indexed in parallel")
+	return cmd
+}
+
+// newIndexPruneCmd creates the command removing the old indexed blocks.
+func newIndexPruneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Remove the indexed eth txs of the old blocks",
+		Long:  `Remove the indexed eth txs and logs of the blocks older than the given number of recent blocks, counting from the latest indexed block.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keepRecent, err := cmd.Flags().GetInt64(flagIndexKeepRecent)
+			if err != nil {
+				return err
+			}
+			if keepRecent <= 0 {
+				return fmt.Errorf("--%s must be positive, got: %d", flagIndexKeepRecent, keepRecent)
+			}
+			ic, err := newIndexTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			latest, err := ic.idxer.LastIndexedBlock()
+			if err != nil {
+				return err
+			}
+			height := latest - keepRecent
+			if height <= 0 {
+				fmt.Println("no indexed block to prune")
+				return nil
+			}
+			if err := ic.idxer.PruneBlocks(height); err != nil {
+				return err
+			}
+			fmt.Printf("pruned blocks up to %d\n", height)
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagIndexKeepRecent, 0, "Number of recent indexed blocks to keep")
+	return cmd
+}
+
+// indexTxContext holds the indexer and the local CometBFT stores used by the
+// index-eth-tx commands.
+type indexTxContext struct {
+	idxer      servertypes.EVMTxIndexer
+	logger     log.Logger
+	txDecoder  sdk.TxDecoder
+	blockStore *cmtstore.BlockStore
+	stateStore sm.Store
+}
+
+func newIndexTxContext(cmd *cobra.Command) (*indexTxContext, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := serverCtx.Config
+	home := cfg.RootDir
+	logger := serverCtx.Logger
+	evmCfg, err := cosmosevmserverconfig.GetConfig(serverCtx.Viper)
+	if err != nil {
+		return nil, err
+	}
+	idxLogger := logger.With("module", "evmindex")
+	idxer, err := NewEVMTxIndexer(evmCfg.JSONRPC, home, server.GetAppDBBackend(serverCtx.Viper), idxLogger, clientCtx)
+	if err != nil {
+		logger.Error("failed to open evm indexer", "error", err.Error())
+		return nil, err
+	}
+
+	// open local CometBFT db, because the local rpc won't be available.
+	tmdb, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+	stateDB, err := cmtconfig.DefaultDBProvider(&cmtconfig.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return nil, err
+	}
+
+	return &indexTxContext{
+		idxer:      idxer,
+		logger:     idxLogger,
+		txDecoder:  clientCtx.TxConfig.TxDecoder(),
+		blockStore: cmtstore.NewBlockStore(tmdb),
+		stateStore: sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+		}),
+	}, nil
+}
+
+// blockRange returns the range given by the from and to flags, which default
+// to the first and the latest indexed blocks.
+func (ic *indexTxContext) blockRange(cmd *cobra.Command) (int64, int64, error) {
+	from, err := cmd.Flags().GetInt64(flagIndexFrom)
+	if err != nil {
+		return 0, 0, err
+	}
+	to, err := cmd.Flags().GetInt64(flagIndexTo)
+	if err != nil {
+		return 0, 0, err
+	}
+	if from == 0 {
+		if from, err = ic.idxer.FirstIndexedBlock(); err != nil {
+			return 0, 0, err
+		}
+	}
+	if to == 0 {
+		if to, err = ic.idxer.LastIndexedBlock(); err != nil {
+			return 0, 0, err
+		}
+	}
+	if from <= 0 || to <= 0 {
+		// the indexer db is empty
+		return 1, 0, nil
+	}
+	return from, min(to, ic.blockStore.Height()), nil
+}
+
+// loadBlock loads the block and its results from the local stores.
+func (ic *indexTxContext) loadBlock(height int64) (*cmttypes.Block, []*abci.ExecTxResult, error) {
+	blk := ic.blockStore.LoadBlock(height)
+	if blk == nil {
+		return nil, nil, fmt.Errorf("block not found %d", height)
+	}
+	resBlk, err := ic.stateStore.LoadFinalizeBlockResponse(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return blk, resBlk.TxResults, nil
+}
+
+// indexBlock indexes the block and prints its height.
+func (ic *indexTxContext) indexBlock(height int64) error {
+	blk, txResults, err := ic.loadBlock(height)
+	if err != nil {
+		return err
+	}
+	if err := ic.idxer.IndexBlock(blk, txResults); err != nil {
+		return err
+	}
+	fmt.Println(height)
+	return nil
+}
+
+// invalidBlocks returns the sorted blocks of the range which are missing from
+// the indexer db or whose indexed eth txs don't match the block results. The
+// invalid blocks are printed if verbose is set.
+func (ic *indexTxContext) invalidBlocks(ctx context.Context, workers int, from, to int64, verbose bool) ([]int64, error) {
+	missing, err := ic.idxer.MissingBlocks(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if verbose {
+		for _, height := range missing {
+			fmt.Printf("block %d: missing\n", height)
+		}
+	}
+
+	var mtx sync.Mutex
+	invalid := slices.Clone(missing)
+	err = forEachBlock(ctx, workers, to-from+1, func(i int64) int64 { return from + i }, func(height int64) error {
+		if _, found := slices.BinarySearch(missing, height); found {
+			return nil
+		}
+		blk, txResults, err := ic.loadBlock(height)
+		if err != nil {
+			return err
+		}
+		if err := indexer.VerifyBlock(ic.idxer, ic.logger, ic.txDecoder, blk, txResults); err != nil {
+			mtx.Lock()
+			defer mtx.Unlock()
+			if verbose {
+				fmt.Println(err)
+			}
+			invalid = append(invalid, height)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(invalid)
+	return invalid, nil
+}
+
+// forEachBlock calls fn for the n blocks whose heights are returned by height,
+// running up to workers calls in parallel. It stops at the first error.
+func forEachBlock(ctx context.Context, workers int, n int64, height func(i int64) int64, fn func(int64) error) error {
+	if workers < 1 {
+		return fmt.Errorf("--%s must be positive, got: %d", flagIndexWorkers, workers)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(workers)
+	for i := int64(0); i < n && ctx.Err() == nil; i++ {
+		h := height(i)
+		g.Go(func() error { return fn(h) })
+	}
+	return g.Wait()
+}
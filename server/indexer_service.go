@@ -80,6 +80,8 @@ func (eis *EVMIndexerService) OnStart() error {
 	}
 	if lastBlock == -1 {
 		lastBlock = latestBlock
+	} else {
+		eis.repairGaps(ctx, status.SyncInfo.EarliestBlockHeight, lastBlock)
 	}
 
 	// blockErr indicates an error fetching an expected block or its results
@@ -100,25 +102,67 @@ func (eis *EVMIndexerService) OnStart() error {
 			continue
 		}
 		for i := lastBlock + 1; i <= latestBlock; i++ {
-			var (
-				block       *coretypes.ResultBlock
-				blockResult *coretypes.ResultBlockResults
-			)
-
-			block, blockErr = eis.client.Block(ctx, &i)
-			if blockErr != nil {
-				eis.Logger.Error("failed to fetch block", "height", i, "err", blockErr)
+			if blockErr = eis.indexBlock(ctx, i); blockErr != nil {
 				break
 			}
-			blockResult, blockErr = eis.client.BlockResults(ctx, &i)
-			if blockErr != nil {
-				eis.Logger.Error("failed to fetch block result", "height", i, "err", blockErr)
-				break
-			}
-			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
-				eis.Logger.Error("failed to index block", "height", i, "err", err)
-			}
-			lastBlock = blockResult.Height
+			lastBlock = i
 		}
 	}
 }
+
+// repairGaps indexes the blocks missing from the indexed range, which may be
+// left by a crash or an interrupted backfill. The range starts at the first
+// indexed block, or at the earliest block available on the node if it was
+// pruned.
+// The blocks without eth txs of a db indexed before the blocks were recorded
+// are indexed again once.
+func (eis *EVMIndexerService) repairGaps(ctx context.Context, earliestBlock, lastBlock int64) {
+	firstBlock, err := eis.txIdxr.FirstIndexedBlock()
+	if err != nil {
+		eis.Logger.Error("failed to load first indexed block", "err", err)
+		return
+	}
+	firstBlock = max(firstBlock, earliestBlock)
+	if firstBlock > lastBlock {
+		return
+	}
+	missing, err := eis.txIdxr.MissingBlocks(firstBlock, lastBlock)
+	if err != nil {
+		eis.Logger.Error("failed to check indexed blocks", "from", firstBlock, "to", lastBlock, "err", err)
+		return
+	}
+	if len(missing) == 0 {
+		return
+	}
+	eis.Logger.Info("indexing missing blocks", "from", firstBlock, "to", lastBlock, "count", len(missing))
+	for _, height := range missing {
+		if err := eis.indexBlock(ctx, height); err != nil {
+			return
+		}
+	}
+}
+
+// indexBlock fetches the block and its results and indexes them, it only
+// returns the errors fetching the data.
+func (eis *EVMIndexerService) indexBlock(ctx context.Context, height int64) error {
+	var (
+		block       *coretypes.ResultBlock
+		blockResult *coretypes.ResultBlockResults
+		err         error
+	)
+
+	block, err = eis.client.Block(ctx, &height)
+	if err != nil {
+		eis.Logger.Error("failed to fetch block", "height", height, "err", err)
+		return err
+	}
+	blockResult, err = eis.client.BlockResults(ctx, &height)
+	if err != nil {
+		eis.Logger.Error("failed to fetch block result", "height", height, "err", err)
+		return err
+	}
+	if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+		eis.Logger.Error("failed to index block", "height", height, "err", err)
+	}
+	return nil
+}
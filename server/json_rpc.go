@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -74,9 +76,46 @@ func StartJSONRPC(
 			return nil, err
 		}
 	}
+	// the subscriptions of the eth namespace are served on the connections
+	// supporting notifications, the websocket server handles its own
+	if slices.Contains(rpcAPIArr, rpc.EthNamespace) {
+		if err := rpcServer.RegisterName(rpc.EthNamespace, rpc.NewSubscriptionAPI(logger, stream)); err != nil {
+			return nil, err
+		}
+	}
+
+	if ipcPath := config.JSONRPC.IPCPath; ipcPath != "" {
+		if !filepath.IsAbs(ipcPath) {
+			ipcPath = filepath.Join(clientCtx.HomeDir, ipcPath)
+		}
+		// the IPC clients run on the node's host, they are neither authenticated
+		// nor rate limited
+		if err := startIPCServer(ctx, srvCtx, g, ipcPath, rpcServer); err != nil {
+			return nil, err
+		}
+	}
+
+	auth, err := rpc.NewAuthenticator(config.JSONRPC.Auth)
+	if err != nil {
+		return nil, err
+	}
+	limiter, err := rpc.NewRateLimiter(config.JSONRPC.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// the requests are authenticated before being rate limited, so the
+	// clients are identified by verified API keys
+	var rpcHandler http.Handler = rpcServer
+	if limiter != nil {
+		rpcHandler = limiter.Handler(rpcHandler)
+	}
+	if auth != nil {
+		rpcHandler = auth.Handler(rpcHandler)
+	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcHandler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -131,7 +170,7 @@ func StartJSONRPC(
 
 	srvCtx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
 
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, logger, stream, config, auth, limiter)
 	wsSrv.Start()
 	return httpSrv, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/holiman/uint256"
 	"github.com/spf13/cast"
 
+	"github.com/cosmos/evm/mempool"
 	"github.com/cosmos/evm/mempool/txpool/legacypool"
 	srvflags "github.com/cosmos/evm/server/flags"
 
@@ -144,6 +145,29 @@ func GetLegacyPoolConfig(appOpts servertypes.AppOptions, logger log.Logger) *leg
 	return &legacyConfig
 }
 
+// GetCosmosQueueConfig reads the configuration of the queue of the Cosmos
+// transactions with a future sequence from appOpts and overrides default values
+// with values from app.toml if they exist and are non-zero.
+func GetCosmosQueueConfig(appOpts servertypes.AppOptions, logger log.Logger) *mempool.CosmosQueueConfig {
+	if appOpts == nil {
+		logger.Error("app options is nil, using default cosmos queue config")
+		return &mempool.DefaultCosmosQueueConfig
+	}
+
+	queueConfig := mempool.DefaultCosmosQueueConfig
+	if accountQueue := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolCosmosAccountQueue)); accountQueue != 0 {
+		queueConfig.AccountQueue = accountQueue
+	}
+	if globalQueue := cast.ToUint64(appOpts.Get(srvflags.EVMMempoolCosmosGlobalQueue)); globalQueue != 0 {
+		queueConfig.GlobalQueue = globalQueue
+	}
+	if lifetime := cast.ToDuration(appOpts.Get(srvflags.EVMMempoolCosmosLifetime)); lifetime != 0 {
+		queueConfig.Lifetime = lifetime
+	}
+
+	return &queueConfig
+}
+
 func GetCosmosPoolMaxTx(appOpts servertypes.AppOptions, logger log.Logger) int {
 	if appOpts == nil {
 		// we don't want to return 0 here, as then appOpts.Get() will return nil and that will be
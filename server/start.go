@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"path/filepath"
 	"runtime/pprof"
 
 	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/spf13/cobra"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/sync/errgroup"
@@ -199,6 +201,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, cosmosevmserverconfig.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, cosmosevmserverconfig.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, cosmosevmserverconfig.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "the path of the unix socket of the JSON-RPC IPC endpoint, relative to the node home directory if not absolute (empty=disabled)")
 	cmd.Flags().StringSlice(srvflags.JSONRPCWSOrigins, cosmosevmserverconfig.GetDefaultWSOrigins(), "Defines a list of WebSocket origins that should be allowed to connect")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, cosmosevmserverconfig.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aatom (0=infinite)")                         //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCAllowInsecureUnlock, cosmosevmserverconfig.DefaultJSONRPCAllowInsecureUnlock, "Allow insecure account unlocking when account-related RPCs are exposed by http") //nolint:lll
@@ -214,8 +217,25 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, cosmosevmserverconfig.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, cosmosevmserverconfig.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, cosmosevmserverconfig.DefaultIndexerBackend, "Sets the backend of the custom tx indexer (kv|psql)")
+	cmd.Flags().String(srvflags.JSONRPCIndexerPSQLConn, "", "Sets the connection string of the PostgreSQL database of the psql indexer backend")
+	cmd.Flags().Uint64(srvflags.JSONRPCIndexerLogRetention, cosmosevmserverconfig.DefaultIndexerLogRetention, "Sets the number of recent blocks whose logs are kept in the log indexes of the custom tx indexer (0=all)") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableProfiling, false, "Enables the profiling in the debug namespace")
+	cmd.Flags().StringSlice(srvflags.JSONRPCBundlerEntryPoints, nil, "Defines the addresses of the EntryPoint contracts supported by the ERC-4337 bundler")
+	cmd.Flags().String(srvflags.JSONRPCBundlerKey, "", "Defines the name of the keyring key signing the ERC-4337 bundle transactions")
+	cmd.Flags().Duration(srvflags.JSONRPCBundlerBundleInterval, cosmosevmserverconfig.DefaultBundleInterval, "Sets the interval between the ERC-4337 bundle transactions")
+	cmd.Flags().Int(srvflags.JSONRPCBundlerMaxBundleSize, cosmosevmserverconfig.DefaultMaxBundleSize, "Sets the maximum number of user operations in an ERC-4337 bundle")
+	cmd.Flags().Int(srvflags.JSONRPCBundlerMaxPoolSize, cosmosevmserverconfig.DefaultMaxBundlerPoolSize, "Sets the maximum number of user operations kept by the ERC-4337 bundler")
+	cmd.Flags().Bool(srvflags.JSONRPCAuthEnable, false, "Enables the authentication and the method access control of the JSON-RPC servers")
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecretFile, "", "Defines the file holding the hex-encoded secret of the JWT tokens authenticating the JSON-RPC clients")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthAPIKeys, nil, "Defines the API keys authenticating the JSON-RPC clients")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthPublicMethods, cosmosevmserverconfig.GetDefaultPublicMethods(), "Defines the JSON-RPC methods which can be called without authentication")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAuthDeniedMethods, nil, "Defines the JSON-RPC methods which cannot be called, even with authentication")
+	cmd.Flags().Bool(srvflags.JSONRPCRateLimitEnable, false, "Enables the per-client rate limits of the JSON-RPC servers")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitRate, cosmosevmserverconfig.DefaultRateLimitRate, "Sets the number of tokens added to the JSON-RPC rate limit bucket of a client per second")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, cosmosevmserverconfig.DefaultRateLimitBurst, "Sets the size of the JSON-RPC rate limit bucket of a client")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitDefaultCost, cosmosevmserverconfig.DefaultRateLimitCost, "Sets the JSON-RPC rate limit cost of the methods without a configured cost")
 
 	cmd.Flags().String(srvflags.EVMTracer, cosmosevmserverconfig.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, cosmosevmserverconfig.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -231,6 +251,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(srvflags.EVMMempoolAccountQueue, cosmosevmserverconfig.DefaultMempoolConfig().AccountQueue, "the maximum number of non-executable transaction slots permitted per account")
 	cmd.Flags().Uint64(srvflags.EVMMempoolGlobalQueue, cosmosevmserverconfig.DefaultMempoolConfig().GlobalQueue, "the maximum number of non-executable transaction slots for all accounts")
 	cmd.Flags().Duration(srvflags.EVMMempoolLifetime, cosmosevmserverconfig.DefaultMempoolConfig().Lifetime, "the maximum amount of time non-executable transaction are queued")
+	cmd.Flags().Uint64(srvflags.EVMMempoolCosmosAccountQueue, cosmosevmserverconfig.DefaultMempoolConfig().CosmosAccountQueue, "the maximum number of Cosmos transactions with a future sequence queued per account")
+	cmd.Flags().Uint64(srvflags.EVMMempoolCosmosGlobalQueue, cosmosevmserverconfig.DefaultMempoolConfig().CosmosGlobalQueue, "the maximum number of Cosmos transactions with a future sequence queued for all accounts")
+	cmd.Flags().Duration(srvflags.EVMMempoolCosmosLifetime, cosmosevmserverconfig.DefaultMempoolConfig().CosmosLifetime, "the maximum amount of time Cosmos transactions with a future sequence are queued")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -477,14 +500,12 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 
 	var idxer servertypes.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(svrCtx.Viper))
+		idxLogger := svrCtx.Logger.With("indexer", "evm")
+		idxer, err = NewEVMTxIndexer(config.JSONRPC, home, server.GetAppDBBackend(svrCtx.Viper), idxLogger, clientCtx)
 		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			logger.Error("failed to open evm indexer", "error", err.Error())
 			return err
 		}
-
-		idxLogger := svrCtx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
 
@@ -502,6 +523,11 @@ func startInProcess(svrCtx *server.Context, clientCtx client.Context, opts Start
 				if err := indexerService.Stop(); err != nil {
 					logger.Error("failed to stop evm indexer service", "error", err.Error())
 				}
+				if closer, ok := idxer.(io.Closer); ok {
+					if err := closer.Close(); err != nil {
+						logger.Error("failed to close evm indexer", "error", err.Error())
+					}
+				}
 				return ctx.Err()
 			case err := <-errCh:
 				if err != nil {
@@ -562,6 +588,31 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// NewEVMTxIndexer creates the custom eth tx indexer of the backend selected in
+// the JSON-RPC configuration.
+func NewEVMTxIndexer(
+	cfg cosmosevmserverconfig.JSONRPCConfig,
+	rootDir string,
+	backendType dbm.BackendType,
+	logger log.Logger,
+	clientCtx client.Context,
+) (servertypes.EVMTxIndexer, error) {
+	switch cfg.IndexerBackend {
+	case cosmosevmserverconfig.IndexerBackendPSQL:
+		return indexer.NewPSQLIndexer(cfg.IndexerPSQLConn, logger, clientCtx)
+	case cosmosevmserverconfig.IndexerBackendKV, "":
+		idxDB, err := OpenIndexerDB(rootDir, backendType)
+		if err != nil {
+			return nil, err
+		}
+		kvIdxer := indexer.NewKVIndexer(idxDB, logger, clientCtx)
+		kvIdxer.SetLogRetention(cfg.IndexerLogRetention)
+		return kvIdxer, nil
+	default:
+		return nil, fmt.Errorf("unknown evm indexer backend %s", cfg.IndexerBackend)
+	}
+}
+
 // openTraceWriter opens a trace writer if a trace store file is specified.
 // Parameters:
 // - traceWriterFile: The path to the trace store file. If this is an empty string, no file will be opened.
@@ -663,6 +714,62 @@ func startAPIServer(
 	})
 }
 
+// startIPCServer serves the JSON-RPC server on the unix socket of the IPC path,
+// which only the user running the node can access. The socket is removed and the
+// IPC connections are closed when the context is canceled.
+func startIPCServer(
+	ctx context.Context,
+	svrCtx *server.Context,
+	g *errgroup.Group,
+	ipcPath string,
+	rpcServer *ethrpc.Server,
+) error {
+	if err := os.MkdirAll(filepath.Dir(ipcPath), 0o700); err != nil {
+		return fmt.Errorf("failed to create the IPC directory: %w", err)
+	}
+	// remove the socket left by an unclean shutdown, but never another file
+	if info, err := os.Lstat(ipcPath); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return fmt.Errorf("IPC path %s exists and is not a unix socket", ipcPath)
+		}
+		if err := os.Remove(ipcPath); err != nil {
+			return fmt.Errorf("failed to remove the stale IPC socket: %w", err)
+		}
+	}
+
+	ln, err := net.Listen("unix", ipcPath)
+	if err != nil {
+		return fmt.Errorf("failed to listen on the IPC socket: %w", err)
+	}
+	if err := os.Chmod(ipcPath, 0o600); err != nil {
+		_ = ln.Close()
+		return fmt.Errorf("failed to set the IPC socket permissions: %w", err)
+	}
+
+	g.Go(func() error {
+		svrCtx.Logger.Info("Starting JSON-RPC IPC server", "path", ipcPath)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- rpcServer.ServeListener(ln)
+		}()
+
+		select {
+		case <-ctx.Done():
+			svrCtx.Logger.Info("stopping JSON-RPC IPC server...", "path", ipcPath)
+			// closing the listener removes the socket
+			if err := ln.Close(); err != nil {
+				svrCtx.Logger.Error("failed to close the JSON-RPC IPC socket", "error", err.Error())
+			}
+			rpcServer.Stop()
+			return nil
+		case err := <-errCh:
+			svrCtx.Logger.Error("failed to start JSON-RPC IPC server", "error", err.Error())
+			return err
+		}
+	})
+	return nil
+}
+
 // GenDocProvider returns a function which returns the genesis doc from the genesis file.
 func GenDocProvider(cfg *cmtcfg.Config) func() (*cmttypes.GenesisDoc, error) {
 	return func() (*cmttypes.GenesisDoc, error) {
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/cosmos/evm/rpc"
+	"github.com/cosmos/evm/rpc/stream"
+
+	"cosmossdk.io/log"
+
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+)
+
+func TestStartIPCServer(t *testing.T) {
+	svrCtx := sdkserver.NewDefaultContext()
+	events := stream.NewRPCStreams(nil, log.NewNopLogger(), nil)
+	rpcServer := ethrpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName(rpc.EthNamespace, rpc.NewSubscriptionAPI(log.NewNopLogger(), events)))
+
+	ipcPath := filepath.Join(t.TempDir(), "data", "evmd.ipc")
+	require.NoError(t, os.MkdirAll(filepath.Dir(ipcPath), 0o700))
+
+	// a file which isn't a socket is never removed
+	require.NoError(t, os.WriteFile(ipcPath, nil, 0o600))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	g, ctx := errgroup.WithContext(ctx)
+	require.ErrorContains(t, startIPCServer(ctx, svrCtx, g, ipcPath, rpcServer), "is not a unix socket")
+	require.NoError(t, os.Remove(ipcPath))
+
+	require.NoError(t, startIPCServer(ctx, svrCtx, g, ipcPath, rpcServer))
+	info, err := os.Stat(ipcPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// the subscriptions of the stream are served on the IPC connections
+	client, err := ethrpc.DialIPC(ctx, ipcPath)
+	require.NoError(t, err)
+	defer client.Close()
+
+	hashes := make(chan common.Hash)
+	sub, err := client.EthSubscribe(ctx, hashes, "newPendingTransactions")
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	hash := common.HexToHash("0x01")
+	events.ListenPendingTx(hash)
+	select {
+	case received := <-hashes:
+		require.Equal(t, hash, received)
+	case err := <-sub.Err():
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no pending transaction notification")
+	}
+
+	// the socket is removed on shutdown
+	cancel()
+	require.NoError(t, g.Wait())
+	_, err = os.Stat(ipcPath)
+	require.True(t, os.IsNotExist(err))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmttypes "github.com/cometbft/cometbft/types"
@@ -11,10 +12,25 @@ import (
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
 	IndexBlock(*cmttypes.Block, []*abci.ExecTxResult) error
+	// MissingBlocks returns the blocks of the [from, to] range which are not
+	// indexed.
+	MissingBlocks(from, to int64) ([]int64, error)
+	// PruneBlocks removes the indexed data of the blocks up to height.
+	PruneBlocks(height int64) error
 
 	// GetByTxHash returns nil if tx not found.
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// GetLogs returns the logs matching the addresses and topics of the
+	// contiguous blocks with indexed logs starting at from and up to to,
+	// along with the last block searched, from-1 if from is not indexed.
+	GetLogs(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]*ethtypes.Log, int64, error)
+	// LogIndexedRange returns the first and last blocks whose logs are
+	// indexed, returns -1 if no block is indexed.
+	LogIndexedRange() (int64, int64, error)
 }
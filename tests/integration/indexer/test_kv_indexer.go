@@ -18,10 +18,13 @@ import (
 	"github.com/cosmos/evm/testutil/integration/evm/network"
 	utiltx "github.com/cosmos/evm/testutil/tx"
 	"github.com/cosmos/evm/x/vm/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"cosmossdk.io/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
@@ -190,3 +193,214 @@ func TestKVIndexer(t *testing.T, create network.CreateEvmApp, options ...network
 		})
 	}
 }
+
+var (
+	addrA  = common.BigToAddress(big.NewInt(1))
+	addrB  = common.BigToAddress(big.NewInt(2))
+	topic1 = common.BigToHash(big.NewInt(1))
+	topic2 = common.BigToHash(big.NewInt(2))
+)
+
+// newLogsBlock returns a block with a tx emitting a log from addrA with the
+// topics [topic1] when the height is odd, and from addrB with the topics
+// [topic2, topic1] otherwise.
+func newLogsBlock(t *testing.T, height int64) (*cmttypes.Block, []*abci.ExecTxResult) {
+	t.Helper()
+	txLog := &types.Log{Address: addrA.Hex(), Topics: []string{topic1.Hex()}}
+	if height%2 == 0 {
+		txLog = &types.Log{Address: addrB.Hex(), Topics: []string{topic2.Hex(), topic1.Hex()}}
+	}
+	anyRsp, err := codectypes.NewAnyWithValue(&types.MsgEthereumTxResponse{
+		Hash: common.BigToHash(big.NewInt(height)).Hex(),
+		Logs: []*types.Log{txLog},
+	})
+	require.NoError(t, err)
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{anyRsp}})
+	require.NoError(t, err)
+
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{[]byte("tx")}}}
+	return block, []*abci.ExecTxResult{{Code: 0, Data: data}}
+}
+
+// logBlocks returns the block numbers of the logs.
+func logBlocks(logs []*ethtypes.Log) []uint64 {
+	blocks := []uint64{}
+	for _, log := range logs {
+		blocks = append(blocks, log.BlockNumber)
+	}
+	return blocks
+}
+
+func TestKVIndexerLogs(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	indexBlocks := func(idxer *indexer.KVIndexer, heights ...int64) {
+		for _, height := range heights {
+			block, results := newLogsBlock(t, height)
+			require.NoError(t, idxer.IndexBlock(block, results))
+		}
+	}
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	indexBlocks(idxer, 1, 2, 3, 4)
+
+	first, last, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(4), last)
+
+	testCases := []struct {
+		name         string
+		from, to     int64
+		addresses    []common.Address
+		topics       [][]common.Hash
+		expBlocks    []uint64
+		expIndexedTo int64
+	}{
+		{"all logs", 1, 4, nil, nil, []uint64{1, 2, 3, 4}, 4},
+		{"by address", 1, 4, []common.Address{addrA}, nil, []uint64{1, 3}, 4},
+		{"by addresses", 2, 4, []common.Address{addrA, addrB}, nil, []uint64{2, 3, 4}, 4},
+		{"by first topic", 1, 4, nil, [][]common.Hash{{topic1}}, []uint64{1, 3}, 4},
+		{"by second topic", 1, 4, nil, [][]common.Hash{{}, {topic1}}, []uint64{2, 4}, 4},
+		{"by address and topic", 1, 4, []common.Address{addrA}, [][]common.Hash{{topic2}}, []uint64{}, 4},
+		{"range after the indexed blocks", 3, 6, nil, nil, []uint64{3, 4}, 4},
+		{"range not indexed", 5, 6, nil, nil, []uint64{}, 4},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, indexedTo, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expIndexedTo, indexedTo)
+			require.Equal(t, tc.expBlocks, logBlocks(logs))
+		})
+	}
+
+	t.Run("log retention", func(t *testing.T) {
+		idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+		idxer.SetLogRetention(2)
+		indexBlocks(idxer, 1, 2, 3, 4)
+
+		first, last, err := idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), first)
+		require.Equal(t, int64(4), last)
+
+		// the blocks out of the retention window are not indexed backward
+		indexBlocks(idxer, 2)
+		first, _, err = idxer.LogIndexedRange()
+		require.NoError(t, err)
+		require.Equal(t, int64(3), first)
+
+		logs, indexedTo, err := idxer.GetLogs(1, 4, []common.Address{addrA}, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), indexedTo)
+		require.Empty(t, logs)
+
+		logs, indexedTo, err = idxer.GetLogs(3, 4, []common.Address{addrA}, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), indexedTo)
+		require.Equal(t, []uint64{3}, logBlocks(logs))
+	})
+}
+
+// newEthTxBlock returns a block with a successful eth tx, along with the hash
+// of the eth tx.
+func newEthTxBlock(t *testing.T, clientCtx client.Context, height int64) (*cmttypes.Block, []*abci.ExecTxResult, common.Hash) {
+	t.Helper()
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{To: &to, Amount: big.NewInt(1000), GasLimit: 21000})
+	tx.From = priv.PubKey().Address().Bytes()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), utiltx.NewSigner(priv)))
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	txHash := tx.AsTransaction().Hash()
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: height}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	return block, []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: to.Hex()},
+				}},
+			},
+		},
+	}, txHash
+}
+
+func TestKVIndexerMaintenance(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	txDecoder := clientCtx.TxConfig.TxDecoder()
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	blocks := make(map[int64]*cmttypes.Block)
+	results := make(map[int64][]*abci.ExecTxResult)
+	var replacedHash common.Hash
+	for _, height := range []int64{1, 2, 4, 5} {
+		if height%2 == 0 {
+			var txHash common.Hash
+			blocks[height], results[height], txHash = newEthTxBlock(t, clientCtx, height)
+			if height == 4 {
+				replacedHash = txHash
+			}
+		} else {
+			blocks[height], results[height] = newLogsBlock(t, height)
+		}
+		require.NoError(t, idxer.IndexBlock(blocks[height], results[height]))
+	}
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), first)
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), last)
+
+	// the blocks without eth txs are recorded as indexed
+	missing, err := idxer.MissingBlocks(1, 6)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 6}, missing)
+
+	for height, block := range blocks {
+		require.NoError(t, indexer.VerifyBlock(idxer, log.NewNopLogger(), txDecoder, block, results[height]))
+	}
+
+	// an eth tx which is not indexed
+	block, result, _ := newEthTxBlock(t, clientCtx, 4)
+	require.Error(t, indexer.VerifyBlock(idxer, log.NewNopLogger(), txDecoder, block, result))
+	// an indexed eth tx which is not in the block
+	require.Error(t, indexer.VerifyBlock(idxer, log.NewNopLogger(), txDecoder, &cmttypes.Block{Header: cmttypes.Header{Height: 2}}, nil))
+
+	// indexing a block again replaces its eth txs
+	require.NoError(t, idxer.IndexBlock(block, result))
+	require.NoError(t, indexer.VerifyBlock(idxer, log.NewNopLogger(), txDecoder, block, result))
+	_, err = idxer.GetByTxHash(replacedHash)
+	require.Error(t, err)
+
+	require.NoError(t, idxer.PruneBlocks(2))
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), first)
+	missing, err = idxer.MissingBlocks(1, 5)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, missing)
+	_, err = idxer.GetByBlockAndIndex(2, 0)
+	require.Error(t, err)
+	logFirst, _, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), logFirst)
+}
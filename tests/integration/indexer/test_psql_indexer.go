@@ -0,0 +1,143 @@
+package indexer
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/evm/crypto/ethsecp256k1"
+	"github.com/cosmos/evm/indexer"
+	"github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// PSQLConnEnv is the environment variable holding the connection string of
+// the PostgreSQL database used by TestPSQLIndexer, which is skipped if unset.
+// The tables of the indexer are dropped from the database.
+const PSQLConnEnv = "EVM_INDEXER_PSQL_CONN"
+
+func TestPSQLIndexer(t *testing.T, create network.CreateEvmApp, options ...network.ConfigOption) {
+	connStr := os.Getenv(PSQLConnEnv)
+	if connStr == "" {
+		t.Skipf("%s is not set", PSQLConnEnv)
+	}
+
+	nw := network.New(create, options...)
+	encodingConfig := nw.GetEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	idxer, err := indexer.NewPSQLIndexer(connStr, log.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := idxer.DB().Exec(`DROP TABLE evm_events, evm_logs, evm_receipts, evm_txs, evm_blocks`)
+		require.NoError(t, err)
+		require.NoError(t, idxer.Close())
+	})
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	// index a block with an eth tx
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(&types.EvmTxArgs{Nonce: 0, To: &to, Amount: big.NewInt(1000), GasLimit: 21000})
+	tx.From = from.Bytes()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), utiltx.NewSigner(priv)))
+	txHash := tx.AsTransaction().Hash()
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), constants.ExampleAttoDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	block := &cmttypes.Block{Header: cmttypes.Header{Height: 1}, Data: cmttypes.Data{Txs: []cmttypes.Tx{txBz}}}
+	results := []*abci.ExecTxResult{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: to.Hex()},
+				}},
+			},
+		},
+	}
+	// indexing a block again replaces its rows
+	require.NoError(t, idxer.IndexBlock(block, results))
+	require.NoError(t, idxer.IndexBlock(block, results))
+
+	res1, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res1.Height)
+	require.Equal(t, uint64(21000), res1.GasUsed)
+	require.False(t, res1.Failed)
+	res2, err := idxer.GetByBlockAndIndex(1, 0)
+	require.NoError(t, err)
+	require.Equal(t, res1, res2)
+
+	_, err = idxer.GetByTxHash(common.Hash{})
+	require.Error(t, err)
+
+	var events int
+	require.NoError(t, idxer.DB().QueryRow(`SELECT COUNT(*) FROM evm_events WHERE height = 1`).Scan(&events))
+	require.Equal(t, 1, events)
+
+	// index blocks with logs
+	for height := int64(2); height <= 5; height++ {
+		block, results := newLogsBlock(t, height)
+		require.NoError(t, idxer.IndexBlock(block, results))
+	}
+
+	first, last, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(5), last)
+
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), last)
+
+	testCases := []struct {
+		name         string
+		from, to     int64
+		addresses    []common.Address
+		topics       [][]common.Hash
+		expBlocks    []uint64
+		expIndexedTo int64
+	}{
+		{"all logs", 1, 5, nil, nil, []uint64{2, 3, 4, 5}, 5},
+		{"by address", 1, 5, []common.Address{addrA}, nil, []uint64{3, 5}, 5},
+		{"by second topic", 1, 5, nil, [][]common.Hash{{}, {topic1}}, []uint64{2, 4}, 5},
+		{"by address and topic", 1, 5, []common.Address{addrB}, [][]common.Hash{{topic2}}, []uint64{2, 4}, 5},
+		{"range after the indexed blocks", 4, 7, nil, nil, []uint64{4, 5}, 5},
+		{"range not indexed", 6, 7, nil, nil, []uint64{}, 5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, indexedTo, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.expIndexedTo, indexedTo)
+			require.Equal(t, tc.expBlocks, logBlocks(logs))
+		})
+	}
+}
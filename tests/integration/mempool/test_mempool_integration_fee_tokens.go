@@ -0,0 +1,98 @@
+package mempool
+
+import (
+	"math/big"
+	"slices"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/testutil/keyring"
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// TestEVMTransactionWithFeeToken tests that an EVM transaction whose sender only
+// holds enough of the EVM denom for the transaction value is admitted to the
+// mempool, charged and refunded in a whitelisted fee token.
+func (s *IntegrationTestSuite) TestEVMTransactionWithFeeToken() {
+	const feeTokenDenom = "ibc/fee"
+
+	var (
+		feeTokenPrice   = sdkmath.LegacyNewDec(2)
+		feeTokenBalance = sdkmath.NewInt(1e18)
+		value           = big.NewInt(1000)
+		gasPrice        = big.NewInt(5_000_000_000)
+	)
+
+	// the sender can only pay for the transaction value with the EVM denom
+	sender := keyring.NewKey()
+	evmDenom := testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID].Denom
+	balances := []banktypes.Balance{{
+		Address: sender.AccAddr.String(),
+		Coins: sdk.NewCoins(
+			sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(value)),
+			sdk.NewCoin(feeTokenDenom, feeTokenBalance),
+		),
+	}}
+
+	// whitelist the fee token
+	erc20Genesis := erc20types.DefaultGenesisState()
+	erc20Genesis.TokenPairs = append(
+		slices.Clone(testconstants.ExampleTokenPairs),
+		erc20types.NewTokenPair(utiltx.GenerateAddress(), feeTokenDenom, erc20types.OWNER_MODULE),
+	)
+	erc20Genesis.NativePrecompiles = []string{testconstants.WEVMOSContractMainnet}
+	erc20Genesis.Params.FeeTokens = []erc20types.FeeToken{{Denom: feeTokenDenom, Price: feeTokenPrice}}
+
+	s.SetupTestWithChainID(
+		testconstants.ExampleChainID,
+		network.WithBalances(balances...),
+		network.WithCustomGenesis(network.CustomGenesisState{erc20types.ModuleName: erc20Genesis}),
+	)
+
+	to := s.keyring.GetAddr(1)
+	tx, err := s.factory.GenerateSignedEthTx(sender.Priv, evmtypes.EvmTxArgs{
+		Nonce:    0,
+		To:       &to,
+		Amount:   value,
+		GasLimit: TxGas,
+		GasPrice: gasPrice,
+	})
+	s.Require().NoError(err)
+
+	// mempool admission
+	res, err := s.checkTx(tx)
+	s.Require().NoError(err)
+	s.Require().Equal(abci.CodeTypeOK, res.Code, res.Log)
+	s.Require().Equal(1, s.network.App.GetMempool().CountTx())
+
+	iterator := s.network.App.GetMempool().Select(s.network.GetContext(), nil)
+	s.Require().NotNil(iterator, "fee token transaction should be pending")
+	s.Require().Equal(s.getTxHash(tx), s.getTxHash(iterator.Tx()))
+
+	// execution, with the fees deducted in the ante handler and the leftover gas
+	// refunded after the execution
+	txBytes, err := s.getTxBytes([]sdk.Tx{tx})
+	s.Require().NoError(err)
+	blockRes, err := s.network.NextBlockWithTxs(txBytes...)
+	s.Require().NoError(err)
+	s.Require().Len(blockRes.TxResults, 1)
+	s.Require().Equal(abci.CodeTypeOK, blockRes.TxResults[0].Code, blockRes.TxResults[0].Log)
+
+	gasUsed := big.NewInt(blockRes.TxResults[0].GasUsed)
+	fees := sdkmath.LegacyNewDecFromBigInt(new(big.Int).Mul(gasUsed, gasPrice))
+	expFeeTokenBalance := feeTokenBalance.Sub(fees.Quo(feeTokenPrice).TruncateInt())
+
+	ctx := s.network.GetContext()
+	bankKeeper := s.network.App.GetBankKeeper()
+	s.Require().Equal(expFeeTokenBalance.String(), bankKeeper.GetBalance(ctx, sender.AccAddr, feeTokenDenom).Amount.String())
+	s.Require().True(bankKeeper.GetBalance(ctx, sender.AccAddr, evmDenom).IsZero(), "the value should be paid with the EVM denom")
+}
@@ -71,7 +71,8 @@ func (s *IntegrationTestSuite) TearDownTest() {
 }
 
 // SetupTestWithChainID initializes the test environment with a specific chain ID.
-func (s *IntegrationTestSuite) SetupTestWithChainID(chainID testconstants.ChainID) {
+// Additional network options are applied after the suite options.
+func (s *IntegrationTestSuite) SetupTestWithChainID(chainID testconstants.ChainID, extraOptions ...network.ConfigOption) {
 	s.keyring = keyring.New(20)
 
 	options := []network.ConfigOption{
@@ -79,6 +80,7 @@ func (s *IntegrationTestSuite) SetupTestWithChainID(chainID testconstants.ChainI
 		network.WithPreFundedAccounts(s.keyring.GetAllAccAddrs()...),
 	}
 	options = append(options, s.options...)
+	options = append(options, extraOptions...)
 
 	nw := network.NewUnitTestNetwork(s.create, options...)
 	gh := grpc.NewIntegrationHandler(nw)
@@ -2,11 +2,13 @@ package ics20
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/cosmos/evm"
+	"github.com/cosmos/evm/precompiles/ics20"
 	evmibctesting "github.com/cosmos/evm/testutil/ibc"
 	"github.com/cosmos/evm/testutil/tx"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -171,3 +173,171 @@ func (s *PrecompileTestSuite) TestTransfer() {
 	)
 	s.Require().Equal(sdk.NewCoin(chainBDenom.IBCDenom(), amount), balance)
 }
+
+func (s *PrecompileTestSuite) TestTransferV2Errors() {
+	evmAppA := s.chainA.App.(evm.EvmApp)
+	denom, err := evmAppA.GetStakingKeeper().BondDenom(s.chainA.GetContext())
+	s.Require().NoError(err)
+
+	amount := sdkmath.NewInt(1)
+	defaultReceiver := s.chainB.SenderAccount.GetAddress().String()
+	defaultTimeout := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix()) //nolint:gosec // G115
+
+	tests := []struct {
+		name               string
+		clientID           string
+		useDynamicClient   bool
+		overrideSender     bool
+		receiver           string
+		timeoutTimestamp   uint64
+		expectErrSubstring string
+	}{
+		{
+			name:               "channel ID as source client",
+			clientID:           "channel-0",
+			receiver:           defaultReceiver,
+			timeoutTimestamp:   defaultTimeout,
+			expectErrSubstring: "invalid source client: channel-0",
+		},
+		{
+			name:               "invalid source client",
+			clientID:           "invalid/client",
+			receiver:           defaultReceiver,
+			timeoutTimestamp:   defaultTimeout,
+			expectErrSubstring: "invalid source client",
+		},
+		{
+			name:               "source client without counterparty",
+			clientID:           "07-tendermint-9",
+			receiver:           defaultReceiver,
+			timeoutTimestamp:   defaultTimeout,
+			expectErrSubstring: "not found",
+		},
+		{
+			name:               "zero timeout timestamp",
+			useDynamicClient:   true,
+			receiver:           defaultReceiver,
+			timeoutTimestamp:   0,
+			expectErrSubstring: "invalid timeout timestamp",
+		},
+		{
+			name:               "invalid receiver",
+			useDynamicClient:   true,
+			receiver:           "",
+			timeoutTimestamp:   defaultTimeout,
+			expectErrSubstring: "invalid address",
+		},
+		{
+			name:               "msg sender is not a contract caller",
+			useDynamicClient:   true,
+			overrideSender:     true,
+			receiver:           defaultReceiver,
+			timeoutTimestamp:   defaultTimeout,
+			expectErrSubstring: "does not match the requester address",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			path := evmibctesting.NewPath(s.chainA, s.chainB)
+			path.SetupV2()
+
+			clientID := tc.clientID
+			if tc.useDynamicClient {
+				clientID = path.EndpointA.ClientID
+			}
+
+			sender := common.BytesToAddress(s.chainA.SenderAccount.GetAddress().Bytes())
+			if tc.overrideSender {
+				sender = tx.GenerateAddress()
+			}
+
+			data, err := s.chainAPrecompile.ABI.Pack(
+				ics20.TransferV2Method,
+				clientID,
+				denom,
+				amount.BigInt(),
+				sender,
+				tc.receiver,
+				tc.timeoutTimestamp,
+				"",
+			)
+			s.Require().NoError(err)
+
+			_, _, res, err := s.chainA.SendEvmTx(
+				s.chainA.SenderAccounts[0],
+				0,
+				s.chainAPrecompile.Address(),
+				big.NewInt(0),
+				data,
+				0,
+			)
+			s.Require().Error(err)
+			s.Require().Contains(err.Error(), vm.ErrExecutionReverted.Error())
+			s.Require().Contains(evmtypes.NewExecErrorWithReason(res.Ret).Error(), tc.expectErrSubstring)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestTransferV2() {
+	path := evmibctesting.NewPath(s.chainA, s.chainB)
+	path.SetupV2()
+
+	evmAppA := s.chainA.App.(evm.EvmApp)
+	denom, err := evmAppA.GetStakingKeeper().BondDenom(s.chainA.GetContext())
+	s.Require().NoError(err)
+
+	amount := sdkmath.NewInt(5)
+	sourceAddr := common.BytesToAddress(s.chainA.SenderAccount.GetAddress().Bytes())
+	receiver := s.chainB.SenderAccount.GetAddress().String()
+	timeoutTimestamp := uint64(s.chainB.GetContext().BlockTime().Add(time.Hour).Unix()) //nolint:gosec // G115
+
+	data, err := s.chainAPrecompile.ABI.Pack(
+		ics20.TransferV2Method,
+		path.EndpointA.ClientID,
+		denom,
+		amount.BigInt(),
+		sourceAddr,
+		receiver,
+		timeoutTimestamp,
+		"",
+	)
+	s.Require().NoError(err)
+
+	res, _, ethRes, err := s.chainA.SendEvmTx(
+		s.chainA.SenderAccounts[0],
+		0,
+		s.chainAPrecompile.Address(),
+		big.NewInt(0),
+		data,
+		0,
+	)
+	s.Require().NoError(err)
+
+	out, err := s.chainAPrecompile.Unpack(ics20.TransferV2Method, ethRes.Ret)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), out[0])
+
+	packets, err := path.EndpointA.ParseV2PacketFromEvent(res.Events)
+	s.Require().NoError(err)
+	s.Require().Len(packets, 1)
+
+	err = path.RelayPacketV2(packets[0])
+	s.Require().NoError(err)
+
+	escrowAddr := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ClientID)
+	escrowBalance := evmAppA.GetBankKeeper().GetBalance(s.chainA.GetContext(), escrowAddr, denom)
+	s.Require().Equal(amount, escrowBalance.Amount)
+
+	trace := transfertypes.NewHop(transfertypes.PortID, path.EndpointB.ClientID)
+	chainBDenom := transfertypes.NewDenom(denom, trace)
+	evmAppB := s.chainB.App.(evm.EvmApp)
+	balance := evmAppB.GetBankKeeper().GetBalance(
+		s.chainB.GetContext(),
+		s.chainB.SenderAccount.GetAddress(),
+		chainBDenom.IBCDenom(),
+	)
+	s.Require().Equal(sdk.NewCoin(chainBDenom.IBCDenom(), amount), balance)
+}
@@ -17,6 +17,7 @@ import (
 	rpc "github.com/cosmos/evm/rpc/types"
 	"github.com/cosmos/evm/testutil/constants"
 	utiltx "github.com/cosmos/evm/testutil/tx"
+	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	sdkmath "cosmossdk.io/math"
@@ -370,6 +371,7 @@ func (s *TestSuite) TestFeeHistory() {
 			func(_ sdk.AccAddress) {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
 				var header metadata.MD
 				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterParams(queryClient, &header, 1)
@@ -387,6 +389,7 @@ func (s *TestSuite) TestFeeHistory() {
 			func(_ sdk.AccAddress) {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
 				var header metadata.MD
 				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterParams(queryClient, &header, 1)
@@ -404,6 +407,7 @@ func (s *TestSuite) TestFeeHistory() {
 			func(_ sdk.AccAddress) {
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
 				var header metadata.MD
 				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				RegisterParams(queryClient, &header, 1)
@@ -423,6 +427,7 @@ func (s *TestSuite) TestFeeHistory() {
 				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
 				var header metadata.MD
 				RegisterParams(queryClient, &header, 1)
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
@@ -455,6 +460,7 @@ func (s *TestSuite) TestFeeHistory() {
 				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -485,6 +491,7 @@ func (s *TestSuite) TestFeeHistory() {
 				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -517,6 +524,7 @@ func (s *TestSuite) TestFeeHistory() {
 				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -547,6 +555,7 @@ func (s *TestSuite) TestFeeHistory() {
 				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				client := s.backend.ClientCtx.Client.(*mocks.Client)
 				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient))
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -569,6 +578,39 @@ func (s *TestSuite) TestFeeHistory() {
 			true,
 			nil,
 		},
+		{
+			"pass - base fee and gas used ratio from state with rewards",
+			func(validator sdk.AccAddress) {
+				var header metadata.MD
+				queryClient := s.backend.QueryClient.QueryClient.(*mocks.EVMQueryClient)
+				fQueryClient := s.backend.QueryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := s.backend.ClientCtx.Client.(*mocks.Client)
+				s.backend.Cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistory(fQueryClient, 2, 2, []feemarkettypes.BlockFeeHistory{
+					{Height: 1, BaseFee: sdkmath.LegacyNewDec(50_000_000_000), GasUsed: 5_000_000, GasTarget: 5_000_000, GasLimit: 10_000_000},
+				})
+				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, baseFee)
+				RegisterValidatorAccount(queryClient, validator)
+				RegisterConsensusParams(client, 1)
+				RegisterParams(queryClient, &header, 1)
+				RegisterFeeMarketParams(fQueryClient, 1)
+			},
+			1,
+			1,
+			&rpc.FeeHistoryResult{
+				OldestBlock:      (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:          []*hexutil.Big{(*hexutil.Big)(big.NewInt(50_000_000_000)), (*hexutil.Big)(big.NewInt(50_000_000_000))},
+				GasUsedRatio:     []float64{0.5},
+				Reward:           [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0)), (*hexutil.Big)(big.NewInt(0))}},
+				BlobBaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(1)), (*hexutil.Big)(big.NewInt(1))},
+				BlobGasUsedRatio: []float64{0},
+			},
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			true,
+			nil,
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(fmt.Sprintf("case %s", tc.name), func() {
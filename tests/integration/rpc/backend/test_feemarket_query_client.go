@@ -21,3 +21,14 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.EXPECT().Params(mock.Anything, &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// FeeHistory
+func RegisterFeeMarketFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, lastBlock int64, blockCount uint64, entries []feemarkettypes.BlockFeeHistory) {
+	feeMarketClient.EXPECT().FeeHistory(mock.Anything, &feemarkettypes.QueryFeeHistoryRequest{LastBlock: lastBlock, BlockCount: blockCount}).
+		Return(&feemarkettypes.QueryFeeHistoryResponse{FeeHistory: entries}, nil)
+}
+
+func RegisterFeeMarketFeeHistoryError(feeMarketClient *mocks.FeeMarketQueryClient) {
+	feeMarketClient.EXPECT().FeeHistory(mock.Anything, mock.Anything).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
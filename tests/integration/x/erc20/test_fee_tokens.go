@@ -0,0 +1,170 @@
+package erc20
+
+import (
+	"math/big"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const feeTokenDenom = "ibc/fee"
+
+func (s *KeeperTestSuite) TestGetFeeTokenPrice() {
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPrice math.LegacyDec
+		expErr   error
+	}{
+		{
+			"fail - fee token not whitelisted",
+			func() {},
+			math.LegacyDec{},
+			types.ErrInvalidFeeToken,
+		},
+		{
+			"fail - token pair not registered",
+			func() {
+				s.setFeeTokens(ctx, types.FeeToken{Denom: feeTokenDenom, Price: math.LegacyNewDec(2)})
+			},
+			math.LegacyDec{},
+			types.ErrTokenPairNotFound,
+		},
+		{
+			"fail - token pair disabled",
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), feeTokenDenom, types.OWNER_MODULE)
+				pair.Enabled = false
+				s.Require().NoError(s.network.App.GetErc20Keeper().SetToken(ctx, pair))
+				s.setFeeTokens(ctx, types.FeeToken{Denom: feeTokenDenom, Price: math.LegacyNewDec(2)})
+			},
+			math.LegacyDec{},
+			types.ErrERC20TokenPairDisabled,
+		},
+		{
+			"fail - native ERC20 token pair",
+			func() {
+				// whitelisted before the ERC20 token is registered
+				s.setFeeTokens(ctx, types.FeeToken{Denom: feeTokenDenom, Price: math.LegacyNewDec(2)})
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), feeTokenDenom, types.OWNER_EXTERNAL)
+				s.Require().NoError(s.network.App.GetErc20Keeper().SetToken(ctx, pair))
+			},
+			math.LegacyDec{},
+			types.ErrInvalidFeeToken,
+		},
+		{
+			"fail - no price source",
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), feeTokenDenom, types.OWNER_MODULE)
+				s.Require().NoError(s.network.App.GetErc20Keeper().SetToken(ctx, pair))
+				s.setFeeTokens(ctx, types.FeeToken{Denom: feeTokenDenom, Price: math.LegacyZeroDec()})
+			},
+			math.LegacyDec{},
+			types.ErrInvalidFeeToken,
+		},
+		{
+			"pass - fixed price",
+			func() {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), feeTokenDenom, types.OWNER_MODULE)
+				s.Require().NoError(s.network.App.GetErc20Keeper().SetToken(ctx, pair))
+				s.setFeeTokens(ctx, types.FeeToken{Denom: feeTokenDenom, Price: math.LegacyNewDec(2)})
+			},
+			math.LegacyNewDec(2),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+
+			tc.malleate()
+
+			price, err := s.network.App.GetErc20Keeper().GetFeeTokenPrice(ctx, feeTokenDenom)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expPrice, price)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetFeeTokenPayment() {
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name       string
+		balance    math.Int
+		fees       *big.Int
+		expPayment bool
+	}{
+		{
+			"pass - enough fee token balance",
+			math.NewInt(5),
+			big.NewInt(10),
+			true,
+		},
+		{
+			"fail - insufficient fee token balance",
+			math.NewInt(4),
+			big.NewInt(10),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			payer := s.keyring.GetAccAddr(0)
+
+			pair := types.NewTokenPair(utiltx.GenerateAddress(), feeTokenDenom, types.OWNER_MODULE)
+			s.Require().NoError(s.network.App.GetErc20Keeper().SetToken(ctx, pair))
+			s.setFeeTokens(ctx, types.FeeToken{Denom: feeTokenDenom, Price: math.LegacyNewDec(2)})
+
+			coins := sdk.Coins{sdk.NewCoin(feeTokenDenom, tc.balance)}
+			bankKeeper := s.network.App.GetBankKeeper()
+			s.Require().NoError(bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+			s.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, coins))
+
+			payment, found := s.network.App.GetErc20Keeper().GetFeeTokenPayment(ctx, payer, tc.fees)
+			s.Require().Equal(tc.expPayment, found)
+			if tc.expPayment {
+				s.Require().Equal(feeTokenDenom, payment.Denom)
+				s.Require().Equal(math.LegacyNewDec(2), payment.Price)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSetParamsNativeERC20FeeToken() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	pair := types.NewTokenPair(utiltx.GenerateAddress(), feeTokenDenom, types.OWNER_EXTERNAL)
+	s.Require().NoError(erc20Keeper.SetToken(ctx, pair))
+
+	params := erc20Keeper.GetParams(ctx)
+	params.FeeTokens = []types.FeeToken{{Denom: feeTokenDenom, Price: math.LegacyNewDec(2)}}
+	s.Require().ErrorIs(erc20Keeper.SetParams(ctx, params), types.ErrInvalidFeeToken)
+	s.Require().Empty(erc20Keeper.GetParams(ctx).FeeTokens)
+}
+
+// setFeeTokens whitelists the given fee tokens in the erc20 params.
+func (s *KeeperTestSuite) setFeeTokens(ctx sdk.Context, feeTokens ...types.FeeToken) {
+	params := s.network.App.GetErc20Keeper().GetParams(ctx)
+	params.FeeTokens = feeTokens
+	s.Require().NoError(s.network.App.GetErc20Keeper().SetParams(ctx, params))
+
+	s.Require().Equal(feeTokens, s.network.App.GetErc20Keeper().GetParams(ctx).FeeTokens)
+}
@@ -0,0 +1,254 @@
+package erc20
+
+import (
+	"time"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	rateLimitChannel = "channel-0"
+	rateLimitDenom   = "ibc/limited"
+)
+
+func (s *KeeperTestSuite) TestUpdateRateLimitFlows() {
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name      string
+		rateLimit func() types.RateLimit
+		malleate  func()
+		channel   string
+		denom     string
+		direction string
+		amount    math.Int
+		expErr    bool
+		expUsage  math.Int
+	}{
+		{
+			"pass - not rate limited channel",
+			func() types.RateLimit { return newRateLimit(rateLimitDenom, 100, 100) },
+			func() {},
+			"channel-1",
+			rateLimitDenom,
+			types.FlowOutflow,
+			math.NewInt(1000),
+			false,
+			math.ZeroInt(),
+		},
+		{
+			"pass - outflow within quota",
+			func() types.RateLimit { return newRateLimit(rateLimitDenom, 100, 100) },
+			func() {},
+			rateLimitChannel,
+			rateLimitDenom,
+			types.FlowOutflow,
+			math.NewInt(100),
+			false,
+			math.NewInt(100),
+		},
+		{
+			"fail - outflow exceeds quota",
+			func() types.RateLimit { return newRateLimit(rateLimitDenom, 100, 100) },
+			func() {
+				s.Require().NoError(s.network.App.GetErc20Keeper().UpdateRateLimitOutflow(ctx, rateLimitChannel, rateLimitDenom, math.NewInt(60)))
+			},
+			rateLimitChannel,
+			rateLimitDenom,
+			types.FlowOutflow,
+			math.NewInt(41),
+			true,
+			math.NewInt(60),
+		},
+		{
+			"fail - inflow exceeds quota",
+			func() types.RateLimit { return newRateLimit(rateLimitDenom, 100, 0) },
+			func() {},
+			rateLimitChannel,
+			rateLimitDenom,
+			types.FlowInflow,
+			math.NewInt(101),
+			true,
+			math.ZeroInt(),
+		},
+		{
+			"pass - disabled outflow quota",
+			func() types.RateLimit { return newRateLimit(rateLimitDenom, 100, 0) },
+			func() {},
+			rateLimitChannel,
+			rateLimitDenom,
+			types.FlowOutflow,
+			math.NewInt(1000),
+			false,
+			math.NewInt(1000),
+		},
+		{
+			"fail - limit on the ERC20 address applies to the token pair denom",
+			func() types.RateLimit {
+				pair := types.NewTokenPair(utiltx.GenerateAddress(), rateLimitDenom, types.OWNER_MODULE)
+				s.Require().NoError(s.network.App.GetErc20Keeper().SetToken(ctx, pair))
+				return newRateLimit(pair.Erc20Address, 0, 100)
+			},
+			func() {},
+			rateLimitChannel,
+			rateLimitDenom,
+			types.FlowOutflow,
+			math.NewInt(101),
+			true,
+			math.ZeroInt(),
+		},
+		{
+			"pass - previous window partially overlaps the rolling window",
+			func() types.RateLimit { return newRateLimit(rateLimitDenom, 0, 100) },
+			func() {
+				s.Require().NoError(s.network.App.GetErc20Keeper().UpdateRateLimitOutflow(ctx, rateLimitChannel, rateLimitDenom, math.NewInt(100)))
+				// half of the previous window overlaps the rolling window
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(90 * time.Minute))
+			},
+			rateLimitChannel,
+			rateLimitDenom,
+			types.FlowOutflow,
+			math.NewInt(50),
+			false,
+			math.NewInt(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx = s.network.GetContext()
+			erc20Keeper := s.network.App.GetErc20Keeper()
+
+			rateLimit := tc.rateLimit()
+			s.setRateLimits(ctx, rateLimit)
+			tc.malleate()
+
+			var err error
+			if tc.direction == types.FlowInflow {
+				err = erc20Keeper.UpdateRateLimitInflow(ctx, tc.channel, tc.denom, tc.amount)
+			} else {
+				err = erc20Keeper.UpdateRateLimitOutflow(ctx, tc.channel, tc.denom, tc.amount)
+			}
+
+			exceeded := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeRateLimitExceeded {
+					exceeded = true
+				}
+			}
+			s.Require().Equal(tc.expErr, exceeded)
+
+			if tc.expErr {
+				s.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			inflow, outflow := erc20Keeper.GetRateLimitUsage(ctx, rateLimit)
+			usage := outflow
+			if tc.direction == types.FlowInflow {
+				usage = inflow
+			}
+			s.Require().Equal(tc.expUsage.String(), usage.String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRevertRateLimitOutflow() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	rateLimit := newRateLimit(rateLimitDenom, 0, 100)
+	s.setRateLimits(ctx, rateLimit)
+
+	s.Require().NoError(erc20Keeper.UpdateRateLimitOutflow(ctx, rateLimitChannel, rateLimitDenom, math.NewInt(80)))
+	s.Require().ErrorIs(erc20Keeper.UpdateRateLimitOutflow(ctx, rateLimitChannel, rateLimitDenom, math.NewInt(80)), types.ErrRateLimitExceeded)
+
+	// the refunded tokens free the quota
+	erc20Keeper.RevertRateLimitOutflow(ctx, rateLimitChannel, rateLimitDenom, math.NewInt(80))
+	_, outflow := erc20Keeper.GetRateLimitUsage(ctx, rateLimit)
+	s.Require().True(outflow.IsZero())
+	s.Require().NoError(erc20Keeper.UpdateRateLimitOutflow(ctx, rateLimitChannel, rateLimitDenom, math.NewInt(80)))
+
+	// the outflow doesn't go below zero
+	erc20Keeper.RevertRateLimitOutflow(ctx, rateLimitChannel, rateLimitDenom, math.NewInt(1000))
+	_, outflow = erc20Keeper.GetRateLimitUsage(ctx, rateLimit)
+	s.Require().True(outflow.IsZero())
+}
+
+func (s *KeeperTestSuite) TestSetParamsRateLimits() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	kept := newRateLimit(rateLimitDenom, 0, 100)
+	removed := newRateLimit("ibc/removed", 0, 100)
+	s.setRateLimits(ctx, kept, removed)
+
+	s.Require().NoError(erc20Keeper.UpdateRateLimitOutflow(ctx, rateLimitChannel, kept.Denom, math.NewInt(10)))
+	s.Require().NoError(erc20Keeper.UpdateRateLimitOutflow(ctx, rateLimitChannel, removed.Denom, math.NewInt(10)))
+
+	// the flows of the removed rate limits are deleted
+	s.setRateLimits(ctx, kept)
+	_, found := erc20Keeper.GetRateLimitFlow(ctx, rateLimitChannel, kept.Denom)
+	s.Require().True(found)
+	_, found = erc20Keeper.GetRateLimitFlow(ctx, rateLimitChannel, removed.Denom)
+	s.Require().False(found)
+
+	// invalid rate limits are rejected
+	params := erc20Keeper.GetParams(ctx)
+	params.RateLimits = append(params.RateLimits, kept)
+	s.Require().ErrorContains(erc20Keeper.SetParams(ctx, params), "duplicated rate limit")
+	s.Require().Equal([]types.RateLimit{kept}, erc20Keeper.GetParams(ctx).RateLimits)
+}
+
+func (s *KeeperTestSuite) TestQueryRateLimits() {
+	s.SetupTest()
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.GetErc20Keeper()
+
+	rateLimitA := newRateLimit(rateLimitDenom, 100, 100)
+	rateLimitB := newRateLimit(rateLimitDenom, 100, 100)
+	rateLimitB.ChannelId = "channel-1"
+	s.setRateLimits(ctx, rateLimitA, rateLimitB)
+
+	s.Require().NoError(erc20Keeper.UpdateRateLimitInflow(ctx, rateLimitChannel, rateLimitDenom, math.NewInt(30)))
+
+	res, err := erc20Keeper.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.RateLimits, 2)
+
+	res, err = erc20Keeper.RateLimits(ctx, &types.QueryRateLimitsRequest{ChannelId: rateLimitChannel})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.RateLimitUsage{{
+		RateLimit: rateLimitA,
+		Inflow:    math.NewInt(30),
+		Outflow:   math.ZeroInt(),
+	}}, res.RateLimits)
+}
+
+// newRateLimit returns a rate limit of the denom on the rate limited channel
+// with an hourly window.
+func newRateLimit(denom string, maxInflow, maxOutflow int64) types.RateLimit {
+	return types.RateLimit{
+		ChannelId:  rateLimitChannel,
+		Denom:      denom,
+		MaxInflow:  math.NewInt(maxInflow),
+		MaxOutflow: math.NewInt(maxOutflow),
+		Window:     time.Hour,
+	}
+}
+
+// setRateLimits sets the given rate limits in the erc20 params.
+func (s *KeeperTestSuite) setRateLimits(ctx sdk.Context, rateLimits ...types.RateLimit) {
+	params := s.network.App.GetErc20Keeper().GetParams(ctx)
+	params.RateLimits = rateLimits
+	s.Require().NoError(s.network.App.GetErc20Keeper().SetParams(ctx, params))
+}
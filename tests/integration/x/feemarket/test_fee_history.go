@@ -0,0 +1,173 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/evm/testutil/integration/evm/network"
+	"github.com/cosmos/evm/x/feemarket/keeper"
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestEndBlockRecordsFeeHistory() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	testCases := []struct {
+		name       string
+		window     uint64
+		blocks     int64
+		expHeights []int64
+	}{
+		{
+			"disabled - nothing recorded",
+			0,
+			3,
+			nil,
+		},
+		{
+			"window larger than the recorded blocks",
+			5,
+			3,
+			[]int64{1, 2, 3},
+		},
+		{
+			"older blocks are pruned",
+			2,
+			4,
+			[]int64{3, 4},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			params := fmk.GetParams(ctx)
+			params.FeeHistoryWindow = tc.window
+			s.Require().NoError(fmk.SetParams(ctx, params))
+
+			for height := int64(1); height <= tc.blocks; height++ {
+				meter := storetypes.NewGasMeter(uint64(1000000000))
+				ctx = ctx.WithBlockHeight(height).
+					WithBlockTime(time.Unix(height*5, 0)).
+					WithBlockGasMeter(meter).
+					WithBlockGasUsed(uint64(height) * 21000) //#nosec G115
+				s.Require().NoError(fmk.EndBlock(ctx))
+			}
+
+			entries := fmk.GetAllFeeHistory(ctx)
+			s.Require().Len(entries, len(tc.expHeights))
+			for i, entry := range entries {
+				s.Require().Equal(tc.expHeights[i], entry.Height)
+				s.Require().Equal(uint64(entry.Height)*21000, entry.GasUsed) //#nosec G115
+				s.Require().Equal(params.BaseFee, entry.BaseFee)
+				s.Require().Equal(entry.GasLimit/uint64(params.ElasticityMultiplier), entry.GasTarget)
+				s.Require().Equal(uint64(entry.Height)*5, entry.BlockTime) //#nosec G115
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	nw := network.NewUnitTestNetwork(s.create, s.options...)
+	ctx := nw.GetContext()
+	fmk := nw.App.GetFeeMarketKeeper()
+
+	// params stored by consensus version 1 don't have a fee history window
+	params := fmk.GetParams(ctx)
+	params.FeeHistoryWindow = 0
+	s.Require().NoError(fmk.SetParams(ctx, params))
+
+	s.Require().NoError(keeper.NewMigrator(*fmk).Migrate1to2(ctx))
+
+	params.FeeHistoryWindow = types.DefaultFeeHistoryWindow
+	s.Require().Equal(params, fmk.GetParams(ctx))
+}
+
+func (s *KeeperTestSuite) TestQueryFeeHistory() {
+	var (
+		nw  *network.UnitTestNetwork
+		ctx sdk.Context
+	)
+
+	testCases := []struct {
+		name       string
+		req        *types.QueryFeeHistoryRequest
+		expHeights []int64
+		expPass    bool
+	}{
+		{
+			"fail - nil request",
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - zero block count",
+			&types.QueryFeeHistoryRequest{LastBlock: 3},
+			nil,
+			false,
+		},
+		{
+			"pass - latest recorded blocks",
+			&types.QueryFeeHistoryRequest{BlockCount: 2},
+			[]int64{4, 5},
+			true,
+		},
+		{
+			"pass - range ending at the given block",
+			&types.QueryFeeHistoryRequest{LastBlock: 3, BlockCount: 2},
+			[]int64{2, 3},
+			true,
+		},
+		{
+			"pass - block count capped by the history",
+			&types.QueryFeeHistoryRequest{LastBlock: 2, BlockCount: 10},
+			[]int64{1, 2},
+			true,
+		},
+		{
+			"pass - range beyond the recorded blocks",
+			&types.QueryFeeHistoryRequest{LastBlock: 10, BlockCount: 2},
+			nil,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// reset network and context
+			nw = network.NewUnitTestNetwork(s.create, s.options...)
+			ctx = nw.GetContext()
+			fmk := nw.App.GetFeeMarketKeeper()
+
+			for height := int64(1); height <= 5; height++ {
+				fmk.SetBlockFeeHistory(ctx, types.BlockFeeHistory{
+					Height:  height,
+					BaseFee: math.LegacyNewDec(height),
+				})
+			}
+
+			res, err := fmk.FeeHistory(ctx, tc.req)
+			if !tc.expPass {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Len(res.FeeHistory, len(tc.expHeights))
+			for i, entry := range res.FeeHistory {
+				s.Require().Equal(tc.expHeights[i], entry.Height)
+				s.Require().Equal(math.LegacyNewDec(entry.Height), entry.BaseFee)
+			}
+		})
+	}
+}
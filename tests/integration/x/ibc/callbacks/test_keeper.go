@@ -2,11 +2,16 @@ package callbacks
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/cosmos/evm/contracts"
 	"github.com/cosmos/evm/testutil/keyring"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	"github.com/cosmos/evm/x/ibc/callbacks/testutil"
 	"github.com/cosmos/evm/x/ibc/callbacks/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
 	cbtypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -16,6 +21,182 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+func (s *KeeperTestSuite) TestOnSendPacket() {
+	var (
+		contract     common.Address
+		ctx          sdk.Context
+		senderKey    keyring.Key
+		transferData transfertypes.FungibleTokenPacketData
+		packetData   []byte
+	)
+	testCases := []struct {
+		name           string
+		malleate       func()
+		expErr         error
+		expSentPackets int64
+	}{
+		{
+			"success",
+			func() {
+				contract = s.deployCounterWithCallbacks()
+				ctx = s.network.GetContext()
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "send_callback": true}}`, contract.Hex())
+				packetData = transferData.GetBytes()
+			},
+			nil,
+			1,
+		},
+		{
+			"no callback in memo",
+			func() {
+				transferData.Memo = ""
+				packetData = transferData.GetBytes()
+			},
+			nil,
+			0,
+		},
+		{
+			"send callback not enabled",
+			func() {
+				// the contract code does not exist, but it is not called
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, contract.Hex())
+				packetData = transferData.GetBytes()
+			},
+			nil,
+			0,
+		},
+		{
+			"send callback disabled",
+			func() {
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "send_callback": false}}`, contract.Hex())
+				packetData = transferData.GetBytes()
+			},
+			nil,
+			0,
+		},
+		{
+			"contract code does not exist",
+			func() {},
+			types.ErrCallbackFailed,
+			0,
+		},
+		{
+			"contract reverts",
+			func() {
+				var err error
+				// the ERC20 contract does not implement onPacketSend
+				contract, err = s.factory.DeployContract(
+					senderKey.Priv,
+					evmtypes.EvmTxArgs{},
+					testutiltypes.ContractDeploymentData{
+						Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+						ConstructorArgs: []interface{}{"coin", "token", uint8(18)},
+					},
+				)
+				s.Require().NoError(err)
+				s.Require().NoError(s.network.NextBlock())
+				ctx = s.network.GetContext()
+
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "send_callback": true}}`, contract.Hex())
+				packetData = transferData.GetBytes()
+			},
+			types.ErrCallbackFailed,
+			0,
+		},
+		{
+			"packet data is not transfer",
+			func() {
+				packetData = []byte("not a transfer packet")
+			},
+			ibcerrors.ErrInvalidType,
+			0,
+		},
+		{
+			"packet data is transfer but callback data is not valid",
+			func() {
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": 10, "calldata": "%x", "send_callback": true}}`, []byte("calldata"))
+				packetData = transferData.GetBytes()
+			},
+			cbtypes.ErrInvalidCallbackData,
+			0,
+		},
+		{
+			"packet data is transfer but custom calldata is set",
+			func() {
+				transferData.Memo = fmt.Sprintf(`{"src_callback": {"address": "%s", "calldata": "%x", "send_callback": true}}`, contract.Hex(), []byte("calldata"))
+				packetData = transferData.GetBytes()
+			},
+			types.ErrInvalidCalldata,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.SetupTest() // reset
+		ctx = s.network.GetContext()
+
+		senderKey = s.keyring.GetKey(0)
+		contract = common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678") // Example contract address
+
+		transferData = transfertypes.NewFungibleTokenPacketData(
+			"uatom",
+			"100",
+			senderKey.AccAddr.String(),
+			"cosmos1receiver",
+			fmt.Sprintf(`{"src_callback": {"address": "%s", "send_callback": true}}`, contract.Hex()),
+		)
+		packetData = transferData.GetBytes()
+
+		tc.malleate()
+
+		err := s.network.App.GetCallbackKeeper().IBCSendPacketCallback(
+			ctx, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 10000000, packetData, contract.Hex(), senderKey.AccAddr.String(), transfertypes.V1,
+		)
+		if tc.expErr != nil {
+			s.Require().Error(err, tc.name)
+			s.Require().Contains(err.Error(), tc.expErr.Error(), "expected error: %s, got: %s", tc.expErr.Error(), err.Error())
+		} else {
+			s.Require().NoError(err, tc.name)
+		}
+
+		if tc.expSentPackets != 0 {
+			s.Require().Equal(big.NewInt(tc.expSentPackets), s.sentPackets(ctx, contract), tc.name)
+		}
+	}
+}
+
+// deployCounterWithCallbacks deploys the CounterWithCallbacks test contract.
+func (s *KeeperTestSuite) deployCounterWithCallbacks() common.Address {
+	contractData, err := testutil.LoadCounterWithCallbacksContract()
+	s.Require().NoError(err)
+
+	contract, err := s.factory.DeployContract(
+		s.keyring.GetPrivKey(0),
+		evmtypes.EvmTxArgs{},
+		testutiltypes.ContractDeploymentData{Contract: contractData},
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.NextBlock())
+
+	return contract
+}
+
+// sentPackets returns the number of packets sent with the given
+// CounterWithCallbacks contract as source callback.
+func (s *KeeperTestSuite) sentPackets(ctx sdk.Context, contract common.Address) *big.Int {
+	contractData, err := testutil.LoadCounterWithCallbacksContract()
+	s.Require().NoError(err)
+
+	res, err := s.network.App.GetEVMKeeper().CallEVM(
+		ctx, contractData.ABI, s.keyring.GetAddr(0), contract, false, big.NewInt(100_000), "sentPackets",
+	)
+	s.Require().NoError(err)
+
+	var sentPackets *big.Int
+	s.Require().NoError(contractData.ABI.UnpackIntoInterface(&sentPackets, "sentPackets", res.Ret))
+	return sentPackets
+}
+
 func (s *KeeperTestSuite) TestOnRecvPacket() {
 	var (
 		contract     common.Address
@@ -0,0 +1,277 @@
+package precisebank
+
+import (
+	"math/big"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/cosmos/evm/x/precisebank/keeper"
+	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// usdcExtendedDenom is a 6 decimals denom extended to 18 decimals through the
+// x/precisebank params.
+var usdcExtendedDenom = types.NewExtendedDenom("uusdc", "ausdc", 6)
+
+// setExtendedDenoms registers the given extended denoms in the x/precisebank
+// params.
+func (s *KeeperIntegrationTestSuite) setExtendedDenoms(eds ...types.ExtendedDenom) {
+	err := s.network.App.GetPreciseBankKeeper().SetParams(s.network.GetContext(), types.NewParams(eds...))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperIntegrationTestSuite) TestSetParamsExtendedDenoms() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		params   types.Params
+		wantErr  string
+	}{
+		{
+			"valid - extended denom",
+			func() {},
+			types.NewParams(usdcExtendedDenom),
+			"",
+		},
+		{
+			"invalid - EVM integer denom",
+			func() {},
+			types.NewParams(types.NewExtendedDenom(types.IntegerCoinDenom(), "aother", 6)),
+			"the EVM denom is always extended and cannot be registered in params",
+		},
+		{
+			"invalid - EVM extended denom",
+			func() {},
+			types.NewParams(types.NewExtendedDenom("uother", types.ExtendedCoinDenom(), 6)),
+			"the EVM denom is always extended and cannot be registered in params",
+		},
+		{
+			"valid - remove extended denom without fractional balances",
+			func() {
+				s.setExtendedDenoms(usdcExtendedDenom)
+			},
+			types.DefaultParams(),
+			"",
+		},
+		{
+			"invalid - remove extended denom with fractional balances",
+			func() {
+				s.setExtendedDenoms(usdcExtendedDenom)
+				s.MintToAccount(sdk.AccAddress{1}, cs(c(usdcExtendedDenom.ExtendedDenom, 1)))
+			},
+			types.DefaultParams(),
+			"cannot remove or modify extended denom ausdc with outstanding fractional balances",
+		},
+		{
+			"invalid - modify extended denom with fractional balances",
+			func() {
+				s.setExtendedDenoms(usdcExtendedDenom)
+				s.MintToAccount(sdk.AccAddress{1}, cs(c(usdcExtendedDenom.ExtendedDenom, 1)))
+			},
+			types.NewParams(types.NewExtendedDenom("uusdc", "ausdc", 8)),
+			"cannot remove or modify extended denom ausdc with outstanding fractional balances",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			err := s.network.App.GetPreciseBankKeeper().SetParams(s.network.GetContext(), tc.params)
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.params, s.network.App.GetPreciseBankKeeper().GetParams(s.network.GetContext()))
+		})
+	}
+}
+
+func (s *KeeperIntegrationTestSuite) TestSendCoinsExtendedDenom() {
+	s.SetupTest()
+	s.setExtendedDenoms(usdcExtendedDenom)
+
+	pbk := s.network.App.GetPreciseBankKeeper()
+	ctx := s.network.GetContext()
+	cf := usdcExtendedDenom.ConversionFactor()
+
+	sender := sdk.AccAddress{1}
+	recipient := sdk.AccAddress{2}
+	reserveAddr := s.network.App.GetAccountKeeper().GetModuleAddress(types.ModuleName)
+
+	// 1.5 uusdc to the sender
+	s.MintToAccount(sender, cs(ci(usdcExtendedDenom.ExtendedDenom, cf.MulRaw(3).QuoRaw(2))))
+
+	// 0.75 uusdc to the recipient
+	sendAmt := cf.MulRaw(3).QuoRaw(4)
+	err := pbk.SendCoins(ctx, sender, recipient, cs(ci(usdcExtendedDenom.ExtendedDenom, sendAmt)))
+	s.Require().NoError(err)
+
+	s.Require().Equal(sendAmt, pbk.GetBalance(ctx, sender, usdcExtendedDenom.ExtendedDenom).Amount)
+	s.Require().Equal(sendAmt, pbk.GetBalance(ctx, recipient, usdcExtendedDenom.ExtendedDenom).Amount)
+	s.Require().Equal(sendAmt, pbk.GetDenomFractionalBalance(ctx, usdcExtendedDenom, sender))
+	s.Require().Equal(sendAmt, pbk.GetDenomFractionalBalance(ctx, usdcExtendedDenom, recipient))
+
+	// fractional balances of the EVM denom are not affected
+	s.Require().True(pbk.GetFractionalBalance(ctx, sender).IsZero())
+	s.Require().True(pbk.GetFractionalBalance(ctx, recipient).IsZero())
+
+	// the reserve fully backs the fractional balances and remainder
+	reserveBal := s.network.App.GetBankKeeper().GetBalance(ctx, reserveAddr, usdcExtendedDenom.IntegerDenom)
+	backed := pbk.GetDenomTotalSumFractionalBalances(ctx, usdcExtendedDenom).
+		Add(pbk.GetDenomRemainderAmount(ctx, usdcExtendedDenom))
+	s.Require().Equal(reserveBal.Amount.Mul(cf), backed)
+}
+
+func (s *KeeperIntegrationTestSuite) TestMintBurnExtendedDenom() {
+	s.SetupTest()
+	s.setExtendedDenoms(usdcExtendedDenom)
+
+	pbk := s.network.App.GetPreciseBankKeeper()
+	ctx := s.network.GetContext()
+	cf := usdcExtendedDenom.ConversionFactor()
+	moduleAddr := s.network.App.GetAccountKeeper().GetModuleAddress(evmtypes.ModuleName)
+
+	mintAmt := cf.MulRaw(5).AddRaw(10)
+	err := pbk.MintCoins(ctx, evmtypes.ModuleName, cs(ci(usdcExtendedDenom.ExtendedDenom, mintAmt)))
+	s.Require().NoError(err)
+
+	s.Require().Equal(mintAmt, pbk.GetBalance(ctx, moduleAddr, usdcExtendedDenom.ExtendedDenom).Amount)
+	s.Require().Equal(mintAmt, pbk.GetSupply(ctx, usdcExtendedDenom.ExtendedDenom).Amount)
+	s.Require().Equal(cf.SubRaw(10), pbk.GetDenomRemainderAmount(ctx, usdcExtendedDenom))
+	s.Require().True(pbk.GetRemainderAmount(ctx).IsZero())
+
+	err = pbk.BurnCoins(ctx, evmtypes.ModuleName, cs(ci(usdcExtendedDenom.ExtendedDenom, mintAmt)))
+	s.Require().NoError(err)
+
+	s.Require().True(pbk.GetBalance(ctx, moduleAddr, usdcExtendedDenom.ExtendedDenom).IsZero())
+	s.Require().True(pbk.GetSupply(ctx, usdcExtendedDenom.ExtendedDenom).IsZero())
+	s.Require().Equal(sdkmath.ZeroInt(), pbk.GetDenomRemainderAmount(ctx, usdcExtendedDenom))
+}
+
+func (s *KeeperIntegrationTestSuite) TestUpdateParamsExtendedDenomAllowances() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		params   types.Params
+		wantErr  string
+	}{
+		{
+			"valid - extend denom without token pair",
+			func() {},
+			types.NewParams(usdcExtendedDenom),
+			"",
+		},
+		{
+			"valid - extend denom whose token pair has no allowances",
+			func() {
+				s.setTokenPair(usdcExtendedDenom.IntegerDenom)
+			},
+			types.NewParams(usdcExtendedDenom),
+			"",
+		},
+		{
+			"invalid - extend denom whose token pair has allowances",
+			func() {
+				pair := s.setTokenPair(usdcExtendedDenom.IntegerDenom)
+				s.setAllowance(pair)
+			},
+			types.NewParams(usdcExtendedDenom),
+			"cannot change the decimals of denom uusdc",
+		},
+		{
+			"invalid - revert extended denom whose token pair has allowances",
+			func() {
+				s.setExtendedDenoms(usdcExtendedDenom)
+				pair := s.setTokenPair(usdcExtendedDenom.IntegerDenom)
+				s.setAllowance(pair)
+			},
+			types.DefaultParams(),
+			"cannot change the decimals of denom uusdc",
+		},
+		{
+			"valid - unchanged extended denom whose token pair has allowances",
+			func() {
+				s.setExtendedDenoms(usdcExtendedDenom)
+				pair := s.setTokenPair(usdcExtendedDenom.IntegerDenom)
+				s.setAllowance(pair)
+			},
+			types.NewParams(usdcExtendedDenom),
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			tc.malleate()
+
+			pbk := s.network.App.GetPreciseBankKeeper()
+			msgServer := keeper.NewMsgServerImpl(*pbk)
+			_, err := msgServer.UpdateParams(s.network.GetContext(), &types.MsgUpdateParams{
+				Authority: pbk.GetAuthority().String(),
+				Params:    tc.params,
+			})
+			if tc.wantErr != "" {
+				s.Require().ErrorContains(err, tc.wantErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.params, pbk.GetParams(s.network.GetContext()))
+		})
+	}
+}
+
+func (s *KeeperIntegrationTestSuite) TestExtendedDenomCache() {
+	s.SetupTest()
+
+	pbk := s.network.App.GetPreciseBankKeeper()
+	ctx := s.network.GetContext()
+
+	// params updates are cached immediately
+	s.setExtendedDenoms(usdcExtendedDenom)
+	ed, found := pbk.GetExtendedDenom(ctx, usdcExtendedDenom.IntegerDenom)
+	s.Require().True(found)
+	s.Require().Equal(usdcExtendedDenom, ed)
+
+	// params updates whose state changes are discarded are dropped from the
+	// cache on the next block
+	cacheCtx, _ := ctx.CacheContext()
+	s.Require().NoError(pbk.SetParams(cacheCtx, types.DefaultParams()))
+	_, found = pbk.GetExtendedDenom(ctx, usdcExtendedDenom.IntegerDenom)
+	s.Require().False(found)
+
+	pbk.BeginBlock(ctx)
+	ed, found = pbk.GetExtendedDenom(ctx, usdcExtendedDenom.IntegerDenom)
+	s.Require().True(found)
+	s.Require().Equal(usdcExtendedDenom, ed)
+}
+
+// setTokenPair registers a token pair for the given denom in x/erc20.
+func (s *KeeperIntegrationTestSuite) setTokenPair(denom string) erc20types.TokenPair {
+	pair := erc20types.NewTokenPair(utiltx.GenerateAddress(), denom, erc20types.OWNER_MODULE)
+	err := s.network.App.GetErc20Keeper().SetToken(s.network.GetContext(), pair)
+	s.Require().NoError(err)
+	return pair
+}
+
+// setAllowance sets an allowance on the ERC20 token of the given token pair.
+func (s *KeeperIntegrationTestSuite) setAllowance(pair erc20types.TokenPair) {
+	err := s.network.App.GetErc20Keeper().SetAllowance(
+		s.network.GetContext(),
+		pair.GetERC20Contract(),
+		utiltx.GenerateAddress(),
+		utiltx.GenerateAddress(),
+		big.NewInt(1),
+	)
+	s.Require().NoError(err)
+}
@@ -94,3 +94,76 @@ func (s *KeeperIntegrationTestSuite) TestQueryFractionalBalance() {
 		})
 	}
 }
+
+func (s *KeeperIntegrationTestSuite) TestQueryTotalFractionalBalances() {
+	testCases := []struct {
+		name         string
+		giveBalances []sdkmath.Int
+	}{
+		{
+			"empty",
+			[]sdkmath.Int{},
+		},
+		{
+			"min amount",
+			[]sdkmath.Int{sdkmath.OneInt()},
+		},
+		{
+			"multiple fractional balances",
+			[]sdkmath.Int{
+				types.ConversionFactor().QuoRaw(2),
+				types.ConversionFactor().QuoRaw(2).AddRaw(1),
+			},
+		},
+		{
+			"integer amounts are not included",
+			[]sdkmath.Int{
+				types.ConversionFactor().MulRaw(5).AddRaw(100),
+				types.ConversionFactor().MulRaw(2),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			expTotal := sdkmath.ZeroInt()
+			for i, balance := range tc.giveBalances {
+				addr := sdk.AccAddress([]byte{byte(i + 1)})
+				s.MintToAccount(addr, sdk.NewCoins(sdk.NewCoin(types.ExtendedCoinDenom(), balance)))
+
+				expTotal = expTotal.Add(balance.Mod(types.ConversionFactor()))
+			}
+
+			res, err := s.network.GetPreciseBankClient().TotalFractionalBalances(
+				context.Background(),
+				&types.QueryTotalFractionalBalancesRequest{},
+			)
+			s.Require().NoError(err)
+
+			s.Require().Equal(sdk.NewCoin(types.ExtendedCoinDenom(), expTotal), res.Total)
+		})
+	}
+}
+
+func (s *KeeperIntegrationTestSuite) TestQueryTotalFractionalBalancesExtendedDenom() {
+	s.SetupTest()
+	s.setExtendedDenoms(usdcExtendedDenom)
+
+	cf := usdcExtendedDenom.ConversionFactor()
+	s.MintToAccount(sdk.AccAddress{1}, sdk.NewCoins(sdk.NewCoin(usdcExtendedDenom.ExtendedDenom, cf.AddRaw(10))))
+
+	res, err := s.network.GetPreciseBankClient().TotalFractionalBalances(
+		context.Background(),
+		&types.QueryTotalFractionalBalancesRequest{Denom: usdcExtendedDenom.ExtendedDenom},
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(usdcExtendedDenom.ExtendedDenom, sdkmath.NewInt(10)), res.Total)
+
+	_, err = s.network.GetPreciseBankClient().TotalFractionalBalances(
+		context.Background(),
+		&types.QueryTotalFractionalBalancesRequest{Denom: "unknown"},
+	)
+	s.Require().ErrorContains(err, "unknown is not an extended denom")
+}
@@ -0,0 +1,111 @@
+package precisebank
+
+import (
+	"github.com/cosmos/evm/x/precisebank/keeper"
+	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperIntegrationTestSuite) TestInvariants() {
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context)
+		invariant func(keeper.Keeper) sdk.Invariant
+		wantMsg   string
+	}{
+		{
+			"valid - empty state",
+			func(_ sdk.Context) {},
+			keeper.AllInvariants,
+			"",
+		},
+		{
+			"valid - fractional balances and remainder backed by reserve",
+			func(_ sdk.Context) {
+				s.MintToAccount(sdk.AccAddress{1}, cs(ci(types.ExtendedCoinDenom(), types.ConversionFactor().AddRaw(100))))
+				s.MintToAccount(sdk.AccAddress{2}, cs(c(types.ExtendedCoinDenom(), 50)))
+			},
+			keeper.AllInvariants,
+			"",
+		},
+		{
+			"valid - extended denom backed by reserve",
+			func(_ sdk.Context) {
+				s.setExtendedDenoms(usdcExtendedDenom)
+				s.MintToAccount(sdk.AccAddress{1}, cs(c(usdcExtendedDenom.ExtendedDenom, 100)))
+			},
+			keeper.AllInvariants,
+			"",
+		},
+		{
+			"broken - fractional balance not backed by reserve",
+			func(ctx sdk.Context) {
+				s.network.App.GetPreciseBankKeeper().SetFractionalBalance(ctx, sdk.AccAddress{1}, sdkmath.NewInt(100))
+			},
+			keeper.ReserveBacksFractionsInvariant,
+			"reserve balance 0 mismatches 100 (fractional balances 100 + remainder 0)",
+		},
+		{
+			"broken - extended denom remainder not backed by reserve",
+			func(ctx sdk.Context) {
+				s.setExtendedDenoms(usdcExtendedDenom)
+				s.network.App.GetPreciseBankKeeper().SetDenomRemainderAmount(ctx, usdcExtendedDenom, sdkmath.NewInt(100))
+			},
+			keeper.ReserveBacksFractionsInvariant,
+			"ausdc reserve balance 0 mismatches 100",
+		},
+		{
+			"broken - invalid fractional balance",
+			func(ctx sdk.Context) {
+				store := ctx.KVStore(s.network.App.GetKey(types.StoreKey))
+				bz, err := types.ConversionFactor().Marshal()
+				s.Require().NoError(err)
+				store.Set(append(types.FractionalBalancePrefix, types.FractionalBalanceKey(sdk.AccAddress{1})...), bz)
+			},
+			keeper.ValidFractionalAmountsInvariant,
+			"amount of invalid fractional balances found 1",
+		},
+		{
+			"broken - invalid remainder",
+			func(ctx sdk.Context) {
+				store := ctx.KVStore(s.network.App.GetKey(types.StoreKey))
+				bz, err := types.ConversionFactor().Marshal()
+				s.Require().NoError(err)
+				store.Set(types.RemainderBalanceKey, bz)
+			},
+			keeper.ValidRemainderAmountInvariant,
+			"remainder 1000000000000 is invalid",
+		},
+		{
+			"broken - extended denom in bank",
+			func(ctx sdk.Context) {
+				coins := cs(c(types.ExtendedCoinDenom(), 100))
+				s.Require().NoError(s.network.App.GetBankKeeper().MintCoins(ctx, evmtypes.ModuleName, coins))
+			},
+			keeper.FractionalDenomNotInBankInvariant,
+			"x/bank should not hold any",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.network.GetContext()
+
+			tc.malleate(ctx)
+
+			msg, broken := tc.invariant(*s.network.App.GetPreciseBankKeeper())(ctx)
+			if tc.wantMsg == "" {
+				s.Require().False(broken, msg)
+				return
+			}
+
+			s.Require().True(broken)
+			s.Require().Contains(msg, tc.wantMsg)
+		})
+	}
+}
@@ -53,23 +53,30 @@ func (s *KeeperIntegrationTestSuite) MintToModuleAccount(moduleName string, amt
 }
 
 // GetAllBalances returns all the account balances for the given account address.
-// This returns the extended coin balance if the account has a non-zero balance,
-// WITHOUT the integer coin balance.
+// This returns the extended coin balances if the account has a non-zero balance,
+// WITHOUT the integer coin balances.
 func (s *KeeperIntegrationTestSuite) GetAllBalances(addr sdk.AccAddress) sdk.Coins {
-	// Get all balances for an account
-	bankBalances := s.network.App.GetBankKeeper().GetAllBalances(s.network.GetContext(), addr)
+	ctx := s.network.GetContext()
+	pbk := s.network.App.GetPreciseBankKeeper()
 
-	// Remove integer coins from the balance
-	for _, coin := range bankBalances {
-		if coin.Denom == types.IntegerCoinDenom() {
-			bankBalances = bankBalances.Sub(coin)
+	// Get all balances for an account
+	bankBalances := s.network.App.GetBankKeeper().GetAllBalances(ctx, addr)
+
+	extendedDenoms := append(pbk.GetParams(ctx).ExtendedDenoms, types.EVMExtendedDenom())
+	for _, ed := range extendedDenoms {
+		// Remove integer coins from the balance
+		for _, coin := range bankBalances {
+			if coin.Denom == ed.IntegerDenom {
+				bankBalances = bankBalances.Sub(coin)
+			}
 		}
-	}
 
-	// Replace the integer coin with the extended coin, from x/precisebank
-	extendedBal := s.network.App.GetPreciseBankKeeper().GetBalance(s.network.GetContext(), addr, types.ExtendedCoinDenom())
+		// Replace the integer coin with the extended coin, from x/precisebank
+		extendedBal := pbk.GetBalance(ctx, addr, ed.ExtendedDenom)
+		bankBalances = bankBalances.Add(extendedBal)
+	}
 
-	return bankBalances.Add(extendedBal)
+	return bankBalances
 }
 
 // ConvertCoinsToExtendedCoinDenom converts sdk.Coins that includes Integer denoms
@@ -0,0 +1,135 @@
+package vm
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+
+	utiltx "github.com/cosmos/evm/testutil/tx"
+	"github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (s *KeeperTestSuite) TestDistributeFees() {
+	var (
+		gasUsed  = uint64(21000)
+		baseFee  = big.NewInt(1e9)
+		gasPrice = big.NewInt(15e8)
+		coinbase = utiltx.GenerateAddress()
+	)
+
+	testCases := []struct {
+		name            string
+		feeDistribution types.FeeDistribution
+		coinbase        common.Address
+		expBurnRatio    sdkmath.LegacyDec
+		expCommRatio    sdkmath.LegacyDec
+		expTip          bool
+	}{
+		{
+			"disabled - fees stay in the fee collector",
+			types.DefaultFeeDistribution(),
+			coinbase,
+			sdkmath.LegacyZeroDec(),
+			sdkmath.LegacyZeroDec(),
+			false,
+		},
+		{
+			"burn, community pool and proposer tip",
+			types.FeeDistribution{
+				BaseFeeBurnRatio:   sdkmath.LegacyNewDecWithPrec(5, 1),
+				CommunityPoolRatio: sdkmath.LegacyNewDecWithPrec(2, 1),
+				ProposerTips:       true,
+			},
+			coinbase,
+			sdkmath.LegacyNewDecWithPrec(5, 1),
+			sdkmath.LegacyNewDecWithPrec(2, 1),
+			true,
+		},
+		{
+			"proposer tips without coinbase stay in the fee collector",
+			types.FeeDistribution{
+				BaseFeeBurnRatio:   sdkmath.LegacyOneDec(),
+				CommunityPoolRatio: sdkmath.LegacyZeroDec(),
+				ProposerTips:       true,
+			},
+			common.Address{},
+			sdkmath.LegacyOneDec(),
+			sdkmath.LegacyZeroDec(),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.Network.GetContext()
+			evmKeeper := s.Network.App.GetEVMKeeper()
+			bankKeeper := s.Network.App.GetBankKeeper()
+
+			params := evmKeeper.GetParams(ctx)
+			params.FeeDistribution = tc.feeDistribution
+			s.Require().NoError(evmKeeper.SetParams(ctx, params))
+
+			// fund the fee collector as the ante handler would
+			fees := sdk.NewCoins(sdk.NewCoin(types.GetEVMCoinDenom(), sdkmath.NewInt(1e18)))
+			s.Require().NoError(bankKeeper.MintCoins(ctx, minttypes.ModuleName, fees))
+			s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, authtypes.FeeCollectorName, fees))
+
+			feeCollector := common.BytesToAddress(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+			feeCollectorBefore := evmKeeper.GetBalance(ctx, feeCollector).ToBig()
+			poolBefore, err := s.Network.App.GetDistrKeeper().FeePool.Get(ctx)
+			s.Require().NoError(err)
+
+			msg := core.Message{GasPrice: gasPrice}
+			err = evmKeeper.DistributeFees(ctx, msg, gasUsed, baseFee, tc.coinbase)
+			s.Require().NoError(err)
+
+			baseFeeAmt := sdkmath.LegacyNewDecFromBigInt(new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), baseFee))
+			expBurn := baseFeeAmt.Mul(tc.expBurnRatio).TruncateInt()
+			conversionFactor := types.GetEVMCoinDecimals().ConversionFactor()
+			expCommunity := baseFeeAmt.Mul(tc.expCommRatio).TruncateInt().Quo(conversionFactor)
+			expTip := sdkmath.ZeroInt()
+			if tc.expTip {
+				expTip = sdkmath.NewIntFromBigInt(new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), new(big.Int).Sub(gasPrice, baseFee)))
+			}
+
+			s.Require().Equal(expTip.String(), evmKeeper.GetBalance(ctx, tc.coinbase).String())
+
+			poolAfter, err := s.Network.App.GetDistrKeeper().FeePool.Get(ctx)
+			s.Require().NoError(err)
+			poolDiff := poolAfter.CommunityPool.AmountOf(types.GetEVMCoinDenom()).Sub(poolBefore.CommunityPool.AmountOf(types.GetEVMCoinDenom()))
+			s.Require().Equal(sdkmath.LegacyNewDecFromInt(expCommunity), poolDiff)
+
+			expSpent := expBurn.Add(expCommunity.Mul(conversionFactor)).Add(expTip)
+			feeCollectorAfter := evmKeeper.GetBalance(ctx, feeCollector).ToBig()
+			s.Require().Equal(expSpent.String(), new(big.Int).Sub(feeCollectorBefore, feeCollectorAfter).String())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDistributeFeesVirtualFeeCollection() {
+	s.SetupTest()
+	ctx := s.Network.GetContext()
+	evmKeeper := s.Network.App.GetEVMKeeper()
+
+	params := evmKeeper.GetParams(ctx)
+	params.FeeDistribution.ProposerTips = true
+	s.Require().NoError(evmKeeper.SetParams(ctx, params))
+
+	evmKeeper.EnableVirtualFeeCollection()
+	defer types.SetVirtualFeeCollection(false)
+
+	msg := core.Message{GasPrice: big.NewInt(15e8)}
+	err := evmKeeper.DistributeFees(ctx, msg, 21000, big.NewInt(1e9), utiltx.GenerateAddress())
+	s.Require().ErrorIs(err, types.ErrInvalidFeeDistribution)
+
+	// the params can't enable the fee distribution either
+	s.Require().ErrorIs(evmKeeper.SetParams(ctx, params), types.ErrInvalidFeeDistribution)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -382,6 +383,76 @@ func (s *KeeperTestSuite) TestQueryCode() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryCodeDelegation() {
+	testCases := []struct {
+		msg           string
+		getReqAndResp func() (*types.QueryCodeDelegationRequest, *types.QueryCodeDelegationResponse)
+		expPass       bool
+	}{
+		{
+			"invalid address",
+			func() (*types.QueryCodeDelegationRequest, *types.QueryCodeDelegationResponse) {
+				return &types.QueryCodeDelegationRequest{
+					Address: invalidAddress,
+				}, nil
+			},
+			false,
+		},
+		{
+			"success - not delegated",
+			func() (*types.QueryCodeDelegationRequest, *types.QueryCodeDelegationResponse) {
+				newIndex := s.Keyring.AddKey()
+				addr := s.Keyring.GetAddr(newIndex)
+
+				stateDB := s.Network.GetStateDB()
+				stateDB.SetCode(addr, []byte("code"))
+				s.Require().NoError(stateDB.Commit())
+
+				return &types.QueryCodeDelegationRequest{
+					Address: addr.String(),
+				}, &types.QueryCodeDelegationResponse{}
+			},
+			true,
+		},
+		{
+			"success - delegated",
+			func() (*types.QueryCodeDelegationRequest, *types.QueryCodeDelegationResponse) {
+				newIndex := s.Keyring.AddKey()
+				addr := s.Keyring.GetAddr(newIndex)
+				target := tx.GenerateAddress()
+
+				stateDB := s.Network.GetStateDB()
+				stateDB.SetCode(addr, ethtypes.AddressToDelegation(target))
+				s.Require().NoError(stateDB.Commit())
+
+				return &types.QueryCodeDelegationRequest{
+					Address: addr.String(),
+				}, &types.QueryCodeDelegationResponse{
+					Delegated: true,
+					Address:   target.Hex(),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			req, expectedResponse := tc.getReqAndResp()
+
+			ctx := s.Network.GetContext()
+			res, err := s.Network.GetEvmClient().CodeDelegation(ctx, req)
+
+			s.Require().Equal(expectedResponse, res)
+			if tc.expPass {
+				s.Require().NoError(err)
+			} else {
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TODO: Fix this one
 func (s *KeeperTestSuite) TestQueryTxLogs() {
 	expLogs := []*types.Log{}
@@ -161,6 +161,125 @@ func (s *KeeperTestSuite) TestEthereumTx() {
 	s.EnableFeemarket = false
 }
 
+func (s *KeeperTestSuite) TestEthereumTxBatch() {
+	amount := big.NewInt(1e18)
+
+	testCases := []struct {
+		name         string
+		maxBatchMsgs uint64
+		secondInput  []byte
+		sameNonce    bool
+		expErr       string
+		expExecuted  bool
+		expNonceIncr uint64
+	}{
+		{
+			name:         "fail - batching disabled",
+			maxBatchMsgs: 0,
+			expErr:       "expected 1 message, got 2",
+		},
+		{
+			name:         "fail - nonces are not consecutive",
+			maxBatchMsgs: 2,
+			sameNonce:    true,
+			expErr:       "invalid nonce",
+		},
+		{
+			name:         "success - batch executed",
+			maxBatchMsgs: 2,
+			expExecuted:  true,
+			expNonceIncr: 2,
+		},
+		{
+			name:         "fail - failed tx reverts the batch",
+			maxBatchMsgs: 2,
+			// the INVALID opcode makes the contract creation fail
+			secondInput: []byte{0xfe},
+			expErr:      types.ErrBatchReverted.Error(),
+			// the fees and nonces are kept when the batch is reverted
+			expNonceIncr: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			params := s.Network.App.GetEVMKeeper().GetParams(s.Network.GetContext())
+			params.MaxBatchMsgs = tc.maxBatchMsgs
+			err := utils.UpdateEvmParams(utils.UpdateParamsInput{
+				Tf:      s.Factory,
+				Network: s.Network,
+				Pk:      s.Keyring.GetPrivKey(0),
+				Params:  params,
+			})
+			s.Require().NoError(err)
+
+			sender := s.Keyring.GetKey(0)
+			recipient := s.Keyring.GetAddr(1)
+			ctx := s.Network.GetContext()
+			nonce := s.Network.App.GetEVMKeeper().GetNonce(ctx, sender.Addr)
+			balance := s.Network.App.GetEVMKeeper().GetBalance(ctx, recipient)
+
+			first, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, types.EvmTxArgs{
+				To:       &recipient,
+				Amount:   amount,
+				Nonce:    nonce,
+				GasLimit: 100_000,
+			})
+			s.Require().NoError(err)
+
+			secondArgs := types.EvmTxArgs{
+				Nonce:    nonce + 1,
+				GasLimit: 100_000,
+				Input:    tc.secondInput,
+			}
+			if tc.sameNonce {
+				secondArgs.Nonce = nonce
+			}
+			if tc.secondInput == nil {
+				secondArgs.To = &recipient
+				secondArgs.Amount = amount
+			}
+			second, err := s.Factory.GenerateSignedMsgEthereumTx(sender.Priv, secondArgs)
+			s.Require().NoError(err)
+
+			txConfig := s.Network.GetEncodingConfig().TxConfig
+			tx, err := types.BuildBatchTx(txConfig.NewTxBuilder(), s.Network.GetBaseDenom(), &first, &second)
+			s.Require().NoError(err)
+			txBytes, err := txConfig.TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			res, err := s.Network.NextBlockWithTxs(txBytes)
+			s.Require().NoError(err)
+			s.Require().Len(res.TxResults, 1)
+			txRes := res.TxResults[0]
+			if tc.expErr == "" {
+				s.Require().Zero(txRes.Code, txRes.Log)
+			} else {
+				s.Require().NotZero(txRes.Code)
+				s.Require().Contains(txRes.Log, tc.expErr)
+				if tc.expErr == types.ErrBatchReverted.Error() {
+					// the JSON-RPC relies on the code to return the receipts of the batch
+					s.Require().Equal(types.ModuleName, txRes.Codespace)
+					s.Require().Equal(types.ErrBatchReverted.ABCICode(), txRes.Code)
+				}
+			}
+
+			ctx = s.Network.GetContext()
+			newBalance := s.Network.App.GetEVMKeeper().GetBalance(ctx, recipient)
+			newNonce := s.Network.App.GetEVMKeeper().GetNonce(ctx, sender.Addr)
+			s.Require().Equal(nonce+tc.expNonceIncr, newNonce)
+			if tc.expExecuted {
+				expBalance := new(big.Int).Add(balance.ToBig(), new(big.Int).Mul(amount, big.NewInt(2)))
+				s.Require().Equal(expBalance, newBalance.ToBig())
+			} else {
+				s.Require().Equal(balance, newBalance)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestUpdateParams() {
 	s.SetupTest()
 	testCases := []struct {
@@ -0,0 +1,111 @@
+package ibctesting
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm"
+	"github.com/cosmos/evm/precompiles/ics20"
+	testutiltypes "github.com/cosmos/evm/testutil/types"
+	ibctestutil "github.com/cosmos/evm/x/ibc/callbacks/testutil"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// TransferV2 sends the given amount of the denom from the sender account with
+// the given index through the ICS20 precompile of the endpoint chain, using the
+// IBC v2 route identified by the endpoint client. The packet times out one hour
+// after the current block time. The sent packet is returned.
+func (endpoint *Endpoint) TransferV2(
+	precompile *ics20.Precompile,
+	senderIdx int,
+	denom string,
+	amount math.Int,
+	receiver, memo string,
+) (channeltypesv2.Packet, error) {
+	chain := endpoint.Chain
+	senderAccount := chain.SenderAccounts[senderIdx]
+	timeoutTimestamp := uint64(chain.GetContext().BlockTime().Add(time.Hour).Unix()) //nolint:gosec // G115
+
+	data, err := precompile.Pack(ics20.TransferV2Method,
+		endpoint.ClientID,
+		denom,
+		amount.BigInt(),
+		common.BytesToAddress(senderAccount.SenderAccount.GetAddress().Bytes()),
+		receiver,
+		timeoutTimestamp,
+		memo,
+	)
+	require.NoError(chain.TB, err)
+
+	res, _, ethRes, err := chain.SendEvmTx(senderAccount, senderIdx, precompile.Address(), big.NewInt(0), data, 0)
+	if err != nil {
+		if ethRes != nil && ethRes.Failed() {
+			return channeltypesv2.Packet{}, evmtypes.NewExecErrorWithReason(ethRes.Ret)
+		}
+		return channeltypesv2.Packet{}, err
+	}
+
+	packets, err := endpoint.ParseV2PacketFromEvent(res.Events)
+	if err != nil {
+		return channeltypesv2.Packet{}, err
+	}
+	require.Len(chain.TB, packets, 1)
+	return packets[0], nil
+}
+
+// DeployContract deploys the contract with the given deployment data from the
+// default sender of the chain and returns its address.
+func (chain *TestChain) DeployContract(deploymentData testutiltypes.ContractDeploymentData) (common.Address, error) {
+	app, ok := chain.App.(evm.EvmApp)
+	require.True(chain.TB, ok)
+
+	// Get account's nonce to create contract hash
+	from := common.BytesToAddress(chain.SenderPrivKey.PubKey().Address().Bytes())
+	account := app.GetEVMKeeper().GetAccount(chain.GetContext(), from)
+	if account == nil {
+		return common.Address{}, errors.New("account not found")
+	}
+
+	ctorArgs, err := deploymentData.Contract.ABI.Pack("", deploymentData.ConstructorArgs...)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrap(err, "failed to pack constructor arguments")
+	}
+
+	data := deploymentData.Contract.Bin
+	data = append(data, ctorArgs...)
+
+	_, err = app.GetEVMKeeper().CallEVMWithData(chain.GetContext(), from, nil, data, true, nil)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(err, "failed to deploy contract")
+	}
+
+	return crypto.CreateAddress(from, account.Nonce), nil
+}
+
+// DeployCounterWithCallbacks deploys the callbacks counter contract from the
+// default sender of the chain and returns the contract with its address.
+func (chain *TestChain) DeployCounterWithCallbacks() (evmtypes.CompiledContract, common.Address) {
+	contractData, err := ibctestutil.LoadCounterWithCallbacksContract()
+	require.NoError(chain.TB, err)
+
+	contractAddr, err := chain.DeployContract(testutiltypes.ContractDeploymentData{
+		Contract:        contractData,
+		ConstructorArgs: nil,
+	})
+	require.NoError(chain.TB, err)
+
+	// the deployment increments the nonce of the default sender used for relaying
+	err = chain.SenderAccount.SetSequence(chain.SenderAccount.GetSequence() + 1)
+	require.NoError(chain.TB, err)
+
+	return contractData, contractAddr
+}
@@ -118,7 +118,7 @@ func getAccAddrsFromBalances(balances []banktypes.Balance) []sdktypes.AccAddress
 	numberOfBalances := len(balances)
 	genAccounts := make([]sdktypes.AccAddress, 0, numberOfBalances)
 	for _, balance := range balances {
-		genAccounts = append(genAccounts, sdktypes.AccAddress(balance.Address))
+		genAccounts = append(genAccounts, sdktypes.MustAccAddressFromBech32(balance.Address))
 	}
 	return genAccounts
 }
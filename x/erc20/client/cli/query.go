@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
 		GetParamsCmd(),
+		GetRateLimitsCmd(),
 	)
 	return cmd
 }
@@ -128,3 +129,36 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetRateLimitsCmd queries the IBC rate limits with their current usage
+func GetRateLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits [channel-id]",
+		Short: "Gets the IBC rate limits with their current usage",
+		Long:  "Gets the IBC rate limits with their usage over the current rolling window, optionally restricted to an IBC channel or client",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitsRequest{}
+			if len(args) > 0 {
+				req.ChannelId = args[0]
+			}
+
+			res, err := queryClient.RateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -50,12 +50,32 @@ func NewIBCMiddleware(k erc20types.Erc20Keeper, app porttypes.IBCModule) IBCMidd
 // If the acknowledgement fails, this callback will default to the ibc-core
 // packet callback.
 // If conversion fails, then the user will receive the bank token instead.
+// If the packet memo contains packet-forward-middleware style forwarding
+// instructions, the tokens are forwarded to the next chain instead of being
+// converted.
+// The packet is rejected with an error acknowledgement if the received tokens
+// exceed an inflow rate limit of the destination channel.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	channelVersion string,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+		if err := im.keeper.UpdateRecvPacketInflow(ctx, packet, data); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+
+		metadata, found, err := erc20types.ParseForwardMetadata(data.Memo)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		if found {
+			return im.onRecvForwardPacket(ctx, channelVersion, packet, data, metadata, relayer)
+		}
+	}
+
 	ack := im.Module.OnRecvPacket(ctx, channelVersion, packet, relayer)
 
 	// return if the acknowledgement is an error ACK
@@ -66,6 +86,34 @@ func (im IBCMiddleware) OnRecvPacket(
 	return im.keeper.OnRecvPacket(ctx, packet, ack)
 }
 
+// onRecvForwardPacket receives the tokens of a packet to forward on an
+// intermediate account derived from the packet, in place of its receiver, and
+// forwards them to the next chain. The packet is acknowledged asynchronously,
+// once the forwarded packet is acknowledged or timed out.
+func (im IBCMiddleware) onRecvForwardPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	metadata erc20types.ForwardMetadata,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	forwarder := erc20types.GetForwardReceiver(packet.DestinationChannel, data.Sender)
+
+	overrideData := data
+	overrideData.Receiver = forwarder.String()
+	overrideData.Memo = ""
+	overridePacket := packet
+	overridePacket.Data = overrideData.GetBytes()
+
+	ack := im.Module.OnRecvPacket(ctx, channelVersion, overridePacket, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	return im.keeper.ForwardPacket(ctx, packet, data, forwarder, metadata)
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface.
 // It refunds the token transferred and then automatically converts the
 // Cosmos Coin to their ERC20 token representation.
@@ -162,6 +162,15 @@ func (k Keeper) IterateAllowances(
 	}
 }
 
+// HasAllowances returns true if there is any allowance stored on the given erc20 precompile address.
+func (k Keeper) HasAllowances(ctx sdk.Context, erc20 common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
+	iterator := storetypes.KVStorePrefixIterator(store, erc20.Bytes())
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
 func (k Keeper) deleteAllowances(ctx sdk.Context, erc20 common.Address) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAllowance)
 	iterator := storetypes.KVStorePrefixIterator(store, erc20.Bytes())
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/evm/x/erc20/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithFeeTokenPriceOracle sets the price source used for the fee tokens that
+// don't have a fixed price in the params.
+func (k *Keeper) WithFeeTokenPriceOracle(oracle types.FeeTokenPriceOracle) *Keeper {
+	k.feeTokenPriceOracle = oracle
+	return k
+}
+
+// GetFeeTokens returns the whitelisted fee tokens in order of preference.
+func (k Keeper) GetFeeTokens(ctx sdk.Context) []types.FeeToken {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixFeeToken)
+	defer iterator.Close()
+
+	var feeTokens []types.FeeToken
+	for ; iterator.Valid(); iterator.Next() {
+		var feeToken types.FeeToken
+		k.cdc.MustUnmarshal(iterator.Value(), &feeToken)
+		feeTokens = append(feeTokens, feeToken)
+	}
+	return feeTokens
+}
+
+// setFeeTokens replaces the whitelisted fee tokens, keeping the given order.
+func (k Keeper) setFeeTokens(ctx sdk.Context, feeTokens []types.FeeToken) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeToken)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for i, feeToken := range feeTokens {
+		store.Set(sdk.Uint64ToBigEndian(uint64(i)), k.cdc.MustMarshal(&feeToken)) //nolint:gosec // G115
+	}
+}
+
+// validateFeeTokenPair checks that the token pair of the given fee token, if
+// registered, is not a native ERC20 token. The fees are deducted from the bank
+// balance of the payer, while the balances of native ERC20 tokens are held by
+// their contract.
+func (k Keeper) validateFeeTokenPair(ctx sdk.Context, denom string) error {
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, denom))
+	if found && pair.IsNativeERC20() {
+		return errorsmod.Wrapf(types.ErrInvalidFeeToken, "fee token %s cannot be a native ERC20 token", denom)
+	}
+	return nil
+}
+
+// GetFeeTokenPrice returns the amount of the EVM denom, in its original decimals,
+// that is equivalent to one unit of the given fee token. It fails if the denom
+// is not whitelisted, if its token pair is not registered or disabled, or if no
+// price is available.
+func (k Keeper) GetFeeTokenPrice(ctx sdk.Context, denom string) (math.LegacyDec, error) {
+	var (
+		feeToken types.FeeToken
+		found    bool
+	)
+	for _, ft := range k.GetFeeTokens(ctx) {
+		if ft.Denom == denom {
+			feeToken, found = ft, true
+			break
+		}
+	}
+	if !found {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "%s is not a whitelisted fee token", denom)
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, denom))
+	if !found {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "fee token %s", denom)
+	}
+	if !pair.Enabled {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrERC20TokenPairDisabled, "fee token %s", denom)
+	}
+	if pair.IsNativeERC20() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "fee token %s cannot be a native ERC20 token", denom)
+	}
+
+	if feeToken.HasFixedPrice() {
+		return feeToken.Price, nil
+	}
+
+	if k.feeTokenPriceOracle == nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "no price source for fee token %s", denom)
+	}
+
+	price, err := k.feeTokenPriceOracle.GetFeeTokenPrice(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(err, "failed to get price for fee token %s", denom)
+	}
+	if price.IsNil() || !price.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "invalid price for fee token %s: %s", denom, price)
+	}
+
+	return price, nil
+}
+
+// GetFeeTokenPayment returns the first whitelisted fee token that the payer can
+// use to pay for the given fees, expressed in the EVM denom with 18 decimals.
+// Fee tokens without an available price are skipped.
+func (k Keeper) GetFeeTokenPayment(ctx sdk.Context, payer sdk.AccAddress, fees *big.Int) (evmtypes.FeeTokenPayment, bool) {
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		price, err := k.GetFeeTokenPrice(ctx, feeToken.Denom)
+		if err != nil {
+			continue
+		}
+
+		payment := evmtypes.FeeTokenPayment{Denom: feeToken.Denom, Price: price}
+		amount := payment.ConvertFrom18Decimals(fees, true)
+		if k.bankKeeper.SpendableCoin(ctx, payer, feeToken.Denom).Amount.GTE(amount) {
+			return payment, true
+		}
+	}
+
+	return evmtypes.FeeTokenPayment{}, false
+}
@@ -84,3 +84,32 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// RateLimits returns the IBC rate limits, optionally restricted to a channel or
+// client, with their usage over the current rolling window
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var rateLimits []types.RateLimit
+	if req.ChannelId != "" {
+		rateLimits = k.GetChannelRateLimits(ctx, req.ChannelId)
+	} else {
+		rateLimits = k.GetRateLimits(ctx)
+	}
+
+	usages := make([]types.RateLimitUsage, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		inflow, outflow := k.GetRateLimitUsage(ctx, rateLimit)
+		usages = append(usages, types.RateLimitUsage{
+			RateLimit: rateLimit,
+			Inflow:    inflow,
+			Outflow:   outflow,
+		})
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: usages}, nil
+}
@@ -162,11 +162,25 @@ func (k Keeper) OnRecvPacket(
 // a self-destructed ERC20 contract or an invalid function, OnAcknowledgementPacket
 // still succeeds, but the user receives the corresponding bank token from the
 // TokenPair instead. A user may then manually re-attempt the conversion.
+// If the packet was sent to forward the tokens of a received packet, the
+// received packet is acknowledged instead.
+// The refunded tokens of a failed acknowledgement are removed from the outflow
+// of the rate limits of the source channel.
 func (k Keeper) OnAcknowledgementPacket(
-	ctx sdk.Context, _ channeltypes.Packet,
+	ctx sdk.Context, packet channeltypes.Packet,
 	data transfertypes.FungibleTokenPacketData,
 	ack channeltypes.Acknowledgement,
 ) error {
+	if !ack.Success() {
+		// the sent tokens are refunded
+		k.revertRefundedPacketOutflow(ctx, packet, data)
+	}
+
+	if inFlight, found := k.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence); found {
+		k.DeleteInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		return k.onForwardedPacketAcknowledgement(ctx, inFlight, ack)
+	}
+
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
 		// convert the token from Cosmos Coin to its ERC20 representation
@@ -184,7 +198,19 @@ func (k Keeper) OnAcknowledgementPacket(
 // a self-destructed ERC20 contract or an invalid function, OnTimeoutPacket still
 // succeeds, but the user receives the corresponding bank token from the TokenPair
 // instead. A user may then manually re-attempt the conversion.
-func (k Keeper) OnTimeoutPacket(ctx sdk.Context, _ channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+// If the packet was sent to forward the tokens of a received packet, it is
+// resent or the received packet is acknowledged instead.
+// The refunded tokens are removed from the outflow of the rate limits of the
+// source channel.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	// the sent tokens are refunded
+	k.revertRefundedPacketOutflow(ctx, packet, data)
+
+	if inFlight, found := k.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence); found {
+		k.DeleteInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+		return k.onForwardedPacketTimeout(ctx, inFlight)
+	}
+
 	return k.ConvertCoinToERC20FromPacket(ctx, data)
 }
 
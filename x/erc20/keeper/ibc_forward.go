@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ForwardPacket forwards the tokens of a received ICS20 packet that carries a
+// packet-forward-middleware style `forward` memo. The tokens must have been
+// received by the forwarder account, and are forwarded in their Cosmos coin
+// representation, so they are not converted to ERC20 on this chain.
+//
+// A nil acknowledgement is returned on success, as the received packet is
+// acknowledged once the forwarded packet is acknowledged or timed out. If the
+// tokens cannot be forwarded, an error acknowledgement is returned so that the
+// sender is refunded on the previous chain.
+func (k Keeper) ForwardPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	forwarder sdk.AccAddress,
+	metadata types.ForwardMetadata,
+) exported.Acknowledgement {
+	token := transfertypes.Token{
+		Denom:  transfertypes.ExtractDenomFromPath(data.Denom),
+		Amount: data.Amount,
+	}
+
+	inFlight := types.InFlightPacket{
+		Packet:           packet,
+		Forwarder:        forwarder.String(),
+		Token:            ibc.GetReceivedCoin(packet, token),
+		Metadata:         metadata,
+		RetriesRemaining: metadata.GetRetries(),
+	}
+
+	if err := k.forwardInFlightPacket(ctx, inFlight); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return nil
+}
+
+// forwardInFlightPacket sends the tokens of the in-flight packet to the next
+// chain and stores the packet until the forwarded one is acknowledged.
+func (k Keeper) forwardInFlightPacket(ctx sdk.Context, inFlight types.InFlightPacket) error {
+	memo, err := inFlight.Metadata.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	timeout := ctx.BlockTime().Add(inFlight.Metadata.GetTimeout())
+	msg := transfertypes.NewMsgTransfer(
+		inFlight.Metadata.Port,
+		inFlight.Metadata.Channel,
+		inFlight.Token,
+		inFlight.Forwarder,
+		inFlight.Metadata.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(timeout.UnixNano()), //nolint:gosec // G115 -- block time is after the unix epoch
+		memo,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidForwardMetadata, err.Error())
+	}
+
+	res, err := k.transferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	k.SetInFlightPacket(ctx, msg.SourcePort, msg.SourceChannel, res.Sequence, inFlight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardPacket,
+			sdk.NewAttribute(types.AttributeCoinSourceChannel, inFlight.Packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, msg.SourcePort),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, msg.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyForwardSeq, strconv.FormatUint(res.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, inFlight.Token.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+		),
+	)
+
+	return nil
+}
+
+// onForwardedPacketAcknowledgement acknowledges the received packet once its
+// forwarded packet is acknowledged. On an error acknowledgement, the tokens
+// refunded to the forwarder are returned to the previous chain.
+func (k Keeper) onForwardedPacketAcknowledgement(
+	ctx sdk.Context,
+	inFlight types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	if !ack.Success() {
+		return k.refundInFlightPacket(ctx, inFlight, ack.GetError())
+	}
+
+	return k.writeInFlightAcknowledgement(ctx, inFlight, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+}
+
+// onForwardedPacketTimeout resends the forwarded packet if retries remain, and
+// otherwise returns the tokens refunded to the forwarder to the previous chain.
+func (k Keeper) onForwardedPacketTimeout(ctx sdk.Context, inFlight types.InFlightPacket) error {
+	if inFlight.RetriesRemaining > 0 {
+		inFlight.RetriesRemaining--
+		err := k.forwardInFlightPacket(ctx, inFlight)
+		if err == nil {
+			return nil
+		}
+		return k.refundInFlightPacket(ctx, inFlight, err.Error())
+	}
+
+	return k.refundInFlightPacket(ctx, inFlight, "forwarded packet timed out")
+}
+
+// refundInFlightPacket reverts the receipt of the in-flight packet tokens, so
+// that they can be refunded on the previous chain, and writes an error
+// acknowledgement for the received packet.
+func (k Keeper) refundInFlightPacket(ctx sdk.Context, inFlight types.InFlightPacket, reason string) error {
+	forwarderBz, err := k.addrCodec.StringToBytes(inFlight.Forwarder)
+	if err != nil {
+		return err
+	}
+	forwarder := sdk.AccAddress(forwarderBz)
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlight.Packet.GetData(), &data); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidIBC, "cannot unmarshal ICS-20 transfer packet data: %s", err)
+	}
+
+	packet := inFlight.Packet
+	if transfertypes.ExtractDenomFromPath(data.Denom).HasPrefix(packet.SourcePort, packet.SourceChannel) {
+		// the tokens were unescrowed when received, so they are escrowed back
+		escrowAddress := transfertypes.GetEscrowAddress(packet.DestinationPort, packet.DestinationChannel)
+		if err := k.transferKeeper.EscrowCoin(ctx, forwarder, escrowAddress, inFlight.Token); err != nil {
+			return err
+		}
+	} else {
+		// the vouchers were minted when received, so they are burned
+		coins := sdk.NewCoins(inFlight.Token)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, forwarder, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardPacketRefund,
+			sdk.NewAttribute(types.AttributeCoinSourceChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, inFlight.Token.String()),
+			sdk.NewAttribute(types.AttributeKeyError, reason),
+		),
+	)
+
+	return k.writeInFlightAcknowledgement(
+		ctx,
+		inFlight,
+		channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrForwardFailed, reason)),
+	)
+}
+
+// writeInFlightAcknowledgement writes the asynchronous acknowledgement of the
+// received packet.
+func (k Keeper) writeInFlightAcknowledgement(
+	ctx sdk.Context,
+	inFlight types.InFlightPacket,
+	ack exported.Acknowledgement,
+) error {
+	return k.transferKeeper.GetICS4Wrapper().WriteAcknowledgement(ctx, inFlight.Packet, ack)
+}
+
+// GetInFlightPacket returns the in-flight packet forwarded with the given port,
+// channel and sequence.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	bz := store.Get(types.InFlightPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var inFlight types.InFlightPacket
+	if err := json.Unmarshal(bz, &inFlight); err != nil {
+		// NOTE: shouldn't happen as the in-flight packets are only stored by this module
+		panic(err)
+	}
+	return inFlight, true
+}
+
+// SetInFlightPacket stores the in-flight packet forwarded with the given port,
+// channel and sequence.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64, inFlight types.InFlightPacket) {
+	bz, err := json.Marshal(inFlight)
+	if err != nil {
+		panic(err)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	store.Set(types.InFlightPacketKey(portID, channelID, sequence), bz)
+}
+
+// DeleteInFlightPacket removes the in-flight packet forwarded with the given
+// port, channel and sequence.
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	store.Delete(types.InFlightPacketKey(portID, channelID, sequence))
+}
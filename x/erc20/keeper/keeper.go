@@ -27,6 +27,8 @@ type Keeper struct {
 	evmKeeper      types.EVMKeeper
 	stakingKeeper  types.StakingKeeper
 	transferKeeper *transferkeeper.Keeper
+	// optional price source for the fee tokens without a fixed price
+	feeTokenPriceOracle types.FeeTokenPriceOracle
 }
 
 // NewKeeper creates new instances of the erc20 Keeper
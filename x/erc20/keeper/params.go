@@ -12,13 +12,28 @@ var isTrue = []byte("0x01")
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	permissionlessRegistration := k.isPermissionlessRegistration(ctx)
-	return types.NewParams(enableErc20, permissionlessRegistration)
+	params = types.NewParams(enableErc20, permissionlessRegistration)
+	params.FeeTokens = k.GetFeeTokens(ctx)
+	params.RateLimits = k.GetRateLimits(ctx)
+	return params
 }
 
 // SetParams sets the erc20 parameters to the param space.
 func (k Keeper) SetParams(ctx sdk.Context, newParams types.Params) error {
+	if err := newParams.Validate(); err != nil {
+		return err
+	}
+
+	for _, feeToken := range newParams.FeeTokens {
+		if err := k.validateFeeTokenPair(ctx, feeToken.Denom); err != nil {
+			return err
+		}
+	}
+
 	k.setERC20Enabled(ctx, newParams.EnableErc20)
 	k.SetPermissionlessRegistration(ctx, newParams.PermissionlessRegistration)
+	k.setFeeTokens(ctx, newParams.FeeTokens)
+	k.setRateLimits(ctx, newParams.RateLimits)
 	return nil
 }
 
@@ -0,0 +1,222 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/evm/ibc"
+	"github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetRateLimits returns the rate limits of the IBC transfers.
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	return k.getRateLimits(ctx, types.KeyPrefixRateLimit)
+}
+
+// GetChannelRateLimits returns the rate limits of the IBC transfers through the
+// given channel or client.
+func (k Keeper) GetChannelRateLimits(ctx sdk.Context, channelID string) []types.RateLimit {
+	return k.getRateLimits(ctx, append(types.KeyPrefixRateLimit, types.RateLimitChannelPrefix(channelID)...))
+}
+
+func (k Keeper) getRateLimits(ctx sdk.Context, keyPrefix []byte) []types.RateLimit {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	var rateLimits []types.RateLimit
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	return rateLimits
+}
+
+// setRateLimits replaces the rate limits. The flows of the removed rate limits
+// are deleted, while the flows of the kept ones are preserved.
+func (k Keeper) setRateLimits(ctx sdk.Context, rateLimits []types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	flowStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimitFlow)
+
+	kept := make(map[string]bool, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		kept[string(types.RateLimitKey(rateLimit.ChannelId, rateLimit.Denom))] = true
+	}
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		if !kept[string(key)] {
+			flowStore.Delete(key)
+		}
+	}
+
+	for _, rateLimit := range rateLimits {
+		store.Set(types.RateLimitKey(rateLimit.ChannelId, rateLimit.Denom), k.cdc.MustMarshal(&rateLimit))
+	}
+}
+
+// GetRateLimitFlow returns the flow of the rate limit of the given channel and
+// denom, as last recorded.
+func (k Keeper) GetRateLimitFlow(ctx sdk.Context, channelID, denom string) (types.RateLimitFlow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimitFlow)
+	bz := store.Get(types.RateLimitKey(channelID, denom))
+	if bz == nil {
+		return types.RateLimitFlow{}, false
+	}
+
+	var flow types.RateLimitFlow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// setRateLimitFlow sets the flow of the rate limit of the given channel and denom.
+func (k Keeper) setRateLimitFlow(ctx sdk.Context, channelID, denom string, flow types.RateLimitFlow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimitFlow)
+	store.Set(types.RateLimitKey(channelID, denom), k.cdc.MustMarshal(&flow))
+}
+
+// currentRateLimitFlow returns the flow of the rate limit advanced to the
+// current block time.
+func (k Keeper) currentRateLimitFlow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitFlow {
+	flow, found := k.GetRateLimitFlow(ctx, rateLimit.ChannelId, rateLimit.Denom)
+	if !found {
+		return types.NewRateLimitFlow(ctx.BlockTime())
+	}
+	return flow.Advance(ctx.BlockTime(), rateLimit.Window)
+}
+
+// GetRateLimitUsage returns the amounts received and sent over the current
+// rolling window of the rate limit.
+func (k Keeper) GetRateLimitUsage(ctx sdk.Context, rateLimit types.RateLimit) (inflow, outflow math.Int) {
+	return k.currentRateLimitFlow(ctx, rateLimit).Usage(ctx.BlockTime(), rateLimit.Window)
+}
+
+// UpdateRateLimitInflow records the given amount of the denom received through
+// the channel or client in the rate limits that apply to it. It fails, without
+// recording the amount, if it exceeds the inflow quota of a rate limit.
+func (k Keeper) UpdateRateLimitInflow(ctx sdk.Context, channelID, denom string, amount math.Int) error {
+	return k.updateRateLimitFlows(ctx, channelID, denom, amount, types.FlowInflow)
+}
+
+// UpdateRateLimitOutflow records the given amount of the denom sent through the
+// channel or client in the rate limits that apply to it. It fails, without
+// recording the amount, if it exceeds the outflow quota of a rate limit.
+func (k Keeper) UpdateRateLimitOutflow(ctx sdk.Context, channelID, denom string, amount math.Int) error {
+	return k.updateRateLimitFlows(ctx, channelID, denom, amount, types.FlowOutflow)
+}
+
+// RevertRateLimitOutflow removes the given amount of the denom from the outflow
+// of the current window of the rate limits that apply to it, for the sent
+// tokens that are refunded.
+func (k Keeper) RevertRateLimitOutflow(ctx sdk.Context, channelID, denom string, amount math.Int) {
+	for _, rateLimit := range k.matchingRateLimits(ctx, channelID, denom) {
+		flow := k.currentRateLimitFlow(ctx, rateLimit)
+		flow.Outflow = math.MaxInt(flow.Outflow.Sub(amount), math.ZeroInt())
+		k.setRateLimitFlow(ctx, rateLimit.ChannelId, rateLimit.Denom, flow)
+	}
+}
+
+// UpdateRecvPacketInflow records the tokens received with the packet in the
+// rate limits of its destination channel or client.
+func (k Keeper) UpdateRecvPacketInflow(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	token := transfertypes.Token{
+		Denom:  transfertypes.ExtractDenomFromPath(data.Denom),
+		Amount: data.Amount,
+	}
+	coin := ibc.GetReceivedCoin(packet, token)
+	return k.UpdateRateLimitInflow(ctx, packet.DestinationChannel, coin.Denom, coin.Amount)
+}
+
+// revertRefundedPacketOutflow reverts the outflow of the tokens sent with the
+// packet, which are refunded after an error acknowledgement or a timeout.
+func (k Keeper) revertRefundedPacketOutflow(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) {
+	coin := ibc.GetSentCoin(data.Denom, data.Amount)
+	if coin.Amount.IsNil() {
+		return
+	}
+	k.RevertRateLimitOutflow(ctx, packet.SourceChannel, coin.Denom, coin.Amount)
+}
+
+// updateRateLimitFlows adds the amount to the flows of the rate limits of the
+// channel and denom in the given direction. If the usage of a rate limit would
+// exceed its quota, an event is emitted and an error is returned.
+func (k Keeper) updateRateLimitFlows(ctx sdk.Context, channelID, denom string, amount math.Int, direction string) error {
+	rateLimits := k.matchingRateLimits(ctx, channelID, denom)
+	flows := make([]types.RateLimitFlow, len(rateLimits))
+	for i, rateLimit := range rateLimits {
+		flow := k.currentRateLimitFlow(ctx, rateLimit)
+		flows[i] = flow.Add(direction, amount)
+
+		quota := rateLimit.Quota(direction)
+		if quota.IsZero() {
+			continue
+		}
+
+		inflow, outflow := flows[i].Usage(ctx.BlockTime(), rateLimit.Window)
+		usage := outflow
+		if direction == types.FlowInflow {
+			usage = inflow
+		}
+		if usage.LTE(quota) {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRateLimitExceeded,
+				sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.ChannelId),
+				sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+				sdk.NewAttribute(types.AttributeKeyFlow, direction),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyUsage, usage.String()),
+				sdk.NewAttribute(types.AttributeKeyQuota, quota.String()),
+			),
+		)
+		return errorsmod.Wrapf(
+			types.ErrRateLimitExceeded,
+			"%s of %s%s on %s would reach %s over the window, exceeding the quota of %s",
+			direction, amount, denom, channelID, usage, quota,
+		)
+	}
+
+	for i, rateLimit := range rateLimits {
+		k.setRateLimitFlow(ctx, rateLimit.ChannelId, rateLimit.Denom, flows[i])
+	}
+	return nil
+}
+
+// matchingRateLimits returns the rate limits of the channel or client that
+// apply to the given denom, either directly or through its token pair.
+func (k Keeper) matchingRateLimits(ctx sdk.Context, channelID, denom string) []types.RateLimit {
+	channelRateLimits := k.GetChannelRateLimits(ctx, channelID)
+	if len(channelRateLimits) == 0 {
+		return nil
+	}
+
+	pairID := k.GetTokenPairID(ctx, denom)
+
+	var rateLimits []types.RateLimit
+	for _, rateLimit := range channelRateLimits {
+		if rateLimit.Denom == denom ||
+			(len(pairID) > 0 && bytes.Equal(k.GetTokenPairID(ctx, rateLimit.Denom), pairID)) {
+			rateLimits = append(rateLimits, rateLimit)
+		}
+	}
+	return rateLimits
+}
@@ -6,18 +6,24 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -199,6 +205,185 @@ func (m *Allowance) GetSpender() string {
 	return ""
 }
 
+// FeeToken defines a token that is whitelisted to pay for transaction fees
+// instead of the EVM denom.
+type FeeToken struct {
+	// denom is the cosmos base denomination of a registered token pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price is the amount of the EVM denom, in its original decimals, that is
+	// equivalent to one unit of the fee token. A zero price delegates the price
+	// lookup to the fee token price oracle.
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{2}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// RateLimit defines the maximum amounts of a token that can be received and
+// sent through an IBC route over a rolling window.
+type RateLimit struct {
+	// channel_id is the IBC v1 channel ID or the IBC v2 client ID of the route
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the cosmos base denomination or the hex contract address of the
+	// token. For a registered token pair, the limit applies to both of its
+	// representations.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_inflow is the maximum amount of the token that can be received over
+	// the window. A zero amount disables the inflow quota.
+	MaxInflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_inflow,json=maxInflow,proto3,customtype=cosmossdk.io/math.Int" json:"max_inflow"`
+	// max_outflow is the maximum amount of the token that can be sent over the
+	// window. A zero amount disables the outflow quota.
+	MaxOutflow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_outflow,json=maxOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"max_outflow"`
+	// window is the duration of the rolling window
+	Window time.Duration `protobuf:"bytes,5,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{3}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// RateLimitFlow defines the amounts of a rate limited token that were received
+// and sent in the current window and in the previous one.
+type RateLimitFlow struct {
+	// window_start is the start time of the current window
+	WindowStart time.Time `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// inflow is the amount received in the current window
+	Inflow cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=inflow,proto3,customtype=cosmossdk.io/math.Int" json:"inflow"`
+	// outflow is the amount sent in the current window
+	Outflow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=outflow,proto3,customtype=cosmossdk.io/math.Int" json:"outflow"`
+	// previous_inflow is the amount received in the previous window
+	PreviousInflow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=previous_inflow,json=previousInflow,proto3,customtype=cosmossdk.io/math.Int" json:"previous_inflow"`
+	// previous_outflow is the amount sent in the previous window
+	PreviousOutflow cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=previous_outflow,json=previousOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"previous_outflow"`
+}
+
+func (m *RateLimitFlow) Reset()         { *m = RateLimitFlow{} }
+func (m *RateLimitFlow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlow) ProtoMessage()    {}
+func (*RateLimitFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1164958b5b106e92, []int{4}
+}
+func (m *RateLimitFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlow.Merge(m, src)
+}
+func (m *RateLimitFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlow proto.InternalMessageInfo
+
+func (m *RateLimitFlow) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
 // Deprecated: RegisterCoinProposal is a gov Content type to register a token
 // pair for a native Cosmos coin. We're keeping it to remove the existing
 // proposals from store. After that, remove this message.
@@ -217,7 +402,7 @@ func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{2}
+	return fileDescriptor_1164958b5b106e92, []int{5}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,7 +464,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{3}
+	return fileDescriptor_1164958b5b106e92, []int{6}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,7 +517,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{4}
+	return fileDescriptor_1164958b5b106e92, []int{7}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +585,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1164958b5b106e92, []int{5}
+	return fileDescriptor_1164958b5b106e92, []int{8}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,6 +639,9 @@ func init() {
 	proto.RegisterEnum("cosmos.evm.erc20.v1.Owner", Owner_name, Owner_value)
 	proto.RegisterType((*TokenPair)(nil), "cosmos.evm.erc20.v1.TokenPair")
 	proto.RegisterType((*Allowance)(nil), "cosmos.evm.erc20.v1.Allowance")
+	proto.RegisterType((*FeeToken)(nil), "cosmos.evm.erc20.v1.FeeToken")
+	proto.RegisterType((*RateLimit)(nil), "cosmos.evm.erc20.v1.RateLimit")
+	proto.RegisterType((*RateLimitFlow)(nil), "cosmos.evm.erc20.v1.RateLimitFlow")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "cosmos.evm.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*ProposalMetadata)(nil), "cosmos.evm.erc20.v1.ProposalMetadata")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "cosmos.evm.erc20.v1.RegisterERC20Proposal")
@@ -463,43 +651,61 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/erc20.proto", fileDescriptor_1164958b5b106e92) }
 
 var fileDescriptor_1164958b5b106e92 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xb1, 0x6b, 0xdb, 0x4e,
-	0x14, 0xc7, 0x75, 0x89, 0xf3, 0xfb, 0x45, 0x97, 0xc4, 0xb8, 0xd7, 0x04, 0x84, 0xc1, 0xb2, 0x71,
-	0xa0, 0x98, 0x0e, 0x52, 0xec, 0x6c, 0x85, 0x52, 0x6c, 0x47, 0x05, 0x97, 0xc4, 0x36, 0x8a, 0x43,
-	0x4b, 0x17, 0x73, 0x96, 0x0e, 0x45, 0x58, 0xba, 0x33, 0xba, 0x8b, 0xd2, 0x0e, 0xdd, 0x3b, 0x76,
-	0xe9, 0xd4, 0xa5, 0xd0, 0xa9, 0xff, 0x49, 0xc6, 0x8c, 0xa5, 0x43, 0x28, 0xf6, 0xd2, 0x3f, 0xa3,
-	0xe8, 0x4e, 0x2a, 0x69, 0xe9, 0x50, 0x9a, 0xed, 0xbe, 0x5f, 0xbf, 0xf7, 0xfc, 0x79, 0xf7, 0x9e,
-	0x0e, 0xd6, 0x3d, 0xc6, 0x63, 0xc6, 0x6d, 0x92, 0xc6, 0x36, 0x49, 0xbc, 0xce, 0x81, 0x9d, 0xb6,
-	0xd5, 0xc1, 0x5a, 0x24, 0x4c, 0x30, 0x74, 0x5f, 0x05, 0x58, 0x24, 0x8d, 0x2d, 0xe5, 0xa7, 0xed,
-	0xaa, 0x99, 0x67, 0xcd, 0x30, 0x9d, 0xdb, 0x69, 0x7b, 0x46, 0x04, 0x6e, 0x4b, 0xa1, 0x92, 0xaa,
-	0xbb, 0x01, 0x0b, 0x98, 0x3c, 0xda, 0xd9, 0x49, 0xb9, 0xcd, 0xcf, 0x00, 0xea, 0x13, 0x36, 0x27,
-	0x74, 0x8c, 0xc3, 0x04, 0xed, 0xc3, 0x1d, 0x59, 0x6f, 0x8a, 0x7d, 0x3f, 0x21, 0x9c, 0x1b, 0xa0,
-	0x01, 0x5a, 0xba, 0xbb, 0x2d, 0xcd, 0xae, 0xf2, 0xd0, 0x2e, 0xdc, 0xf0, 0x09, 0x65, 0xb1, 0xb1,
-	0x26, 0x7f, 0x54, 0x02, 0x19, 0xf0, 0x7f, 0x42, 0xf1, 0x2c, 0x22, 0xbe, 0xb1, 0xde, 0x00, 0xad,
-	0x4d, 0xb7, 0x90, 0xa8, 0x0b, 0xcb, 0x1e, 0xa3, 0x22, 0xc1, 0x9e, 0x98, 0xb2, 0x4b, 0x4a, 0x12,
-	0xa3, 0xd4, 0x00, 0xad, 0x72, 0xa7, 0x6a, 0xfd, 0xa1, 0x0d, 0x6b, 0x94, 0x45, 0xb8, 0x3b, 0x45,
-	0x86, 0x94, 0x8f, 0x4a, 0xdf, 0x3f, 0xd6, 0x41, 0xf3, 0x03, 0x80, 0x7a, 0x37, 0x8a, 0xd8, 0x25,
-	0xa6, 0x1e, 0xf9, 0x6b, 0x56, 0xf5, 0x97, 0x39, 0xab, 0x14, 0x19, 0x2b, 0x5f, 0x10, 0xea, 0x93,
-	0x44, 0xb2, 0xea, 0x6e, 0x21, 0xd1, 0x21, 0xdc, 0x48, 0x71, 0x74, 0x41, 0x24, 0xa2, 0xde, 0xab,
-	0x5d, 0xdd, 0xd4, 0xb5, 0xaf, 0x37, 0xf5, 0x3d, 0x45, 0xca, 0xfd, 0xb9, 0x15, 0x32, 0x3b, 0xc6,
-	0xe2, 0xdc, 0x1a, 0x50, 0xe1, 0xaa, 0x58, 0x49, 0xa7, 0x35, 0xdf, 0x03, 0xb8, 0xeb, 0x92, 0x20,
-	0xe4, 0x82, 0x24, 0x7d, 0x16, 0xd2, 0x71, 0xc2, 0x16, 0x8c, 0xe3, 0x28, 0x63, 0x10, 0xa1, 0x88,
-	0x48, 0x0e, 0xa8, 0x04, 0x6a, 0xc0, 0x2d, 0x9f, 0x70, 0x2f, 0x09, 0x17, 0x22, 0x64, 0x34, 0xe7,
-	0xbb, 0x6d, 0xa1, 0x27, 0x70, 0x33, 0x26, 0x02, 0xfb, 0x58, 0x60, 0x63, 0xbd, 0xb1, 0xde, 0xda,
-	0xea, 0xd4, 0x8a, 0x1b, 0x93, 0x63, 0xcd, 0x67, 0x6c, 0x9d, 0xe4, 0x41, 0xbd, 0x52, 0x46, 0xeb,
-	0xfe, 0x4c, 0xca, 0xb9, 0x4e, 0x61, 0xa5, 0x40, 0x29, 0x22, 0x7f, 0x29, 0x0d, 0xfe, 0xa1, 0x74,
-	0xf3, 0x0d, 0xdc, 0x2b, 0x7a, 0x75, 0xdc, 0x7e, 0xe7, 0xe0, 0xce, 0xcd, 0x3e, 0x80, 0x65, 0x39,
-	0xb8, 0x7c, 0x98, 0x84, 0xcb, 0x96, 0x75, 0xf7, 0x37, 0x37, 0xef, 0x89, 0xc3, 0xda, 0x84, 0x05,
-	0x41, 0x44, 0xe4, 0xea, 0xf6, 0x19, 0x4d, 0x49, 0xc2, 0x43, 0x76, 0xf7, 0x3b, 0xcf, 0xf2, 0xb2,
-	0x92, 0xf9, 0x5e, 0x28, 0xa1, 0xd6, 0xef, 0xe1, 0x33, 0xb8, 0x21, 0xb7, 0x11, 0xed, 0xc1, 0x7b,
-	0xa3, 0xe7, 0x43, 0xc7, 0x9d, 0x9e, 0x0d, 0x4f, 0xc7, 0x4e, 0x7f, 0xf0, 0x74, 0xe0, 0x1c, 0x55,
-	0x34, 0x54, 0x81, 0xdb, 0xca, 0x3e, 0x19, 0x1d, 0x9d, 0x1d, 0x3b, 0x15, 0x80, 0x10, 0x2c, 0x2b,
-	0xc7, 0x79, 0x31, 0x71, 0xdc, 0x61, 0xf7, 0xb8, 0xb2, 0x56, 0x2d, 0xbd, 0xfd, 0x64, 0x6a, 0xbd,
-	0xc7, 0x57, 0x4b, 0x13, 0x5c, 0x2f, 0x4d, 0xf0, 0x6d, 0x69, 0x82, 0x77, 0x2b, 0x53, 0xbb, 0x5e,
-	0x99, 0xda, 0x97, 0x95, 0xa9, 0xbd, 0xdc, 0x0f, 0x42, 0x71, 0x7e, 0x31, 0xb3, 0x3c, 0x16, 0xdb,
-	0xb7, 0xde, 0x81, 0x57, 0xf9, 0x4b, 0x20, 0x5e, 0x2f, 0x08, 0x9f, 0xfd, 0x27, 0x3f, 0xde, 0xc3,
-	0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xc4, 0x5f, 0x9c, 0x2a, 0x04, 0x00, 0x00,
+	// 861 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x38, 0x71, 0x9a, 0x7d, 0x4e, 0x8c, 0x3b, 0x24, 0x92, 0x31, 0x8a, 0x6d, 0x39, 0x12,
+	0xb2, 0x7a, 0xd8, 0x6d, 0x5c, 0x21, 0x04, 0xe2, 0x57, 0x9c, 0x38, 0xc2, 0xc8, 0x4d, 0xa2, 0x8d,
+	0x2b, 0x10, 0x1c, 0xac, 0xf1, 0xee, 0x74, 0x33, 0xca, 0xee, 0x8c, 0xb5, 0x3b, 0x5e, 0xa7, 0x07,
+	0x24, 0x8e, 0x1c, 0x7b, 0x41, 0x42, 0xe2, 0x82, 0xc4, 0x05, 0x6e, 0xfc, 0x19, 0x3d, 0xf6, 0x88,
+	0x38, 0xb4, 0x28, 0x39, 0xc0, 0x9f, 0x81, 0x76, 0x66, 0xd6, 0x84, 0x16, 0x24, 0x8b, 0x5e, 0xac,
+	0x7d, 0x6f, 0xde, 0xf7, 0xcd, 0x37, 0xdf, 0xbc, 0x79, 0x86, 0xa6, 0x27, 0x92, 0x48, 0x24, 0x0e,
+	0x4d, 0x23, 0x87, 0xc6, 0x5e, 0xf7, 0xae, 0x93, 0xee, 0xe9, 0x0f, 0x7b, 0x1a, 0x0b, 0x29, 0xf0,
+	0xeb, 0xba, 0xc0, 0xa6, 0x69, 0x64, 0xeb, 0x7c, 0xba, 0x57, 0xbf, 0x4d, 0x22, 0xc6, 0x85, 0xa3,
+	0x7e, 0x75, 0x5d, 0xbd, 0x61, 0x88, 0x26, 0x84, 0x5f, 0x38, 0xe9, 0xde, 0x84, 0x4a, 0xb2, 0xa7,
+	0x02, 0xb3, 0xbe, 0x15, 0x88, 0x40, 0xa8, 0x4f, 0x27, 0xfb, 0xca, 0x51, 0x81, 0x10, 0x41, 0x48,
+	0x1d, 0x15, 0x4d, 0x66, 0x0f, 0x1d, 0x7f, 0x16, 0x13, 0xc9, 0x04, 0x37, 0xeb, 0xcd, 0x17, 0xd7,
+	0x25, 0x8b, 0x68, 0x22, 0x49, 0x34, 0xd5, 0x05, 0xed, 0x9f, 0x11, 0x58, 0x23, 0x71, 0x41, 0xf9,
+	0x29, 0x61, 0x31, 0xde, 0x85, 0x4d, 0xa5, 0x71, 0x4c, 0x7c, 0x3f, 0xa6, 0x49, 0x52, 0x43, 0x2d,
+	0xd4, 0xb1, 0xdc, 0x0d, 0x95, 0xdc, 0xd7, 0x39, 0xbc, 0x05, 0x25, 0x9f, 0x72, 0x11, 0xd5, 0x8a,
+	0x6a, 0x51, 0x07, 0xb8, 0x06, 0xb7, 0x28, 0x27, 0x93, 0x90, 0xfa, 0xb5, 0x95, 0x16, 0xea, 0xac,
+	0xbb, 0x79, 0x88, 0xf7, 0xa1, 0xe2, 0x09, 0x2e, 0x63, 0xe2, 0xc9, 0xb1, 0x98, 0x73, 0x1a, 0xd7,
+	0x56, 0x5b, 0xa8, 0x53, 0xe9, 0xd6, 0xed, 0x7f, 0xb1, 0xc6, 0x3e, 0xc9, 0x2a, 0xdc, 0xcd, 0x1c,
+	0xa1, 0xc2, 0xf7, 0x56, 0xff, 0xfc, 0xa1, 0x89, 0xda, 0xdf, 0x23, 0xb0, 0xf6, 0xc3, 0x50, 0xcc,
+	0x09, 0xf7, 0xe8, 0xd2, 0x5a, 0xf5, 0x96, 0x46, 0xab, 0x0a, 0x32, 0xad, 0xc9, 0x94, 0x72, 0x9f,
+	0xc6, 0x4a, 0xab, 0xe5, 0xe6, 0x21, 0xbe, 0x07, 0xa5, 0x94, 0x84, 0x33, 0xaa, 0x24, 0x5a, 0xbd,
+	0x9d, 0x27, 0xcf, 0x9a, 0x85, 0xdf, 0x9e, 0x35, 0xb7, 0xb5, 0xd2, 0xc4, 0xbf, 0xb0, 0x99, 0x70,
+	0x22, 0x22, 0xcf, 0xed, 0x01, 0x97, 0xae, 0xae, 0x55, 0xea, 0x0a, 0xed, 0x2f, 0x61, 0xfd, 0x88,
+	0x52, 0xe5, 0xe5, 0xdf, 0x16, 0xa1, 0x9b, 0x16, 0xbd, 0x0b, 0xa5, 0x69, 0xcc, 0x3c, 0xaa, 0xc5,
+	0xf4, 0x76, 0x0d, 0xf9, 0x9b, 0x2f, 0x93, 0x0f, 0x69, 0x40, 0xbc, 0x47, 0x87, 0xd4, 0x73, 0x35,
+	0xa2, 0xfd, 0x75, 0x11, 0x2c, 0x97, 0x48, 0x3a, 0x64, 0x11, 0x93, 0x78, 0x07, 0xc0, 0x3b, 0x27,
+	0x9c, 0xd3, 0x70, 0xcc, 0x7c, 0xb3, 0x87, 0x65, 0x32, 0x03, 0xff, 0x3f, 0x2e, 0xe8, 0x7d, 0x80,
+	0x88, 0x5c, 0x8e, 0x19, 0x7f, 0x18, 0x8a, 0x79, 0x6d, 0x65, 0x99, 0xf3, 0x59, 0x11, 0xb9, 0x1c,
+	0xa8, 0x7a, 0xfc, 0x21, 0x94, 0x33, 0xb4, 0x98, 0x49, 0x05, 0x5f, 0xca, 0x9e, 0x6c, 0xbf, 0x13,
+	0x0d, 0xc0, 0x1f, 0xc3, 0xda, 0x9c, 0x71, 0x5f, 0xcc, 0x6b, 0xa5, 0x16, 0xea, 0x94, 0xbb, 0x6f,
+	0xd8, 0xba, 0x33, 0xed, 0xbc, 0x33, 0xed, 0x43, 0xd3, 0xb9, 0xbd, 0xcd, 0x8c, 0xf5, 0xbb, 0xe7,
+	0x4d, 0xf4, 0xd3, 0x1f, 0xbf, 0xdc, 0x41, 0xae, 0xc1, 0xb5, 0x9f, 0x17, 0x61, 0x73, 0x61, 0xc1,
+	0x51, 0xc6, 0x39, 0x84, 0x0d, 0xbd, 0x36, 0x4e, 0x24, 0x89, 0xa5, 0x32, 0xa2, 0xdc, 0xad, 0xbf,
+	0xc4, 0x3c, 0xca, 0x7b, 0x5e, 0x53, 0x3f, 0x5e, 0x50, 0x97, 0x35, 0xfc, 0x2c, 0x43, 0xe3, 0xb7,
+	0x61, 0xcd, 0x78, 0x53, 0x5c, 0xe6, 0x70, 0xa6, 0x18, 0xbf, 0x03, 0xb7, 0x72, 0x53, 0x96, 0xf2,
+	0x34, 0xaf, 0xc6, 0x47, 0xf0, 0xda, 0x34, 0xa6, 0x29, 0x13, 0xb3, 0x24, 0xbf, 0x94, 0xa5, 0x5c,
+	0xad, 0xe4, 0x28, 0x73, 0x33, 0x9f, 0x40, 0x75, 0xc1, 0x93, 0x2b, 0x29, 0x2d, 0x43, 0xb4, 0xd8,
+	0xde, 0xdc, 0x51, 0xfb, 0x5b, 0x04, 0x5b, 0x2e, 0x0d, 0x58, 0x22, 0x69, 0x7c, 0x20, 0x18, 0x3f,
+	0x8d, 0xc5, 0x54, 0x24, 0x24, 0xcc, 0x1a, 0x4a, 0x32, 0x19, 0xd2, 0xbc, 0x9d, 0x55, 0x80, 0x5b,
+	0x50, 0xf6, 0x69, 0xe2, 0xc5, 0x6c, 0x9a, 0x5d, 0x9b, 0x69, 0xb6, 0x9b, 0x29, 0xfc, 0x11, 0xac,
+	0x47, 0x54, 0x12, 0x9f, 0x48, 0x52, 0x5b, 0x69, 0xad, 0x74, 0xca, 0xdd, 0x9d, 0xfc, 0xcd, 0xab,
+	0xc9, 0x66, 0xc6, 0x9c, 0x7d, 0xdf, 0x14, 0xf5, 0x56, 0x33, 0xc5, 0xee, 0x02, 0x64, 0x5e, 0xd6,
+	0x19, 0x54, 0x73, 0x29, 0x79, 0xe5, 0x3f, 0xa8, 0xd1, 0xff, 0xa0, 0x6e, 0x7f, 0x05, 0xdb, 0xf9,
+	0x59, 0xfb, 0xee, 0x41, 0xf7, 0xee, 0x2b, 0x1f, 0xf6, 0x2d, 0xa8, 0xa8, 0xd1, 0x63, 0xc6, 0x11,
+	0x4d, 0xd4, 0x91, 0x2d, 0xf7, 0x85, 0xac, 0x39, 0x53, 0x02, 0x3b, 0x23, 0x11, 0x04, 0xa1, 0x1e,
+	0x18, 0x07, 0x82, 0xa7, 0x34, 0x4e, 0x98, 0x78, 0x75, 0xcf, 0x33, 0x5c, 0x46, 0x69, 0x26, 0x9b,
+	0x0e, 0xf4, 0x00, 0xbd, 0xf3, 0x29, 0x94, 0xd4, 0x3c, 0xc5, 0xdb, 0x70, 0xfb, 0xe4, 0xb3, 0xe3,
+	0xbe, 0x3b, 0x7e, 0x70, 0x7c, 0x76, 0xda, 0x3f, 0x18, 0x1c, 0x0d, 0xfa, 0x87, 0xd5, 0x02, 0xae,
+	0xc2, 0x86, 0x4e, 0xdf, 0x3f, 0x39, 0x7c, 0x30, 0xec, 0x57, 0x11, 0xc6, 0x50, 0xd1, 0x99, 0xfe,
+	0xe7, 0xa3, 0xbe, 0x7b, 0xbc, 0x3f, 0xac, 0x16, 0xeb, 0xab, 0xdf, 0xfc, 0xd8, 0x28, 0xf4, 0x3e,
+	0x78, 0x72, 0xd5, 0x40, 0x4f, 0xaf, 0x1a, 0xe8, 0xf7, 0xab, 0x06, 0x7a, 0x7c, 0xdd, 0x28, 0x3c,
+	0xbd, 0x6e, 0x14, 0x7e, 0xbd, 0x6e, 0x14, 0xbe, 0xd8, 0x0d, 0x98, 0x3c, 0x9f, 0x4d, 0x6c, 0x4f,
+	0x44, 0xce, 0x8d, 0x7f, 0xc7, 0x4b, 0xf3, 0xff, 0x28, 0x1f, 0x4d, 0x69, 0x32, 0x59, 0x53, 0xaf,
+	0xf3, 0xde, 0x5f, 0x03, 0x00, 0xd5, 0x62, 0x95, 0xcf, 0x40, 0x07, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -671,6 +877,182 @@ func (m *Allowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintErc20(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxOutflow.Size()
+		i -= size
+		if _, err := m.MaxOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxInflow.Size()
+		i -= size
+		if _, err := m.MaxInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PreviousOutflow.Size()
+		i -= size
+		if _, err := m.PreviousOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PreviousInflow.Size()
+		i -= size
+		if _, err := m.PreviousInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintErc20(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -906,54 +1288,111 @@ func (m *Allowance) Size() (n int) {
 	return n
 }
 
-func (m *RegisterCoinProposal) Size() (n int) {
+func (m *FeeToken) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Description)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovErc20(uint64(l))
-		}
-	}
+	l = m.Price.Size()
+	n += 1 + l + sovErc20(uint64(l))
 	return n
 }
 
-func (m *ProposalMetadata) Size() (n int) {
+func (m *RateLimit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovErc20(uint64(l))
-		}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
 	}
+	l = m.MaxInflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.MaxOutflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovErc20(uint64(l))
 	return n
 }
 
-func (m *RegisterERC20Proposal) Size() (n int) {
+func (m *RateLimitFlow) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.Inflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.PreviousInflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.PreviousOutflow.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *RegisterCoinProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposalMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisterERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
 	l = len(m.Description)
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
@@ -1327,6 +1766,556 @@ func (m *Allowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
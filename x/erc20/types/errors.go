@@ -25,4 +25,8 @@ var (
 	ErrInvalidAllowance         = errorsmod.Register(ModuleName, 18, "invalid allowance")
 	ErrNegativeToken            = errorsmod.Register(ModuleName, 19, "token amount is negative")
 	ErrExpectedEvent            = errorsmod.Register(ModuleName, 20, "expected event")
+	ErrInvalidFeeToken          = errorsmod.Register(ModuleName, 21, "invalid fee token")
+	ErrInvalidForwardMetadata   = errorsmod.Register(ModuleName, 22, "invalid forward metadata")
+	ErrForwardFailed            = errorsmod.Register(ModuleName, 23, "packet forward failed")
+	ErrRateLimitExceeded        = errorsmod.Register(ModuleName, 24, "IBC rate limit exceeded")
 )
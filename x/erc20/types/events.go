@@ -15,10 +15,25 @@ const (
 
 	EventTypeFailedConvertERC20 = "failed_convert_erc20"
 
+	EventTypeForwardPacket       = "forward_packet"
+	EventTypeForwardPacketRefund = "forward_packet_refund"
+
+	EventTypeRateLimitExceeded = "rate_limit_exceeded"
+
 	AttributeCoinSourceChannel = "source_channel"
 	AttributeKeyCosmosCoin     = "cosmos_coin"
 	AttributeKeyERC20Token     = "erc20_token" // #nosec
 	AttributeKeyReceiver       = "receiver"
+	AttributeKeyForwardPort    = "forward_port"
+	AttributeKeyForwardChannel = "forward_channel"
+	AttributeKeyForwardSeq     = "forward_sequence"
+	AttributeKeyError          = "error"
+	AttributeKeyChannel        = "channel"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyFlow           = "flow"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyUsage          = "usage"
+	AttributeKeyQuota          = "quota"
 )
 
 // LogTransfer Event type for Transfer(address from, address to, uint256 value)
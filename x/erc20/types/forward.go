@@ -0,0 +1,250 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ForwardMemoKey is the memo key holding packet-forward-middleware style
+	// forwarding instructions.
+	ForwardMemoKey = "forward"
+
+	// ForwardReceiverPlaceholder is the receiver set on packets whose tokens
+	// are forwarded by the receiving chain. The receiving chain ignores it and
+	// holds the tokens on an intermediate account derived from the packet.
+	ForwardReceiverPlaceholder = "pfm"
+
+	// DefaultForwardTimeout is the relative timeout of a forwarded packet when
+	// the forward memo doesn't set one.
+	DefaultForwardTimeout = 28 * 24 * time.Hour
+)
+
+// Duration is a time.Duration that is encoded in JSON as a duration string
+// (e.g. "10m"). For compatibility with packet-forward-middleware memos, it also
+// accepts an integer amount of nanoseconds.
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case float64:
+		*d = Duration(time.Duration(v))
+	case string:
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*d = Duration(duration)
+	default:
+		return fmt.Errorf("invalid duration: %s", string(bz))
+	}
+	return nil
+}
+
+// ForwardMemo is the memo of an ICS20 packet whose tokens must be forwarded by
+// the receiving chain.
+type ForwardMemo struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines where the tokens of a received ICS20 packet are
+// forwarded to, following the packet-forward-middleware memo format.
+type ForwardMetadata struct {
+	// Receiver is the receiver of the tokens on the next chain.
+	Receiver string `json:"receiver"`
+	// Port is the port on which the tokens are forwarded.
+	Port string `json:"port"`
+	// Channel is the channel on which the tokens are forwarded.
+	Channel string `json:"channel"`
+	// Timeout is the timeout of the forwarded packet, relative to the block
+	// time in which it is sent.
+	Timeout Duration `json:"timeout,omitempty"`
+	// Retries is the number of times the forwarded packet is resent after
+	// timing out before the tokens are refunded.
+	Retries *uint8 `json:"retries,omitempty"`
+	// Next is the memo of the forwarded packet. It is either a JSON object or a
+	// string containing one.
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata returns the forwarding instructions of the given ICS20
+// packet memo. The returned boolean is false if the memo doesn't contain a
+// forward key.
+func ParseForwardMetadata(memo string) (ForwardMetadata, bool, error) {
+	var memoMap map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoMap); err != nil {
+		// not a JSON object, so there is nothing to forward
+		return ForwardMetadata{}, false, nil
+	}
+
+	forward, found := memoMap[ForwardMemoKey]
+	if !found {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var metadata ForwardMetadata
+	if err := json.Unmarshal(forward, &metadata); err != nil {
+		return ForwardMetadata{}, true, errorsmod.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return ForwardMetadata{}, true, err
+	}
+
+	return metadata, true, nil
+}
+
+// Validate performs a stateless validation of the forwarding instructions.
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid port %s: %s", m.Port, err)
+	}
+	if !channeltypes.IsChannelIDFormat(m.Channel) {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid channel %s", m.Channel)
+	}
+	if m.Timeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "negative timeout %s", time.Duration(m.Timeout))
+	}
+	if _, err := m.NextMemo(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet.
+func (m ForwardMetadata) GetTimeout() time.Duration {
+	if m.Timeout == 0 {
+		return DefaultForwardTimeout
+	}
+	return time.Duration(m.Timeout)
+}
+
+// GetRetries returns the number of times the forwarded packet is resent after
+// timing out.
+func (m ForwardMetadata) GetRetries() uint8 {
+	if m.Retries == nil {
+		return 0
+	}
+	return *m.Retries
+}
+
+// NextMemo returns the memo of the forwarded packet.
+func (m ForwardMetadata) NextMemo() (string, error) {
+	next := bytes.TrimSpace(m.Next)
+	if len(next) == 0 || bytes.Equal(next, []byte("null")) {
+		return "", nil
+	}
+
+	// the next memo can be set as a string that contains a JSON object
+	if next[0] == '"' {
+		var memo string
+		if err := json.Unmarshal(next, &memo); err != nil {
+			return "", errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid next memo: %s", err)
+		}
+		next = []byte(memo)
+	}
+
+	var memoMap map[string]json.RawMessage
+	if err := json.Unmarshal(next, &memoMap); err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidForwardMetadata, "next memo must be a JSON object: %s", err)
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, next); err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid next memo: %s", err)
+	}
+	return compacted.String(), nil
+}
+
+// NewForwardMemo returns the memo of an ICS20 packet that is forwarded through
+// the given route before reaching the receiver. Each hop is the port and
+// channel on which an intermediate chain forwards the tokens. The given memo is
+// set on the packet received by the final chain and must be a JSON object if
+// not empty.
+func NewForwardMemo(receiver string, route []transfertypes.Hop, memo string) (string, error) {
+	if len(route) == 0 {
+		return memo, nil
+	}
+
+	var next json.RawMessage
+	if memo != "" {
+		next = json.RawMessage(memo)
+	}
+
+	var forwardMemo ForwardMemo
+	for i := len(route) - 1; i >= 0; i-- {
+		hopReceiver := ForwardReceiverPlaceholder
+		if i == len(route)-1 {
+			hopReceiver = receiver
+		}
+
+		metadata := ForwardMetadata{
+			Receiver: hopReceiver,
+			Port:     route[i].PortId,
+			Channel:  route[i].ChannelId,
+			Next:     next,
+		}
+		if err := metadata.Validate(); err != nil {
+			return "", err
+		}
+
+		forwardMemo = ForwardMemo{Forward: &metadata}
+		bz, err := json.Marshal(forwardMemo)
+		if err != nil {
+			return "", err
+		}
+		next = bz
+	}
+
+	return string(next), nil
+}
+
+// GetForwardReceiver returns the intermediate account that holds the tokens of
+// a packet received on the given channel from the given sender until they are
+// forwarded.
+func GetForwardReceiver(channel, originalSender string) sdk.AccAddress {
+	return address.Hash(ModuleName, []byte(fmt.Sprintf("forward/%s/%s", channel, originalSender)))
+}
+
+// InFlightPacket is a received ICS20 packet whose tokens have been forwarded
+// and that is acknowledged once the forwarded packet is acknowledged or timed
+// out.
+type InFlightPacket struct {
+	// Packet is the packet received from the previous chain.
+	Packet channeltypes.Packet `json:"packet"`
+	// Forwarder is the intermediate account that sends the forwarded packet.
+	Forwarder string `json:"forwarder"`
+	// Token is the received token that is forwarded.
+	Token sdk.Coin `json:"token"`
+	// Metadata are the forwarding instructions of the received packet.
+	Metadata ForwardMetadata `json:"metadata"`
+	// RetriesRemaining is the number of times the forwarded packet can still be
+	// resent after timing out.
+	RetriesRemaining uint8 `json:"retries_remaining"`
+}
@@ -0,0 +1,154 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		memo        string
+		expFound    bool
+		expError    bool
+		expMetadata func(types.ForwardMetadata)
+	}{
+		{"empty memo", "", false, false, nil},
+		{"memo is not JSON", "hello", false, false, nil},
+		{"memo without forward key", `{"dest_callback":{"address":"0x"}}`, false, false, nil},
+		{
+			"forward with default timeout and retries",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}`,
+			true, false,
+			func(m types.ForwardMetadata) {
+				require.Equal(t, "cosmos1receiver", m.Receiver)
+				require.Equal(t, types.DefaultForwardTimeout, m.GetTimeout())
+				require.Equal(t, uint8(0), m.GetRetries())
+			},
+		},
+		{
+			"forward with duration string timeout and retries",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":"10m","retries":2}}`,
+			true, false,
+			func(m types.ForwardMetadata) {
+				require.Equal(t, 10*time.Minute, m.GetTimeout())
+				require.Equal(t, uint8(2), m.GetRetries())
+			},
+		},
+		{
+			"forward with nanoseconds timeout",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":60000000000}}`,
+			true, false,
+			func(m types.ForwardMetadata) {
+				require.Equal(t, time.Minute, m.GetTimeout())
+			},
+		},
+		{
+			"forward with next memo object",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","next": {"forward": {"receiver":"cosmos1final"}}}}`,
+			true, false,
+			func(m types.ForwardMetadata) {
+				next, err := m.NextMemo()
+				require.NoError(t, err)
+				require.Equal(t, `{"forward":{"receiver":"cosmos1final"}}`, next)
+			},
+		},
+		{
+			"forward with next memo string",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","next":"{\"wasm\":{}}"}}`,
+			true, false,
+			func(m types.ForwardMetadata) {
+				next, err := m.NextMemo()
+				require.NoError(t, err)
+				require.Equal(t, `{"wasm":{}}`, next)
+			},
+		},
+		{"forward without receiver", `{"forward":{"port":"transfer","channel":"channel-1"}}`, true, true, nil},
+		{"forward with invalid port", `{"forward":{"receiver":"cosmos1receiver","port":"","channel":"channel-1"}}`, true, true, nil},
+		{"forward with client ID as channel", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"07-tendermint-0"}}`, true, true, nil},
+		{"forward with invalid timeout", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":"soon"}}`, true, true, nil},
+		{"forward with negative timeout", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":"-1m"}}`, true, true, nil},
+		{"forward with non object next memo", `{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","next":"hello"}}`, true, true, nil},
+		{"forward is not an object", `{"forward":"channel-1"}`, true, true, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, found, err := types.ParseForwardMetadata(tc.memo)
+			require.Equal(t, tc.expFound, found)
+			if tc.expError {
+				require.ErrorIs(t, err, types.ErrInvalidForwardMetadata)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expMetadata != nil {
+				tc.expMetadata(metadata)
+			}
+		})
+	}
+}
+
+func TestNewForwardMemo(t *testing.T) {
+	route := []transfertypes.Hop{
+		transfertypes.NewHop(transfertypes.PortID, "channel-1"),
+		transfertypes.NewHop(transfertypes.PortID, "channel-2"),
+	}
+
+	testCases := []struct {
+		name     string
+		route    []transfertypes.Hop
+		memo     string
+		expMemo  string
+		expError bool
+	}{
+		{"empty route keeps the memo", nil, "memo", "memo", false},
+		{
+			"single hop",
+			route[:1],
+			"",
+			`{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-1"}}`,
+			false,
+		},
+		{
+			"multiple hops with a memo for the final chain",
+			route,
+			`{"dest_callback":{"address":"0x"}}`,
+			`{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"cosmos1final","port":"transfer","channel":"channel-2","next":{"dest_callback":{"address":"0x"}}}}}}`,
+			false,
+		},
+		{"memo for the final chain is not a JSON object", route, "memo", "", true},
+		{"invalid hop channel", []transfertypes.Hop{transfertypes.NewHop(transfertypes.PortID, "")}, "", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			memo, err := types.NewForwardMemo("cosmos1final", tc.route, tc.memo)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expMemo, memo)
+
+			if len(tc.route) == 0 {
+				return
+			}
+
+			// the memo is parsed back hop by hop until the final receiver
+			for i := range tc.route {
+				metadata, found, err := types.ParseForwardMetadata(memo)
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, tc.route[i].ChannelId, metadata.Channel)
+				memo, err = metadata.NextMemo()
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.memo, memo)
+		})
+	}
+}
@@ -27,8 +27,13 @@ func DefaultGenesisState() *GenesisState {
 // failure.
 // TODO: Validate that the precompiles have a corresponding token pair
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params on genesis: %w", err)
+	}
+
 	seenErc20 := make(map[string]bool)
 	seenDenom := make(map[string]bool)
+	nativeERC20Denom := make(map[string]bool)
 
 	for _, b := range gs.TokenPairs {
 		if seenErc20[b.Erc20Address] {
@@ -44,6 +49,17 @@ func (gs GenesisState) Validate() error {
 
 		seenErc20[b.Erc20Address] = true
 		seenDenom[b.Denom] = true
+		nativeERC20Denom[b.Denom] = b.IsNativeERC20()
+	}
+
+	// Check if fee tokens have a corresponding token pair
+	for _, feeToken := range gs.Params.FeeTokens {
+		if !seenDenom[feeToken.Denom] {
+			return fmt.Errorf("fee token has no corresponding token pair on genesis: %s", feeToken.Denom)
+		}
+		if nativeERC20Denom[feeToken.Denom] {
+			return fmt.Errorf("fee token cannot be a native ERC20 token on genesis: %s", feeToken.Denom)
+		}
 	}
 
 	// Check if active precompiles have a corresponding token pair
@@ -114,6 +114,12 @@ type Params struct {
 	// permissionless_registration is the parameter that allows ERC20s to be
 	// permissionlessly registered to be converted to bank tokens and vice versa
 	PermissionlessRegistration bool `protobuf:"varint,5,opt,name=permissionless_registration,json=permissionlessRegistration,proto3" json:"permissionless_registration,omitempty"`
+	// fee_tokens is the list of tokens that can be used to pay for transaction
+	// fees, in order of preference
+	FeeTokens []FeeToken `protobuf:"bytes,6,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// rate_limits is the list of quotas on the tokens received and sent through
+	// IBC routes
+	RateLimits []RateLimit `protobuf:"bytes,7,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -163,6 +169,20 @@ func (m *Params) GetPermissionlessRegistration() bool {
 	return false
 }
 
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *Params) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "cosmos.evm.erc20.v1.Params")
@@ -171,33 +191,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/erc20/v1/genesis.proto", fileDescriptor_e964b7a0cc2cbbd5) }
 
 var fileDescriptor_e964b7a0cc2cbbd5 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x14, 0xc6, 0x93, 0xe6, 0xde, 0xd2, 0x4e, 0xba, 0xb0, 0x53, 0x17, 0xa1, 0x85, 0xf4, 0x8f, 0x9b,
-	0xe2, 0x22, 0xb1, 0x75, 0x23, 0x82, 0x8a, 0x05, 0x11, 0xbb, 0x2a, 0xd5, 0x95, 0x9b, 0x30, 0x8d,
-	0x87, 0x38, 0x98, 0x99, 0x09, 0x33, 0x63, 0xb4, 0x6f, 0xe1, 0x63, 0xb8, 0xf4, 0x31, 0xba, 0xec,
-	0x52, 0x10, 0x44, 0xda, 0x85, 0xaf, 0x21, 0x9d, 0x69, 0x69, 0x2a, 0xe5, 0x6e, 0xc2, 0xf0, 0xf1,
-	0xfb, 0x7d, 0x39, 0x1c, 0x0e, 0x1a, 0xa6, 0x42, 0x31, 0xa1, 0x62, 0x28, 0x59, 0x0c, 0x32, 0x9d,
-	0x3e, 0x8a, 0xcb, 0x49, 0x9c, 0x01, 0x07, 0x45, 0x55, 0x54, 0x48, 0xa1, 0x05, 0xee, 0x58, 0x24,
-	0x82, 0x92, 0x45, 0x06, 0x89, 0xca, 0x49, 0xb7, 0x4d, 0x18, 0xe5, 0x22, 0x36, 0x5f, 0xcb, 0x75,
-	0xfb, 0xd7, 0xaa, 0xac, 0x60, 0x81, 0xfb, 0x99, 0xc8, 0x84, 0x79, 0xc6, 0x87, 0x97, 0x4d, 0x47,
-	0xbf, 0x6a, 0xa8, 0xf5, 0xda, 0xfe, 0xf0, 0xad, 0x26, 0x1a, 0xf0, 0x73, 0x54, 0x2f, 0x88, 0x24,
-	0x4c, 0x05, 0xee, 0xc0, 0x1d, 0xfb, 0xd3, 0x5e, 0x74, 0x65, 0x80, 0x68, 0x61, 0x90, 0x59, 0x73,
-	0xf3, 0xbb, 0xef, 0x7c, 0xff, 0xfb, 0xe3, 0xa1, 0xbb, 0x3c, 0x5a, 0x78, 0x8e, 0x7c, 0x2d, 0x3e,
-	0x01, 0x4f, 0x0a, 0x42, 0xa5, 0x0a, 0x6a, 0x03, 0x6f, 0xec, 0x4f, 0xc3, 0xab, 0x25, 0xef, 0x0e,
-	0xdc, 0x82, 0x50, 0x59, 0xed, 0x41, 0xfa, 0x94, 0x2a, 0xfc, 0x06, 0x21, 0x92, 0xe7, 0xe2, 0x0b,
-	0xe1, 0x29, 0xa8, 0xc0, 0xbb, 0xa3, 0xea, 0xe5, 0x09, 0xbb, 0xa8, 0x3a, 0xcb, 0xf8, 0x09, 0xc2,
-	0x9c, 0x68, 0x5a, 0x42, 0x52, 0x48, 0x48, 0x05, 0x2b, 0x68, 0x0e, 0x2a, 0xb8, 0x19, 0x78, 0xe3,
-	0xa6, 0x51, 0x5c, 0xab, 0xb4, 0x2d, 0xb4, 0x38, 0x33, 0xf8, 0x29, 0xea, 0x7c, 0x58, 0x73, 0xc2,
-	0x68, 0x7a, 0xa1, 0xde, 0xfe, 0xaf, 0xe2, 0x23, 0x55, 0x71, 0x47, 0x12, 0xd5, 0xed, 0xa6, 0xf0,
-	0x10, 0xb5, 0x80, 0x93, 0x55, 0x0e, 0x89, 0x99, 0xd9, 0x2c, 0xb7, 0xb1, 0xf4, 0x6d, 0xf6, 0xea,
-	0x10, 0xe1, 0x17, 0xa8, 0x57, 0x80, 0x64, 0x54, 0x29, 0x2a, 0x78, 0x0e, 0x4a, 0x25, 0x12, 0x32,
-	0xaa, 0xb4, 0x24, 0x9a, 0x0a, 0x1e, 0xdc, 0x1a, 0xa3, 0x7b, 0x89, 0x2c, 0x2b, 0xc4, 0xfc, 0xa6,
-	0x51, 0xbb, 0xe7, 0xcd, 0x9e, 0x6d, 0x76, 0xa1, 0xbb, 0xdd, 0x85, 0xee, 0x9f, 0x5d, 0xe8, 0x7e,
-	0xdb, 0x87, 0xce, 0x76, 0x1f, 0x3a, 0x3f, 0xf7, 0xa1, 0xf3, 0xfe, 0x41, 0x46, 0xf5, 0xc7, 0xcf,
-	0xab, 0x28, 0x15, 0x2c, 0xae, 0x5c, 0xcb, 0xd7, 0xe3, 0xbd, 0xe8, 0x75, 0x01, 0x6a, 0x55, 0x37,
-	0x77, 0xf1, 0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x48, 0x19, 0xb7, 0x9b, 0x02, 0x00,
-	0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xb1, 0x6e, 0xd3, 0x40,
+	0x18, 0xc7, 0xe3, 0xa4, 0x0d, 0xcd, 0xa5, 0x03, 0xbd, 0x32, 0x58, 0xa9, 0x70, 0xd3, 0xb2, 0x44,
+	0x0c, 0x36, 0x0d, 0x0b, 0x42, 0x02, 0x44, 0x25, 0xa8, 0xa8, 0x18, 0xa2, 0xc0, 0xc4, 0x62, 0x5d,
+	0xcc, 0x57, 0x73, 0xc2, 0x77, 0x67, 0xdd, 0x77, 0x18, 0xfa, 0x04, 0xac, 0x3c, 0x06, 0x23, 0x8f,
+	0xd1, 0xb1, 0x23, 0x12, 0x12, 0x42, 0xc9, 0xc0, 0x6b, 0x20, 0xdf, 0x39, 0xaa, 0x8d, 0xac, 0x2c,
+	0xd6, 0xe9, 0xaf, 0xdf, 0xff, 0xf3, 0xe9, 0x77, 0x1f, 0x39, 0x4a, 0x14, 0x0a, 0x85, 0x11, 0x14,
+	0x22, 0x02, 0x9d, 0x4c, 0x1f, 0x44, 0xc5, 0x49, 0x94, 0x82, 0x04, 0xe4, 0x18, 0xe6, 0x5a, 0x19,
+	0x45, 0xf7, 0x1d, 0x12, 0x42, 0x21, 0x42, 0x8b, 0x84, 0xc5, 0xc9, 0x68, 0x8f, 0x09, 0x2e, 0x55,
+	0x64, 0xbf, 0x8e, 0x1b, 0x1d, 0xb6, 0x8d, 0x72, 0x05, 0x07, 0xdc, 0x49, 0x55, 0xaa, 0xec, 0x31,
+	0x2a, 0x4f, 0x2e, 0x3d, 0xfe, 0xd5, 0x25, 0xbb, 0x67, 0xee, 0x87, 0x6f, 0x0c, 0x33, 0x40, 0x9f,
+	0x92, 0x7e, 0xce, 0x34, 0x13, 0xe8, 0x7b, 0x63, 0x6f, 0x32, 0x9c, 0x1e, 0x84, 0x2d, 0x17, 0x08,
+	0x67, 0x16, 0x39, 0x1d, 0x5c, 0xfd, 0x3e, 0xec, 0x7c, 0xff, 0xfb, 0xe3, 0xbe, 0x37, 0xaf, 0x5a,
+	0xf4, 0x9c, 0x0c, 0x8d, 0xfa, 0x08, 0x32, 0xce, 0x19, 0xd7, 0xe8, 0x77, 0xc7, 0xbd, 0xc9, 0x70,
+	0x1a, 0xb4, 0x0e, 0x79, 0x5b, 0x72, 0x33, 0xc6, 0x75, 0x7d, 0x0e, 0x31, 0xeb, 0x14, 0xe9, 0x2b,
+	0x42, 0x58, 0x96, 0xa9, 0xcf, 0x4c, 0x26, 0x80, 0x7e, 0x6f, 0xc3, 0xa8, 0xe7, 0x6b, 0xac, 0x31,
+	0xea, 0xa6, 0x4c, 0x1f, 0x11, 0x2a, 0x99, 0xe1, 0x05, 0xc4, 0xb9, 0x86, 0x44, 0x89, 0x9c, 0x67,
+	0x80, 0xfe, 0xd6, 0xb8, 0x37, 0x19, 0xd8, 0x8a, 0xe7, 0x2a, 0x7b, 0x0e, 0x9a, 0xdd, 0x30, 0xf4,
+	0x31, 0xd9, 0x7f, 0x7f, 0x29, 0x99, 0xe0, 0x49, 0xa3, 0xba, 0xfd, 0x7f, 0x95, 0x56, 0x54, 0xad,
+	0x7b, 0xfc, 0xb5, 0x4b, 0xfa, 0x4e, 0x15, 0x3d, 0x22, 0xbb, 0x20, 0xd9, 0x22, 0x83, 0xd8, 0x5e,
+	0xda, 0xda, 0xdd, 0x99, 0x0f, 0x5d, 0xf6, 0xa2, 0x8c, 0xe8, 0x33, 0x72, 0x90, 0x83, 0x16, 0x1c,
+	0x91, 0x2b, 0x99, 0x01, 0x62, 0xac, 0x21, 0xe5, 0x68, 0x34, 0x33, 0x5c, 0x49, 0x7f, 0xdb, 0x36,
+	0x46, 0x4d, 0x64, 0x5e, 0x23, 0xe8, 0x19, 0x21, 0x17, 0x00, 0xb1, 0x35, 0x88, 0x7e, 0xdf, 0xfa,
+	0xba, 0xdb, 0xea, 0xeb, 0x25, 0x80, 0xb5, 0x5f, 0xd7, 0x35, 0xb8, 0xa8, 0x42, 0xfb, 0x88, 0x9a,
+	0x19, 0x88, 0x33, 0x2e, 0xb8, 0x41, 0xff, 0xd6, 0x06, 0xf3, 0x73, 0x66, 0xe0, 0x75, 0x89, 0x35,
+	0xcc, 0xeb, 0x75, 0x8a, 0xe7, 0x5b, 0x3b, 0xdd, 0xdb, 0xbd, 0xd3, 0x27, 0x57, 0xcb, 0xc0, 0xbb,
+	0x5e, 0x06, 0xde, 0x9f, 0x65, 0xe0, 0x7d, 0x5b, 0x05, 0x9d, 0xeb, 0x55, 0xd0, 0xf9, 0xb9, 0x0a,
+	0x3a, 0xef, 0xee, 0xa5, 0xdc, 0x7c, 0xf8, 0xb4, 0x08, 0x13, 0x25, 0xa2, 0xda, 0x0e, 0x7f, 0xa9,
+	0xb6, 0xd8, 0x5c, 0xe6, 0x80, 0x8b, 0xbe, 0xdd, 0xd6, 0x87, 0xff, 0x06, 0x00, 0x2f, 0xe7, 0x05,
+	0x70, 0x31, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -299,6 +322,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.PermissionlessRegistration {
 		i--
 		if m.PermissionlessRegistration {
@@ -380,6 +431,18 @@ func (m *Params) Size() (n int) {
 	if m.PermissionlessRegistration {
 		n += 2
 	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -673,6 +736,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.PermissionlessRegistration = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -49,6 +49,100 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: true,
 		},
+		{
+			name: "valid genesis - with fee tokens",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					EnableErc20: true,
+					FeeTokens: []types.FeeToken{
+						{Denom: "usdt", Price: math.LegacyNewDec(2)},
+						{Denom: "usdc", Price: math.LegacyZeroDec()},
+					},
+				},
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "usdt",
+						Enabled:       true,
+						ContractOwner: types.OWNER_MODULE,
+					},
+					{
+						Erc20Address:  "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+						Denom:         "usdc",
+						Enabled:       true,
+						ContractOwner: types.OWNER_MODULE,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - fee token without token pair",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					EnableErc20: true,
+					FeeTokens:   []types.FeeToken{{Denom: "usdt", Price: math.LegacyNewDec(2)}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - native ERC20 fee token",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					EnableErc20: true,
+					FeeTokens:   []types.FeeToken{{Denom: "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7", Price: math.LegacyNewDec(2)}},
+				},
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Enabled:       true,
+						ContractOwner: types.OWNER_EXTERNAL,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated fee token",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					EnableErc20: true,
+					FeeTokens: []types.FeeToken{
+						{Denom: "usdt", Price: math.LegacyNewDec(2)},
+						{Denom: "usdt", Price: math.LegacyNewDec(3)},
+					},
+				},
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "usdt",
+						Enabled:       true,
+						ContractOwner: types.OWNER_MODULE,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - negative fee token price",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					EnableErc20: true,
+					FeeTokens:   []types.FeeToken{{Denom: "usdt", Price: math.LegacyNewDec(-1)}},
+				},
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:         "usdt",
+						Enabled:       true,
+						ContractOwner: types.OWNER_MODULE,
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "valid genesis - with tokens pairs",
 			genState: &types.GenesisState{
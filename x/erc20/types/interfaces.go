@@ -17,6 +17,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -53,10 +54,20 @@ type EVMKeeper interface {
 	IsContract(ctx sdk.Context, address common.Address) bool
 }
 
+// FeeTokenPriceOracle defines the expected interface of the price source used
+// for the fee tokens that don't have a fixed price in the params.
+type FeeTokenPriceOracle interface {
+	// GetFeeTokenPrice returns the amount of the EVM denom, in its original
+	// decimals, that is equivalent to one unit of the given fee token.
+	GetFeeTokenPrice(ctx sdk.Context, denom string) (math.LegacyDec, error)
+}
+
 type Erc20Keeper interface {
 	OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, ack exported.Acknowledgement) exported.Acknowledgement
 	OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error
 	OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error
+	ForwardPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, forwarder sdk.AccAddress, metadata ForwardMetadata) exported.Acknowledgement
+	UpdateRecvPacketInflow(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error
 	Logger(ctx sdk.Context) log.Logger
 }
 
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -34,6 +37,10 @@ const (
 	prefixAllowance
 	prefixNativePrecompiles
 	prefixDynamicPrecompiles
+	prefixFeeToken
+	prefixInFlightPacket
+	prefixRateLimit
+	prefixRateLimitFlow
 )
 
 // KVStore key prefixes
@@ -45,6 +52,10 @@ var (
 	KeyPrefixAllowance          = []byte{prefixAllowance}
 	KeyPrefixNativePrecompiles  = []byte{prefixNativePrecompiles}
 	KeyPrefixDynamicPrecompiles = []byte{prefixDynamicPrecompiles}
+	KeyPrefixFeeToken           = []byte{prefixFeeToken}
+	KeyPrefixInFlightPacket     = []byte{prefixInFlightPacket}
+	KeyPrefixRateLimit          = []byte{prefixRateLimit}
+	KeyPrefixRateLimitFlow      = []byte{prefixRateLimitFlow}
 )
 
 func AllowanceKey(
@@ -54,3 +65,21 @@ func AllowanceKey(
 ) []byte {
 	return append(append(erc20.Bytes(), owner.Bytes()...), spender.Bytes()...)
 }
+
+// InFlightPacketKey returns the key of the in-flight packet forwarded with the
+// given port, channel and sequence.
+func InFlightPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/", portID, channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// RateLimitKey returns the key of the rate limit, or of its flow, for the given
+// channel and denom.
+func RateLimitKey(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", channelID, denom))
+}
+
+// RateLimitChannelPrefix returns the key prefix of the rate limits, or of their
+// flows, for the given channel.
+func RateLimitChannelPrefix(channelID string) []byte {
+	return []byte(channelID + "/")
+}
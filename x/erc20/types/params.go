@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Parameter store key
 var (
 	ParamStoreKeyEnableErc20                = []byte("EnableErc20") // figure out where this is initialized
@@ -27,3 +33,46 @@ func DefaultParams() Params {
 		PermissionlessRegistration: true,
 	}
 }
+
+// Validate performs a basic validation of the erc20 params.
+func (p Params) Validate() error {
+	seenDenoms := make(map[string]bool, len(p.FeeTokens))
+	for _, feeToken := range p.FeeTokens {
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[feeToken.Denom] {
+			return fmt.Errorf("duplicated fee token denom: %s", feeToken.Denom)
+		}
+		seenDenoms[feeToken.Denom] = true
+	}
+
+	seenRateLimits := make(map[string]bool, len(p.RateLimits))
+	for _, rateLimit := range p.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		if seenRateLimits[rateLimit.Key()] {
+			return fmt.Errorf("duplicated rate limit: %s", rateLimit.Key())
+		}
+		seenRateLimits[rateLimit.Key()] = true
+	}
+	return nil
+}
+
+// Validate checks that the fee token has a valid denom and a non-negative price.
+func (ft FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(ft.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+	if ft.Price.IsNil() || ft.Price.IsNegative() {
+		return fmt.Errorf("invalid price for fee token %s: %s", ft.Denom, ft.Price)
+	}
+	return nil
+}
+
+// HasFixedPrice returns true if the fee token price is set in the params
+// instead of being provided by the fee token price oracle.
+func (ft FeeToken) HasFixedPrice() bool {
+	return ft.Price.IsPositive()
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Flow directions of the rate limited tokens
+const (
+	FlowInflow  = "inflow"
+	FlowOutflow = "outflow"
+)
+
+// Validate checks that the rate limit has a valid route, a valid denom or
+// contract address, non-negative quotas with at least one enabled and a
+// positive window.
+func (rl RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(rl.ChannelId); err != nil {
+		if clientErr := host.ClientIdentifierValidator(rl.ChannelId); clientErr != nil {
+			return fmt.Errorf("invalid rate limit channel or client ID %s: %w", rl.ChannelId, err)
+		}
+	}
+	if !common.IsHexAddress(rl.Denom) {
+		if err := sdk.ValidateDenom(rl.Denom); err != nil {
+			return fmt.Errorf("invalid rate limit denom: %w", err)
+		}
+	}
+	if rl.MaxInflow.IsNil() || rl.MaxInflow.IsNegative() {
+		return fmt.Errorf("invalid max inflow for rate limit %s: %s", rl.Key(), rl.MaxInflow)
+	}
+	if rl.MaxOutflow.IsNil() || rl.MaxOutflow.IsNegative() {
+		return fmt.Errorf("invalid max outflow for rate limit %s: %s", rl.Key(), rl.MaxOutflow)
+	}
+	if rl.MaxInflow.IsZero() && rl.MaxOutflow.IsZero() {
+		return fmt.Errorf("rate limit %s has neither an inflow nor an outflow quota", rl.Key())
+	}
+	if rl.Window <= 0 {
+		return fmt.Errorf("invalid window for rate limit %s: %s", rl.Key(), rl.Window)
+	}
+	return nil
+}
+
+// Key returns the identifier of the rate limit, made of its channel and denom.
+func (rl RateLimit) Key() string {
+	return fmt.Sprintf("%s/%s", rl.ChannelId, rl.Denom)
+}
+
+// Quota returns the maximum amount of the given flow direction. A zero amount
+// means that the direction is not rate limited.
+func (rl RateLimit) Quota(direction string) math.Int {
+	if direction == FlowInflow {
+		return rl.MaxInflow
+	}
+	return rl.MaxOutflow
+}
+
+// NewRateLimitFlow returns an empty flow whose window starts at the given time.
+func NewRateLimitFlow(windowStart time.Time) RateLimitFlow {
+	return RateLimitFlow{
+		WindowStart:     windowStart,
+		Inflow:          math.ZeroInt(),
+		Outflow:         math.ZeroInt(),
+		PreviousInflow:  math.ZeroInt(),
+		PreviousOutflow: math.ZeroInt(),
+	}
+}
+
+// Advance moves the flow to the window that contains the given time. The
+// amounts of the current window become the amounts of the previous one, or are
+// dropped if more than one window elapsed.
+func (f RateLimitFlow) Advance(now time.Time, window time.Duration) RateLimitFlow {
+	if window <= 0 || now.Before(f.WindowStart) {
+		return f
+	}
+
+	elapsed := int64(now.Sub(f.WindowStart) / window)
+	switch elapsed {
+	case 0:
+		return f
+	case 1:
+		f.PreviousInflow, f.PreviousOutflow = f.Inflow, f.Outflow
+	default:
+		f.PreviousInflow, f.PreviousOutflow = math.ZeroInt(), math.ZeroInt()
+	}
+	f.Inflow, f.Outflow = math.ZeroInt(), math.ZeroInt()
+	f.WindowStart = f.WindowStart.Add(time.Duration(elapsed) * window)
+	return f
+}
+
+// Usage returns the amounts received and sent over the rolling window that
+// ends at the given time. The amounts of the previous window are weighted by
+// the share of the rolling window that overlaps it.
+//
+// CONTRACT: the flow must have been advanced to the given time.
+func (f RateLimitFlow) Usage(now time.Time, window time.Duration) (inflow, outflow math.Int) {
+	elapsed := now.Sub(f.WindowStart)
+	if elapsed < 0 || elapsed >= window {
+		return f.Inflow, f.Outflow
+	}
+
+	overlap := int64(window - elapsed)
+	weigh := func(amount math.Int) math.Int {
+		return math.LegacyNewDecFromInt(amount).MulInt64(overlap).QuoInt64(int64(window)).Ceil().TruncateInt()
+	}
+	return f.Inflow.Add(weigh(f.PreviousInflow)), f.Outflow.Add(weigh(f.PreviousOutflow))
+}
+
+// Add returns the flow with the given amount added to the current window in
+// the given direction.
+func (f RateLimitFlow) Add(direction string, amount math.Int) RateLimitFlow {
+	if direction == FlowInflow {
+		f.Inflow = f.Inflow.Add(amount)
+	} else {
+		f.Outflow = f.Outflow.Add(amount)
+	}
+	return f
+}
@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/erc20/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	valid := types.RateLimit{
+		ChannelId:  "channel-0",
+		Denom:      "usdt",
+		MaxInflow:  math.NewInt(100),
+		MaxOutflow: math.ZeroInt(),
+		Window:     time.Hour,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.RateLimit)
+		expPass  bool
+	}{
+		{"valid", func(*types.RateLimit) {}, true},
+		{"valid - client ID", func(rl *types.RateLimit) { rl.ChannelId = "07-tendermint-0" }, true},
+		{"valid - ERC20 address", func(rl *types.RateLimit) { rl.Denom = "0xdac17f958d2ee523a2206206994597c13d831ec7" }, true},
+		{"invalid - empty channel", func(rl *types.RateLimit) { rl.ChannelId = "" }, false},
+		{"invalid - denom", func(rl *types.RateLimit) { rl.Denom = "1usdt" }, false},
+		{"invalid - nil quota", func(rl *types.RateLimit) { rl.MaxOutflow = math.Int{} }, false},
+		{"invalid - negative quota", func(rl *types.RateLimit) { rl.MaxInflow = math.NewInt(-1) }, false},
+		{"invalid - no quota", func(rl *types.RateLimit) { rl.MaxInflow = math.ZeroInt() }, false},
+		{"invalid - zero window", func(rl *types.RateLimit) { rl.Window = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rateLimit := valid
+			tc.malleate(&rateLimit)
+
+			err := rateLimit.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateDuplicatedRateLimit(t *testing.T) {
+	rateLimit := types.RateLimit{
+		ChannelId:  "channel-0",
+		Denom:      "usdt",
+		MaxInflow:  math.NewInt(100),
+		MaxOutflow: math.NewInt(100),
+		Window:     time.Hour,
+	}
+
+	params := types.DefaultParams()
+	params.RateLimits = []types.RateLimit{rateLimit}
+	require.NoError(t, params.Validate())
+
+	params.RateLimits = append(params.RateLimits, rateLimit)
+	require.ErrorContains(t, params.Validate(), "duplicated rate limit")
+}
+
+func TestRateLimitFlowRollingWindow(t *testing.T) {
+	window := time.Hour
+	start := time.Unix(1_700_000_000, 0).UTC()
+
+	flow := types.NewRateLimitFlow(start).
+		Add(types.FlowInflow, math.NewInt(40)).
+		Add(types.FlowOutflow, math.NewInt(100))
+
+	// within the first window
+	now := start.Add(30 * time.Minute)
+	flow = flow.Advance(now, window)
+	inflow, outflow := flow.Usage(now, window)
+	require.Equal(t, math.NewInt(40), inflow)
+	require.Equal(t, math.NewInt(100), outflow)
+
+	// a quarter into the next window, three quarters of the previous window
+	// still overlap the rolling window
+	now = start.Add(window + 15*time.Minute)
+	flow = flow.Advance(now, window)
+	require.Equal(t, start.Add(window), flow.WindowStart)
+	flow = flow.Add(types.FlowOutflow, math.NewInt(10))
+	inflow, outflow = flow.Usage(now, window)
+	require.Equal(t, math.NewInt(30), inflow)
+	require.Equal(t, math.NewInt(85), outflow)
+
+	// the amounts of the previous window are rounded up
+	now = start.Add(window + 59*time.Minute)
+	flow = flow.Advance(now, window)
+	inflow, _ = flow.Usage(now, window)
+	require.Equal(t, math.OneInt(), inflow)
+
+	// the current window becomes the previous one
+	now = start.Add(2*window + 30*time.Minute)
+	flow = flow.Advance(now, window)
+	inflow, outflow = flow.Usage(now, window)
+	require.True(t, inflow.IsZero())
+	require.Equal(t, math.NewInt(5), outflow)
+
+	// everything is dropped once more than a window elapsed
+	now = start.Add(5 * window)
+	flow = flow.Advance(now, window)
+	require.Equal(t, now, flow.WindowStart)
+	inflow, outflow = flow.Usage(now, window)
+	require.True(t, inflow.IsZero())
+	require.True(t, outflow.IsZero())
+}
@@ -16,7 +16,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ ibcapi.IBCModule = &IBCMiddleware{}
+var (
+	_ ibcapi.IBCModule             = &IBCMiddleware{}
+	_ ibcapi.PacketDataUnmarshaler = &IBCMiddleware{}
+)
 
 // IBCMiddleware implements the ICS26 callbacks for the transfer middleware given
 // the erc20 keeper and the underlying application.
@@ -62,6 +65,8 @@ func (im IBCMiddleware) OnSendPacket(
 // representation.
 // If the acknowledgement fails, this callback will default to the ibc-core
 // packet callback.
+// The packet fails if the received tokens exceed an inflow rate limit of the
+// destination client.
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	sourceClient string,
@@ -70,16 +75,29 @@ func (im IBCMiddleware) OnRecvPacket(
 	payload channeltypesv2.Payload,
 	relayer sdk.AccAddress,
 ) channeltypesv2.RecvPacketResult {
-	recvResult := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
-	if recvResult.Status == channeltypesv2.PacketStatus_Failure {
-		return recvResult
-	}
 	packet, err := v2ToV1Packet(payload, sourceClient, destinationClient, sequence)
 	if err != nil {
 		return channeltypesv2.RecvPacketResult{
 			Status: channeltypesv2.PacketStatus_Failure,
 		}
 	}
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+	if err := im.keeper.UpdateRecvPacketInflow(ctx, packet, data); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("erc20 middleware OnRecvPacket rejected the packet: %s", err.Error()))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+
+	recvResult := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if recvResult.Status == channeltypesv2.PacketStatus_Failure {
+		return recvResult
+	}
 	var ack channeltypes.Acknowledgement
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(recvResult.Acknowledgement, &ack); err != nil {
 		return channeltypesv2.RecvPacketResult{
@@ -159,6 +177,17 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	return im.keeper.OnTimeoutPacket(ctx, packet, data)
 }
 
+// UnmarshalPacketData defers to the underlying app to unmarshal the packet data.
+// It allows middlewares wrapping the erc20 middleware, such as the callbacks
+// middleware, to inspect the ICS20 payloads.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	unmarshaler, ok := im.app.(ibcapi.PacketDataUnmarshaler)
+	if !ok {
+		return nil, fmt.Errorf("underlying application does not implement %T", (*ibcapi.PacketDataUnmarshaler)(nil))
+	}
+	return unmarshaler.UnmarshalPacketData(payload)
+}
+
 func v2ToV1Packet(payload channeltypesv2.Payload, sourceClient, destinationClient string, sequence uint64) (channeltypes.Packet, error) {
 	transferRepresentation, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
 	if err != nil {
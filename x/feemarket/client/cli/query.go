@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/evm/x/feemarket/types"
@@ -23,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetFeeHistoryCmd(),
 	)
 	return cmd
 }
@@ -114,3 +117,48 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetFeeHistoryCmd queries the fee market history of a range of blocks
+func GetFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history BLOCK_COUNT [LAST_BLOCK]",
+		Short: "Get the base fee and gas usage recorded for a range of blocks",
+		Long: `Get the base fee and gas usage recorded for the BLOCK_COUNT blocks ending at LAST_BLOCK.
+If the last block is not provided, it will use the latest recorded block.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockCount, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var lastBlock int64
+			if len(args) == 2 {
+				lastBlock, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeHistory(cmd.Context(), &types.QueryFeeHistoryRequest{
+				LastBlock:  lastBlock,
+				BlockCount: blockCount,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -74,10 +74,12 @@ func (k *Keeper) EndBlock(ctx sdk.Context) error {
 	// gasWanted = max(gasWanted * MinGasMultiplier, gasUsed)
 	// this will be keep BaseFee protected from un-penalized manipulation
 	// more info here https://github.com/evmos/ethermint/pull/1105#discussion_r888798925
-	minGasMultiplier := k.GetParams(ctx).MinGasMultiplier
+	params := k.GetParams(ctx)
+	minGasMultiplier := params.MinGasMultiplier
 	limitedGasWanted := math.LegacyNewDec(int64(gasWanted)).Mul(minGasMultiplier)
 	updatedGasWanted := math.LegacyMaxDec(limitedGasWanted, math.LegacyNewDec(int64(gasUsed))).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, updatedGasWanted)
+	k.recordFeeHistory(ctx, params, gasUsed)
 
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "feemarket", "block_gas") //nolint:staticcheck // TODO: fix
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ----------------------------------------------------------------------------
+// Fee History
+// Bounded per-block record of the base fee and gas usage, used to serve
+// eth_feeHistory without replaying CometBFT block results.
+// ----------------------------------------------------------------------------
+
+// SetBlockFeeHistory stores the fee history entry for the entry's block height.
+func (k Keeper) SetBlockFeeHistory(ctx sdk.Context, entry types.BlockFeeHistory) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&entry)
+	store.Set(types.FeeHistoryKey(entry.Height), bz)
+}
+
+// GetBlockFeeHistory returns the fee history entry for the given block height
+// and a boolean indicating whether it was found.
+func (k Keeper) GetBlockFeeHistory(ctx sdk.Context, height int64) (types.BlockFeeHistory, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeHistoryKey(height))
+	if bz == nil {
+		return types.BlockFeeHistory{}, false
+	}
+
+	var entry types.BlockFeeHistory
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry, true
+}
+
+// IterateFeeHistory iterates over all the stored fee history entries in
+// ascending height order and calls the given callback for each of them. The
+// iteration stops if the callback returns true.
+func (k Keeper) IterateFeeHistory(ctx sdk.Context, cb func(entry types.BlockFeeHistory) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.BlockFeeHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// GetAllFeeHistory returns all the stored fee history entries in ascending
+// height order.
+func (k Keeper) GetAllFeeHistory(ctx sdk.Context) []types.BlockFeeHistory {
+	var entries []types.BlockFeeHistory
+	k.IterateFeeHistory(ctx, func(entry types.BlockFeeHistory) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
+
+// GetFeeHistory returns up to blockCount fee history entries ending at
+// lastBlock (inclusive), in ascending height order. Heights that are not
+// recorded are skipped.
+func (k Keeper) GetFeeHistory(ctx sdk.Context, lastBlock int64, blockCount uint64) []types.BlockFeeHistory {
+	if lastBlock <= 0 || blockCount == 0 {
+		return nil
+	}
+
+	firstBlock := int64(1)
+	if blockCount < uint64(lastBlock) { // #nosec G115 -- lastBlock is positive
+		firstBlock = lastBlock - int64(blockCount) + 1 // #nosec G115 -- blockCount is lower than lastBlock
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FeeHistoryKey(firstBlock), types.FeeHistoryKey(lastBlock+1))
+	defer iterator.Close()
+
+	var entries []types.BlockFeeHistory
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.BlockFeeHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// GetLatestFeeHistoryHeight returns the height of the most recent fee history
+// entry, or zero if none is stored.
+func (k Keeper) GetLatestFeeHistoryHeight(ctx sdk.Context) int64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(iterator.Key())) // #nosec G115 -- keys are built from non-negative heights
+}
+
+// PruneFeeHistory deletes all the fee history entries that fall outside of the
+// window ending at the current block height.
+func (k Keeper) PruneFeeHistory(ctx sdk.Context, window uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	// a zero window drops the whole history
+	end := storetypes.PrefixEndBytes(types.KeyPrefixFeeHistory)
+	if window > 0 {
+		if window >= uint64(ctx.BlockHeight()) { // #nosec G115 -- block height is never negative
+			return
+		}
+		end = types.FeeHistoryKey(ctx.BlockHeight() - int64(window) + 1) // #nosec G115 -- window is lower than the block height
+	}
+
+	iterator := store.Iterator(types.KeyPrefixFeeHistory, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// recordFeeHistory stores the base fee and gas usage of the current block and
+// drops the entries that fell out of the configured window.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) recordFeeHistory(ctx sdk.Context, params types.Params, gasUsed uint64) {
+	if !params.IsFeeHistoryEnabled() {
+		// drop any history left over from a previously configured window
+		k.PruneFeeHistory(ctx, 0)
+		return
+	}
+
+	baseFee := k.GetBaseFee(ctx)
+	if baseFee.IsNil() {
+		baseFee = sdkmath.LegacyZeroDec()
+	}
+
+	gasLimit := blockGasLimit(ctx)
+	k.SetBlockFeeHistory(ctx, types.BlockFeeHistory{
+		Height:    ctx.BlockHeight(),
+		BaseFee:   baseFee,
+		GasUsed:   gasUsed,
+		GasTarget: gasLimit / uint64(params.ElasticityMultiplier),
+		GasLimit:  gasLimit,
+		BlockTime: uint64(ctx.BlockTime().Unix()), // #nosec G115 -- block time is after the unix epoch
+	})
+
+	k.PruneFeeHistory(ctx, params.FeeHistoryWindow)
+}
+
+// blockGasLimit returns the block gas limit from the consensus params. A
+// MaxGas equal to -1 means that block gas is unlimited.
+func blockGasLimit(ctx sdk.Context) uint64 {
+	consParams := ctx.ConsensusParams()
+	if consParams.Block != nil && consParams.Block.MaxGas > -1 {
+		return uint64(consParams.Block.MaxGas)
+	}
+	return math.MaxUint64
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/evm/x/feemarket/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -47,3 +50,32 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.BlockCount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "block count cannot be zero")
+	}
+	if req.LastBlock < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid last block %d", req.LastBlock)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	lastBlock := req.LastBlock
+	if lastBlock == 0 {
+		lastBlock = k.GetLatestFeeHistoryHeight(ctx)
+	}
+
+	blockCount := req.BlockCount
+	if window := k.GetParams(ctx).FeeHistoryWindow; blockCount > window {
+		blockCount = window
+	}
+
+	return &types.QueryFeeHistoryResponse{
+		FeeHistory: k.GetFeeHistory(ctx, lastBlock, blockCount),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"github.com/cosmos/evm/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2. The fee
+// history window was added in version 2, so it is zero in the params stored
+// by version 1 and the fee market history is enabled with the default window.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.FeeHistoryWindow = types.DefaultFeeHistoryWindow
+	return m.keeper.SetParams(ctx, params)
+}
@@ -46,6 +46,10 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// fee_history_window defines the number of most recent blocks for which the
+	// base fee and gas usage are kept in state to serve fee history queries. A
+	// value of 0 disables the fee history tracking.
+	FeeHistoryWindow uint64 `protobuf:"varint,9,opt,name=fee_history_window,json=feeHistoryWindow,proto3" json:"fee_history_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -109,8 +113,101 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeHistoryWindow() uint64 {
+	if m != nil {
+		return m.FeeHistoryWindow
+	}
+	return 0
+}
+
+// BlockFeeHistory defines the fee market data recorded for a single block.
+type BlockFeeHistory struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the EIP-1559 base fee of the block
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// gas_used is the total amount of gas consumed by the block
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_target is the amount of gas the base fee calculation targets for the
+	// block, i.e. gas_limit / elasticity_multiplier
+	GasTarget uint64 `protobuf:"varint,4,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+	// gas_limit is the maximum amount of gas the block could consume
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// block_time is the timestamp of the block, in seconds since the unix epoch
+	BlockTime uint64 `protobuf:"varint,6,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+}
+
+func (m *BlockFeeHistory) Reset()         { *m = BlockFeeHistory{} }
+func (m *BlockFeeHistory) String() string { return proto.CompactTextString(m) }
+func (*BlockFeeHistory) ProtoMessage()    {}
+func (*BlockFeeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc4153d77de08e0, []int{1}
+}
+func (m *BlockFeeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFeeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFeeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFeeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFeeHistory.Merge(m, src)
+}
+func (m *BlockFeeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFeeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFeeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFeeHistory proto.InternalMessageInfo
+
+func (m *BlockFeeHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasTarget() uint64 {
+	if m != nil {
+		return m.GasTarget
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetBlockTime() uint64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.evm.feemarket.v1.Params")
+	proto.RegisterType((*BlockFeeHistory)(nil), "cosmos.evm.feemarket.v1.BlockFeeHistory")
 }
 
 func init() {
@@ -118,34 +215,42 @@ func init() {
 }
 
 var fileDescriptor_0fc4153d77de08e0 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0x6e, 0xd4, 0x40,
-	0x10, 0xf5, 0x72, 0xc9, 0xc5, 0xd9, 0x70, 0xd2, 0xb1, 0x0a, 0xc2, 0x4a, 0x84, 0x63, 0x85, 0x22,
-	0x56, 0x0a, 0x5b, 0x51, 0x3a, 0x24, 0x0a, 0x2e, 0x11, 0x20, 0x14, 0xa4, 0xc8, 0x05, 0x05, 0xcd,
-	0x6a, 0xed, 0x4c, 0xec, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x63, 0x71, 0xbf, 0x40, 0xc5, 0x67, 0x50,
-	0xe6, 0x33, 0x52, 0xa6, 0x44, 0x14, 0x11, 0xba, 0x2b, 0x8e, 0xcf, 0x40, 0xe7, 0xe5, 0xce, 0xd7,
-	0x50, 0x5c, 0x63, 0x8d, 0xdf, 0x7b, 0xf3, 0x34, 0x3b, 0xf3, 0xf0, 0x51, 0xa6, 0xb4, 0x50, 0x3a,
-	0x86, 0x46, 0xc4, 0xd7, 0x00, 0x82, 0xd5, 0x37, 0x60, 0xe2, 0xe6, 0xa4, 0xfb, 0x89, 0xaa, 0x5a,
-	0x19, 0x45, 0x5e, 0x58, 0x61, 0x04, 0x8d, 0x88, 0x3a, 0xae, 0x39, 0xd9, 0x7b, 0xc6, 0x04, 0x97,
-	0x2a, 0x6e, 0xbf, 0x56, 0xbb, 0xb7, 0x9b, 0xab, 0x5c, 0xb5, 0x65, 0x3c, 0xaf, 0x2c, 0x7a, 0xf8,
-	0xa7, 0x87, 0xfb, 0x97, 0xac, 0x66, 0x42, 0x13, 0x1f, 0xef, 0x48, 0x45, 0x53, 0xa6, 0x81, 0x5e,
-	0x03, 0x78, 0x28, 0x40, 0xa1, 0x9b, 0x6c, 0x4b, 0x35, 0x62, 0x1a, 0xde, 0x01, 0x90, 0x37, 0x78,
-	0x7f, 0x41, 0xd2, 0xac, 0x60, 0x32, 0x07, 0x7a, 0x05, 0x52, 0x09, 0x2e, 0x99, 0x51, 0xb5, 0xf7,
-	0x24, 0x40, 0xe1, 0x20, 0xf1, 0x52, 0xab, 0x3e, 0x6b, 0x05, 0xe7, 0x1d, 0x4f, 0x4e, 0xf1, 0x73,
-	0x28, 0x99, 0x36, 0x3c, 0xe3, 0x66, 0x4c, 0xc5, 0x6d, 0x69, 0x78, 0x55, 0x72, 0xa8, 0xbd, 0x5e,
-	0xdb, 0xb8, 0xdb, 0x91, 0x9f, 0x96, 0x1c, 0x79, 0x85, 0x07, 0x20, 0x59, 0x5a, 0x02, 0x2d, 0x80,
-	0xe7, 0x85, 0xf1, 0x36, 0x03, 0x14, 0xf6, 0x92, 0xa7, 0x16, 0xfc, 0xd0, 0x62, 0xe4, 0x0c, 0xbb,
-	0xcb, 0xa9, 0xfb, 0x01, 0x0a, 0xb7, 0x47, 0xe1, 0xfd, 0xe3, 0x81, 0xf3, 0xeb, 0xf1, 0x60, 0xdf,
-	0xee, 0x47, 0x5f, 0xdd, 0x44, 0x5c, 0xc5, 0x82, 0x99, 0x22, 0xba, 0x80, 0x9c, 0x65, 0xe3, 0x73,
-	0xc8, 0x7e, 0xcc, 0xee, 0x8e, 0x51, 0xb2, 0xf5, 0x6f, 0x5e, 0x72, 0x81, 0x07, 0x82, 0x4b, 0x9a,
-	0x33, 0x4d, 0xab, 0x9a, 0x67, 0xe0, 0x6d, 0xad, 0xe9, 0xb4, 0x23, 0xb8, 0x7c, 0xcf, 0xf4, 0xe5,
-	0xbc, 0x99, 0x7c, 0xc6, 0x64, 0xe1, 0xb6, 0xf2, 0x52, 0x77, 0x4d, 0xcb, 0xa1, 0xb5, 0xec, 0xf6,
-	0xf1, 0xfa, 0xf0, 0xdb, 0xec, 0xee, 0xf8, 0xe5, 0x4a, 0x3c, 0xbe, 0xae, 0x04, 0xc4, 0xde, 0xf1,
-	0xe3, 0x86, 0xbb, 0x31, 0xdc, 0x4c, 0x86, 0x5c, 0x72, 0xc3, 0x59, 0xb9, 0x3c, 0xe8, 0xe8, 0xed,
-	0xfd, 0xc4, 0x47, 0x0f, 0x13, 0x1f, 0xfd, 0x9e, 0xf8, 0xe8, 0xfb, 0xd4, 0x77, 0x1e, 0xa6, 0xbe,
-	0xf3, 0x73, 0xea, 0x3b, 0x5f, 0x8e, 0x72, 0x6e, 0x8a, 0xdb, 0x34, 0xca, 0x94, 0x88, 0xff, 0xe3,
-	0x6d, 0xc6, 0x15, 0xe8, 0xb4, 0xdf, 0x86, 0xe6, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x59, 0x74, 0x66, 0xa1, 0x02, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xb4, 0xdb, 0x34, 0x99, 0x5a, 0x8c, 0x43, 0xd5, 0xb5, 0xa5, 0xdb, 0x10, 0x0f, 0x5d,
+	0x8a, 0xec, 0x52, 0x7a, 0x13, 0x3c, 0x98, 0x96, 0x5a, 0x24, 0x42, 0x59, 0xaa, 0x82, 0x97, 0x65,
+	0x76, 0xf3, 0xb2, 0x3b, 0x64, 0x67, 0x27, 0xec, 0x4c, 0x52, 0xf3, 0x17, 0x3c, 0x79, 0xf2, 0x37,
+	0x78, 0xec, 0xcf, 0xe8, 0xb1, 0x47, 0xf1, 0x50, 0x24, 0x11, 0xfa, 0x37, 0x64, 0x67, 0xda, 0x24,
+	0x20, 0x1e, 0xea, 0x65, 0x99, 0xf7, 0x7d, 0xef, 0x7d, 0xcc, 0xbc, 0xef, 0x5b, 0xbc, 0x1b, 0x0b,
+	0xc9, 0x85, 0xf4, 0x61, 0xc4, 0xfd, 0x1e, 0x00, 0xa7, 0x45, 0x1f, 0x94, 0x3f, 0xda, 0x9f, 0x17,
+	0xde, 0xa0, 0x10, 0x4a, 0x90, 0xa7, 0xa6, 0xd1, 0x83, 0x11, 0xf7, 0xe6, 0xdc, 0x68, 0x7f, 0xf3,
+	0x11, 0xe5, 0x2c, 0x17, 0xbe, 0xfe, 0x9a, 0xde, 0xcd, 0x8d, 0x44, 0x24, 0x42, 0x1f, 0xfd, 0xf2,
+	0x64, 0xd0, 0xd6, 0x37, 0x0b, 0x57, 0x4f, 0x69, 0x41, 0xb9, 0x24, 0x0e, 0x5e, 0xcb, 0x45, 0x18,
+	0x51, 0x09, 0x61, 0x0f, 0xc0, 0x46, 0x4d, 0xe4, 0xd6, 0x82, 0x7a, 0x2e, 0xda, 0x54, 0xc2, 0x31,
+	0x00, 0x79, 0x85, 0xb7, 0xee, 0xc8, 0x30, 0x4e, 0x69, 0x9e, 0x40, 0xd8, 0x85, 0x5c, 0x70, 0x96,
+	0x53, 0x25, 0x0a, 0x7b, 0xa9, 0x89, 0xdc, 0xf5, 0xc0, 0x8e, 0x4c, 0xf7, 0xa1, 0x6e, 0x38, 0x9a,
+	0xf3, 0xe4, 0x00, 0x3f, 0x86, 0x8c, 0x4a, 0xc5, 0x62, 0xa6, 0xc6, 0x21, 0x1f, 0x66, 0x8a, 0x0d,
+	0x32, 0x06, 0x85, 0xbd, 0xac, 0x07, 0x37, 0xe6, 0xe4, 0xbb, 0x19, 0x47, 0x9e, 0xe3, 0x75, 0xc8,
+	0x69, 0x94, 0x41, 0x98, 0x02, 0x4b, 0x52, 0x65, 0xaf, 0x34, 0x91, 0xbb, 0x1c, 0x3c, 0x30, 0xe0,
+	0x89, 0xc6, 0xc8, 0x21, 0xae, 0xcd, 0x6e, 0x5d, 0x6d, 0x22, 0xb7, 0xde, 0x76, 0x2f, 0xaf, 0x77,
+	0x2a, 0x3f, 0xaf, 0x77, 0xb6, 0xcc, 0x7e, 0x64, 0xb7, 0xef, 0x31, 0xe1, 0x73, 0xaa, 0x52, 0xaf,
+	0x03, 0x09, 0x8d, 0xc7, 0x47, 0x10, 0x7f, 0xbf, 0xb9, 0xd8, 0x43, 0xc1, 0xea, 0xed, 0x7d, 0x49,
+	0x07, 0xaf, 0x73, 0x96, 0x87, 0x09, 0x95, 0xe1, 0xa0, 0x60, 0x31, 0xd8, 0xab, 0xf7, 0x54, 0x5a,
+	0xe3, 0x2c, 0x7f, 0x43, 0xe5, 0x69, 0x39, 0x4c, 0x3e, 0x60, 0x72, 0xa7, 0xb6, 0xf0, 0xd2, 0xda,
+	0x3d, 0x25, 0x1b, 0x46, 0x72, 0x61, 0x1f, 0x2f, 0x30, 0x29, 0xd7, 0x9f, 0x32, 0xa9, 0x44, 0x31,
+	0x0e, 0xcf, 0x59, 0xde, 0x15, 0xe7, 0x76, 0xbd, 0x89, 0x5c, 0x2b, 0x68, 0xf4, 0x00, 0x4e, 0x0c,
+	0xf1, 0x51, 0xe3, 0x2f, 0x5b, 0x5f, 0x6e, 0x2e, 0xf6, 0xb6, 0x17, 0xc2, 0xf4, 0x79, 0x21, 0x4e,
+	0xc6, 0xf5, 0xb7, 0x56, 0xcd, 0x6a, 0xac, 0x04, 0x0d, 0x96, 0x33, 0xc5, 0x68, 0x36, 0xb3, 0xbf,
+	0xf5, 0x1b, 0xe1, 0x87, 0xed, 0x4c, 0xc4, 0xfd, 0xe3, 0x99, 0x2a, 0x79, 0x82, 0xab, 0xb7, 0x36,
+	0x20, 0x6d, 0x43, 0x35, 0xfd, 0xdb, 0x80, 0xa5, 0xff, 0x35, 0xe0, 0x19, 0xae, 0x95, 0xeb, 0x1a,
+	0x4a, 0xe8, 0xea, 0x48, 0x58, 0xc1, 0x6a, 0x42, 0xe5, 0x7b, 0x09, 0x5d, 0xb2, 0x8d, 0x71, 0x49,
+	0x29, 0x5a, 0x24, 0xa0, 0x6c, 0x4b, 0x93, 0xf5, 0x84, 0xca, 0x33, 0x0d, 0x90, 0x2d, 0x5c, 0x16,
+	0x61, 0xc6, 0x38, 0x33, 0x01, 0xb1, 0x82, 0x52, 0xaa, 0x53, 0xd6, 0xe5, 0x6c, 0x54, 0x3e, 0x23,
+	0x54, 0x8c, 0x9b, 0x78, 0x58, 0x41, 0x5d, 0x23, 0x67, 0x8c, 0x43, 0xfb, 0xf5, 0xe5, 0xc4, 0x41,
+	0x57, 0x13, 0x07, 0xfd, 0x9a, 0x38, 0xe8, 0xeb, 0xd4, 0xa9, 0x5c, 0x4d, 0x9d, 0xca, 0x8f, 0xa9,
+	0x53, 0xf9, 0xb4, 0x9b, 0x30, 0x95, 0x0e, 0x23, 0x2f, 0x16, 0xdc, 0xff, 0xc7, 0x0a, 0xd5, 0x78,
+	0x00, 0x32, 0xaa, 0xea, 0x3f, 0xe9, 0xe0, 0xcf, 0x00, 0x5e, 0xcf, 0xea, 0xca, 0xb6, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +273,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FeeHistoryWindow != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeeHistoryWindow))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -226,6 +336,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockFeeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFeeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFeeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasTarget != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasTarget))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -261,6 +429,35 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.FeeHistoryWindow != 0 {
+		n += 1 + sovFeemarket(uint64(m.FeeHistoryWindow))
+	}
+	return n
+}
+
+func (m *BlockFeeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasTarget != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasTarget))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovFeemarket(uint64(m.BlockTime))
+	}
 	return n
 }
 
@@ -478,6 +675,204 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistoryWindow", wireType)
+			}
+			m.FeeHistoryWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistoryWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFeeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFeeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFeeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
+			}
+			m.GasTarget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasTarget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -19,5 +21,24 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if uint64(len(gs.FeeHistory)) > gs.Params.FeeHistoryWindow {
+		return fmt.Errorf("fee history length %d exceeds window %d", len(gs.FeeHistory), gs.Params.FeeHistoryWindow)
+	}
+
+	var prevHeight int64
+	for _, entry := range gs.FeeHistory {
+		if entry.Height <= prevHeight {
+			return fmt.Errorf("fee history heights must be positive and strictly increasing, got %d after %d", entry.Height, prevHeight)
+		}
+		if entry.BaseFee.IsNil() || entry.BaseFee.IsNegative() {
+			return fmt.Errorf("invalid base fee for fee history height %d: %s", entry.Height, entry.BaseFee)
+		}
+		prevHeight = entry.Height
+	}
+
+	return nil
 }
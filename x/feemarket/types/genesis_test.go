@@ -30,6 +30,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				nil,
 			},
 			true,
 		},
@@ -41,6 +42,57 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			),
 			true,
 		},
+		{
+			"valid genesis with fee history",
+			&GenesisState{
+				Params:   DefaultParams(),
+				BlockGas: 1,
+				FeeHistory: []BlockFeeHistory{
+					{Height: 1, BaseFee: DefaultBaseFee, GasUsed: 21000, GasTarget: 50, GasLimit: 100},
+					{Height: 2, BaseFee: DefaultBaseFee, GasUsed: 0, GasTarget: 50, GasLimit: 100},
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis with unordered fee history",
+			&GenesisState{
+				Params:   DefaultParams(),
+				BlockGas: 1,
+				FeeHistory: []BlockFeeHistory{
+					{Height: 2, BaseFee: DefaultBaseFee},
+					{Height: 1, BaseFee: DefaultBaseFee},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis with nil fee history base fee",
+			&GenesisState{
+				Params:     DefaultParams(),
+				BlockGas:   1,
+				FeeHistory: []BlockFeeHistory{{Height: 1}},
+			},
+			false,
+		},
+		{
+			"invalid genesis with fee history exceeding the window",
+			&GenesisState{
+				Params: Params{
+					BaseFeeChangeDenominator: 8,
+					ElasticityMultiplier:     2,
+					BaseFee:                  DefaultBaseFee,
+					MinGasPrice:              DefaultMinGasPrice,
+					MinGasMultiplier:         DefaultMinGasMultiplier,
+					FeeHistoryWindow:         1,
+				},
+				FeeHistory: []BlockFeeHistory{
+					{Height: 1, BaseFee: DefaultBaseFee},
+					{Height: 2, BaseFee: DefaultBaseFee},
+				},
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -16,6 +20,7 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixFeeHistory
 )
 
 const (
@@ -25,9 +30,17 @@ const (
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixFeeHistory     = []byte{prefixFeeHistory}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
 )
+
+// FeeHistoryKey returns the key under which the fee history entry of the given
+// block height is stored. Heights are big endian encoded to keep the entries
+// sorted when iterating.
+func FeeHistoryKey(height int64) []byte {
+	return append([]byte{prefixFeeHistory}, sdk.Uint64ToBigEndian(uint64(height))...) // #nosec G115 -- block heights are never negative
+}
@@ -19,6 +19,11 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultFeeHistoryWindow is the number of blocks for which the fee market
+	// history is kept in state by default
+	DefaultFeeHistoryWindow = uint64(1024)
+	// MaxFeeHistoryWindow bounds the fee market history kept in state
+	MaxFeeHistoryWindow = uint64(100_000)
 
 	ParamsKey = []byte("Params")
 )
@@ -54,6 +59,7 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		FeeHistoryWindow:         DefaultFeeHistoryWindow,
 	}
 }
 
@@ -79,6 +85,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if p.FeeHistoryWindow > MaxFeeHistoryWindow {
+		return fmt.Errorf("fee history window cannot be greater than %d: %d", MaxFeeHistoryWindow, p.FeeHistoryWindow)
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -86,6 +96,11 @@ func (p Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// IsFeeHistoryEnabled returns true if the fee market history is recorded.
+func (p Params) IsFeeHistoryEnabled() bool {
+	return p.FeeHistoryWindow > 0
+}
+
 func validateMinGasPrice(gasPrice math.LegacyDec) error {
 	if gasPrice.IsNil() {
 		return fmt.Errorf("invalid parameter: nil")
@@ -275,6 +275,110 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryFeeHistoryRequest defines the request type for querying the fee market
+// history of a range of blocks.
+type QueryFeeHistoryRequest struct {
+	// last_block is the most recent block height of the requested range. If it
+	// is zero, the latest recorded block is used.
+	LastBlock int64 `protobuf:"varint,1,opt,name=last_block,json=lastBlock,proto3" json:"last_block,omitempty"`
+	// block_count is the number of blocks requested, ending at last_block.
+	BlockCount uint64 `protobuf:"varint,2,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{6}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetLastBlock() int64 {
+	if m != nil {
+		return m.LastBlock
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+// QueryFeeHistoryResponse returns the fee market history for a range of blocks.
+type QueryFeeHistoryResponse struct {
+	// fee_history contains the recorded entries in ascending height order. Blocks
+	// outside of the retained window are omitted.
+	FeeHistory []BlockFeeHistory `protobuf:"bytes,1,rep,name=fee_history,json=feeHistory,proto3" json:"fee_history"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c588b2369eb47d1, []int{7}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetFeeHistory() []BlockFeeHistory {
+	if m != nil {
+		return m.FeeHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.feemarket.v1.QueryParamsResponse")
@@ -282,6 +386,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.evm.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "cosmos.evm.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "cosmos.evm.feemarket.v1.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "cosmos.evm.feemarket.v1.QueryFeeHistoryResponse")
 }
 
 func init() {
@@ -289,36 +395,43 @@ func init() {
 }
 
 var fileDescriptor_2c588b2369eb47d1 = []byte{
-	// 458 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x11, 0x91, 0xb6, 0xc7, 0x02, 0x47, 0x0a, 0xc8, 0x20, 0x9b, 0xba, 0x48, 0xa1, 0x05,
-	0xee, 0xd4, 0xb2, 0xb1, 0x61, 0x21, 0x58, 0x18, 0xc0, 0x1b, 0x2c, 0xd5, 0xc5, 0xbc, 0x5e, 0xad,
-	0xf4, 0x7c, 0x6e, 0xee, 0x12, 0x91, 0x95, 0x99, 0x01, 0x84, 0xf8, 0x0f, 0x8c, 0xfc, 0x06, 0xa6,
-	0x8e, 0x95, 0x58, 0x10, 0x43, 0x85, 0x12, 0x24, 0xfe, 0x06, 0xca, 0xdd, 0xb9, 0xd4, 0x54, 0x56,
-	0xb3, 0x58, 0x4f, 0xcf, 0xdf, 0xfb, 0xbe, 0xef, 0x7d, 0x4f, 0x87, 0xd7, 0x33, 0xa5, 0xa5, 0xd2,
-	0x0c, 0xc6, 0x92, 0xed, 0x02, 0x48, 0x3e, 0x1c, 0x80, 0x61, 0xe3, 0x2d, 0x76, 0x30, 0x82, 0xe1,
-	0x84, 0x96, 0x43, 0x65, 0x14, 0xb9, 0xee, 0x40, 0x14, 0xc6, 0x92, 0x9e, 0x80, 0xe8, 0x78, 0x2b,
-	0xb8, 0xc2, 0x65, 0x5e, 0x28, 0x66, 0xbf, 0x0e, 0x1b, 0xf4, 0x9a, 0x08, 0xff, 0x0d, 0x3a, 0x60,
-	0x57, 0x28, 0xa1, 0x6c, 0xc9, 0xe6, 0x95, 0xef, 0xde, 0x12, 0x4a, 0x89, 0x7d, 0x60, 0xbc, 0xcc,
-	0x19, 0x2f, 0x0a, 0x65, 0xb8, 0xc9, 0x55, 0xa1, 0xdd, 0xdf, 0xb8, 0x8b, 0xc9, 0xcb, 0xb9, 0xaf,
-	0x17, 0x7c, 0xc8, 0xa5, 0x4e, 0xe1, 0x60, 0x04, 0xda, 0xc4, 0xaf, 0xf0, 0xd5, 0x5a, 0x57, 0x97,
-	0xaa, 0xd0, 0x40, 0x12, 0xdc, 0x29, 0x6d, 0xe7, 0x06, 0xba, 0x8d, 0xee, 0x5e, 0xda, 0x8e, 0x68,
-	0xc3, 0x1a, 0xd4, 0x0d, 0x26, 0x2b, 0x87, 0xc7, 0x51, 0xeb, 0xcb, 0x9f, 0xaf, 0x9b, 0x28, 0xf5,
-	0x93, 0xf1, 0xaa, 0xa7, 0x4e, 0xb8, 0x86, 0xa7, 0x00, 0x95, 0x62, 0x8a, 0xbb, 0xf5, 0xb6, 0x97,
-	0x7c, 0x84, 0x97, 0xfb, 0x5c, 0xc3, 0xce, 0x2e, 0x80, 0x15, 0x5d, 0x49, 0xa2, 0x9f, 0xc7, 0xd1,
-	0x4d, 0xa7, 0xab, 0xdf, 0x0c, 0x68, 0xae, 0x98, 0xe4, 0x66, 0x8f, 0x3e, 0x07, 0xc1, 0xb3, 0xc9,
-	0x13, 0xc8, 0xd2, 0xa5, 0xbe, 0xe3, 0x88, 0xaf, 0x55, 0x9c, 0xfb, 0x2a, 0x1b, 0x3c, 0xe3, 0x27,
-	0xdb, 0x6d, 0xe0, 0xd5, 0xff, 0xfa, 0x5e, 0xec, 0x32, 0x6e, 0x0b, 0xee, 0x96, 0x6b, 0xa7, 0xf3,
-	0x72, 0xfb, 0x5b, 0x1b, 0x5f, 0xb4, 0x58, 0xf2, 0x1e, 0xe1, 0x8e, 0xdb, 0x8a, 0xdc, 0x6b, 0x5c,
-	0xfb, 0x6c, 0x94, 0xc1, 0xfd, 0xc5, 0xc0, 0xce, 0x41, 0xdc, 0x7b, 0xf7, 0xfd, 0xf7, 0xa7, 0x0b,
-	0x6b, 0x24, 0x62, 0x4d, 0x47, 0x77, 0x31, 0x92, 0x8f, 0x08, 0x2f, 0xf9, 0xac, 0xc8, 0x39, 0x12,
-	0xf5, 0xa4, 0x83, 0x07, 0x0b, 0xa2, 0xbd, 0xa3, 0x0d, 0xeb, 0x68, 0x9d, 0xac, 0x35, 0x3a, 0xaa,
-	0xee, 0x43, 0x3e, 0x23, 0xbc, 0x5c, 0x65, 0x4a, 0xce, 0x93, 0xa9, 0xdf, 0x24, 0xa0, 0x8b, 0xc2,
-	0xbd, 0xad, 0x4d, 0x6b, 0xeb, 0x0e, 0x89, 0x9b, 0x6d, 0xcd, 0x47, 0x76, 0x04, 0xd7, 0xc9, 0xe3,
-	0xc3, 0x69, 0x88, 0x8e, 0xa6, 0x21, 0xfa, 0x35, 0x0d, 0xd1, 0x87, 0x59, 0xd8, 0x3a, 0x9a, 0x85,
-	0xad, 0x1f, 0xb3, 0xb0, 0xf5, 0xba, 0x27, 0x72, 0xb3, 0x37, 0xea, 0xd3, 0x4c, 0xc9, 0xd3, 0x3c,
-	0x6f, 0x4f, 0x31, 0x99, 0x49, 0x09, 0xba, 0xdf, 0xb1, 0xaf, 0xe5, 0xe1, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x7d, 0x9a, 0xf3, 0x49, 0xdd, 0x03, 0x00, 0x00,
+	// 573 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0xb5, 0xa5, 0x3f, 0x2e, 0x0b, 0x1c, 0x69, 0x1b, 0x19, 0xb0, 0x53, 0xb7, 0x22, 0x69,
+	0x29, 0x3e, 0x1a, 0x36, 0x36, 0x02, 0x2a, 0x0c, 0x0c, 0x60, 0x31, 0x00, 0x4b, 0x74, 0x31, 0x5f,
+	0x1c, 0x2b, 0xb1, 0x2f, 0xcd, 0x39, 0x11, 0x59, 0x99, 0x19, 0x40, 0x88, 0x91, 0x9d, 0x91, 0x3f,
+	0xa3, 0x63, 0x25, 0x16, 0xc4, 0x50, 0xa1, 0x04, 0x89, 0x7f, 0x03, 0xf9, 0xee, 0xf2, 0x8b, 0xc8,
+	0x6d, 0x16, 0xeb, 0xf4, 0xfc, 0xbe, 0xf7, 0xde, 0xe7, 0x7b, 0x32, 0xde, 0xf5, 0xb8, 0x08, 0xb9,
+	0xa0, 0xd0, 0x0b, 0x69, 0x1d, 0x20, 0x64, 0x9d, 0x26, 0xc4, 0xb4, 0x77, 0x44, 0x4f, 0xba, 0xd0,
+	0xe9, 0x3b, 0xed, 0x0e, 0x8f, 0x39, 0xd9, 0x56, 0x24, 0x07, 0x7a, 0xa1, 0x33, 0x26, 0x39, 0xbd,
+	0x23, 0xe3, 0x1a, 0x0b, 0x83, 0x88, 0x53, 0xf9, 0x54, 0x5c, 0xa3, 0x98, 0x26, 0x38, 0x19, 0x54,
+	0xc4, 0x9c, 0xcf, 0x7d, 0x2e, 0x8f, 0x34, 0x39, 0x69, 0xf4, 0xa6, 0xcf, 0xb9, 0xdf, 0x02, 0xca,
+	0xda, 0x01, 0x65, 0x51, 0xc4, 0x63, 0x16, 0x07, 0x3c, 0x12, 0xea, 0xad, 0x9d, 0xc3, 0xe4, 0x45,
+	0x92, 0xeb, 0x39, 0xeb, 0xb0, 0x50, 0xb8, 0x70, 0xd2, 0x05, 0x11, 0xdb, 0xaf, 0xf1, 0xf5, 0x19,
+	0x54, 0xb4, 0x79, 0x24, 0x80, 0x54, 0xf0, 0x6a, 0x5b, 0x22, 0x79, 0x54, 0x40, 0xa5, 0x6c, 0xd9,
+	0x72, 0x52, 0xd6, 0x70, 0xd4, 0x60, 0x65, 0xe3, 0xf4, 0xdc, 0xca, 0x7c, 0xfb, 0xfb, 0xfd, 0x00,
+	0xb9, 0x7a, 0xd2, 0xde, 0xd4, 0xd2, 0x15, 0x26, 0xe0, 0x18, 0x60, 0xe4, 0xe8, 0xe2, 0xdc, 0x2c,
+	0xac, 0x2d, 0x1f, 0xe0, 0xf5, 0x1a, 0x13, 0x50, 0xad, 0x03, 0x48, 0xd3, 0x8d, 0x8a, 0xf5, 0xeb,
+	0xdc, 0xba, 0xa1, 0x7c, 0xc5, 0xdb, 0xa6, 0x13, 0x70, 0x1a, 0xb2, 0xb8, 0xe1, 0x3c, 0x03, 0x9f,
+	0x79, 0xfd, 0xc7, 0xe0, 0xb9, 0x6b, 0x35, 0xa5, 0x61, 0x6f, 0x8d, 0x34, 0x5b, 0xdc, 0x6b, 0x3e,
+	0x61, 0xe3, 0xed, 0xf6, 0xf1, 0xe6, 0x7f, 0xb8, 0x36, 0xbb, 0x8a, 0x97, 0x7d, 0xa6, 0x96, 0x5b,
+	0x76, 0x93, 0xa3, 0xfd, 0x0a, 0x6f, 0x49, 0xea, 0x31, 0xc0, 0xd3, 0x40, 0xc4, 0xbc, 0xd3, 0xd7,
+	0x22, 0xe4, 0x16, 0xc6, 0x2d, 0x26, 0xe2, 0x6a, 0x2d, 0x11, 0xd1, 0x23, 0x1b, 0x09, 0x22, 0x55,
+	0x89, 0x85, 0xb3, 0xf2, 0x4d, 0xd5, 0xe3, 0xdd, 0x28, 0xce, 0x2f, 0x15, 0x50, 0x69, 0xc5, 0xc5,
+	0x12, 0x7a, 0x94, 0x20, 0x36, 0xc7, 0xdb, 0x73, 0xca, 0x3a, 0xc6, 0x4b, 0x9c, 0xad, 0x03, 0x54,
+	0x1b, 0x0a, 0xce, 0xa3, 0xc2, 0x72, 0x29, 0x5b, 0x2e, 0xa5, 0x7e, 0x6b, 0x69, 0x38, 0x91, 0x99,
+	0xfe, 0xe8, 0xb8, 0x3e, 0x86, 0xcb, 0x83, 0x15, 0x7c, 0x45, 0x3a, 0x92, 0x0f, 0x08, 0xaf, 0xaa,
+	0x0b, 0x22, 0x77, 0x52, 0x55, 0xe7, 0x5b, 0x61, 0x1c, 0x2e, 0x46, 0x56, 0x5b, 0xd8, 0xc5, 0xf7,
+	0x3f, 0xfe, 0x7c, 0x5e, 0xda, 0x21, 0x16, 0x4d, 0xeb, 0xaf, 0x6a, 0x04, 0xf9, 0x84, 0xf0, 0x9a,
+	0xbe, 0x76, 0x72, 0x89, 0xc5, 0x6c, 0x69, 0x8c, 0xbb, 0x0b, 0xb2, 0x75, 0xa2, 0x7d, 0x99, 0x68,
+	0x97, 0xec, 0xa4, 0x26, 0x1a, 0x55, 0x8d, 0x7c, 0x41, 0x78, 0x7d, 0x54, 0x0f, 0x72, 0x99, 0xcd,
+	0x6c, 0xbd, 0x0c, 0x67, 0x51, 0xba, 0x8e, 0x75, 0x20, 0x63, 0xed, 0x11, 0x3b, 0x3d, 0x96, 0x6c,
+	0x92, 0xcf, 0x04, 0xf9, 0x8a, 0x30, 0x9e, 0x5c, 0x35, 0xa1, 0x17, 0x5b, 0xcd, 0xb5, 0xd6, 0xb8,
+	0xb7, 0xf8, 0x80, 0x4e, 0x77, 0x28, 0xd3, 0xdd, 0x26, 0x7b, 0xf4, 0x82, 0xdf, 0xd0, 0xa8, 0xab,
+	0x95, 0x87, 0xa7, 0x03, 0x13, 0x9d, 0x0d, 0x4c, 0xf4, 0x7b, 0x60, 0xa2, 0x8f, 0x43, 0x33, 0x73,
+	0x36, 0x34, 0x33, 0x3f, 0x87, 0x66, 0xe6, 0x4d, 0xd1, 0x0f, 0xe2, 0x46, 0xb7, 0xe6, 0x78, 0x3c,
+	0x9c, 0x56, 0x7a, 0x37, 0xa5, 0x15, 0xf7, 0xdb, 0x20, 0x6a, 0xab, 0xf2, 0xc7, 0x74, 0xff, 0x5f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xdb, 0xea, 0x01, 0x48, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +452,9 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// FeeHistory queries the base fee and gas usage recorded for a range of
+	// recent blocks.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -376,6 +492,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.feemarket.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -384,6 +509,9 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// FeeHistory queries the base fee and gas usage recorded for a range of
+	// recent blocks.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -399,6 +527,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -458,6 +589,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.feemarket.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -474,6 +623,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evm/feemarket/v1/query.proto",
@@ -644,6 +797,76 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeHistory) > 0 {
+		for iNdEx := len(m.FeeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -718,6 +941,36 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastBlock != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlock))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeHistory) > 0 {
+		for _, e := range m.FeeHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1112,6 +1365,178 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlock", wireType)
+			}
+			m.LastBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeHistory = append(m.FeeHistory, BlockFeeHistory{})
+			if err := m.FeeHistory[len(m.FeeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"math/big"
+	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -40,6 +42,10 @@ type ContractKeeper struct {
 //
 // The ContractKeeper manages cross-chain contract execution and handles IBC packet
 // callbacks for smart contract interactions.
+//
+// It serves both the IBC v1 and the IBC v2 callbacks middlewares. On IBC v2 routes,
+// the packets are rebuilt by the middleware with the source and destination client
+// IDs in place of the channel IDs.
 func NewKeeper(authKeeper types.AccountKeeper, evmKeeper types.EVMKeeper, erc20Keeper types.ERC20Keeper) ContractKeeper {
 	ck := ContractKeeper{
 		authKeeper:  authKeeper,
@@ -50,14 +56,41 @@ func NewKeeper(authKeeper types.AccountKeeper, evmKeeper types.EVMKeeper, erc20K
 	return ck
 }
 
-// IBCSendPacketCallback handles IBC packet send callbacks for cross-chain operations.
+// IBCSendPacketCallback handles IBC packet send callbacks for cross-chain contract execution.
+// This function is triggered when a packet naming a contract as source callback is sent,
+// allowing the contract to react to the packet being committed or to reject it.
+// The send callback is opt-in: it is only executed if the source callback data sets
+// `send_callback` to true, so that contracts that only implement the acknowledgement
+// and timeout callbacks keep working.
+//
+// The function performs the following operations:
+// 1. Unmarshals and validates the IBC packet data
+// 2. Returns early if the source callback data doesn't enable the send callback
+// 3. Extracts callback data from the packet (source-side callback)
+// 4. Validates that no calldata is present (send callbacks should not contain calldata)
+// 5. Sets up a cached context with proper gas metering for EVM execution
+// 6. Verifies the target contract exists and contains code
+// 7. Calls the contract's onPacketSend function with packet details
+// 8. Manages gas consumption and validates gas limits
+// 9. Commits the cached context changes back to the original context
+//
+// Returns:
+//   - error: Returns nil on success, or an error if any step fails including:
+//   - Packet data unmarshaling errors
+//   - Invalid callback data or unexpected calldata presence
+//   - Address parsing failures
+//   - Contract validation failures (non-existent or no code)
+//   - EVM execution errors, including contract reverts
+//   - Gas limit exceeded errors
+//
+// Any error aborts the packet send, so the packet is never committed.
 //
-// IMPORTANT: This callback is currently not supported and always returns nil.
-// The rationale is that contracts can implement custom logic before the send packet
-// operation is called, making this callback redundant or potentially conflicting
-// with contract-defined behavior.
+// Contract Requirements:
+//   - Must implement onPacketSend(string calldata sourceChannel, string calldata sourcePort,
+//     uint64 timeoutTimestamp, bytes calldata data) function if the send callback is enabled
+//   - Can revert to reject the packet send
 func (k ContractKeeper) IBCSendPacketCallback(
-	cachedCtx sdk.Context,
+	ctx sdk.Context,
 	sourcePort string,
 	sourceChannel string,
 	timeoutHeight clienttypes.Height,
@@ -67,6 +100,72 @@ func (k ContractKeeper) IBCSendPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
+	data, err := types.UnmarshalTransferPacketData(packetData, version, types.PacketDataEncoding(ctx))
+	if err != nil {
+		return err
+	}
+
+	if !types.IsSendCallbackEnabled(data) {
+		return nil
+	}
+
+	cbData, isCbPacket, err := callbacktypes.GetCallbackData(data, version, sourcePort, ctx.GasMeter().GasRemaining(), ctx.GasMeter().GasRemaining(), callbacktypes.SourceCallbackKey)
+	if err != nil {
+		return err
+	}
+	if !isCbPacket {
+		return nil
+	}
+
+	// `ProcessCallback` in IBC-Go overrides the infinite gas meter with a basic gas meter,
+	// so we need to generate a new infinite gas meter to run the EVM executions on.
+	// Skipping this causes the EVM gas estimation function to deplete all Cosmos gas.
+	// We re-add the actual EVM call gas used to the original context after the call is complete
+	// with the gas retrieved from the EVM message result.
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = evmante.BuildEvmExecutionCtx(cachedCtx).
+		WithGasMeter(evmtypes.NewInfiniteGasMeterWithLimit(cbData.CommitGasLimit))
+
+	if len(cbData.Calldata) != 0 {
+		return errorsmod.Wrap(types.ErrInvalidCalldata, "send callback data should not contain calldata")
+	}
+
+	sender, err := utils.HexAddressFromBech32String(packetSenderAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to parse packet sender address %s", packetSenderAddress)
+	}
+
+	contractAddr := common.HexToAddress(contractAddress)
+
+	// Check if the contract address contains code.
+	// This check is required because if there is no code, the call will still pass on the EVM side,
+	// and the packet would be sent without the contract being notified.
+	if !k.evmKeeper.IsContract(ctx, contractAddr) {
+		return errorsmod.Wrapf(types.ErrCallbackFailed, "provided contract address is not a contract: %s", contractAddr)
+	}
+
+	// The IBC v2 callbacks middleware doesn't pass the packet timeout, which is
+	// recorded in seconds in the payload info. Pass it in nanoseconds as for IBC v1.
+	if info, ok := types.PayloadInfoFromContext(ctx); ok && timeoutTimestamp == 0 {
+		timeoutTimestamp = info.TimeoutTimestamp * uint64(time.Second)
+	}
+
+	// Call the onPacketSend function in the contract. A revert aborts the packet send.
+	// NOTE: use the cached ctx for the EVM calls.
+	res, err := k.evmKeeper.CallEVM(cachedCtx, callbacksabi.ABI, sender, contractAddr, true, math.NewIntFromUint64(cachedCtx.GasMeter().GasRemaining()).BigInt(), "onPacketSend",
+		sourceChannel, sourcePort, timeoutTimestamp, packetData)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrCallbackFailed, "EVM returned error: %s", err.Error())
+	}
+
+	// Consume the actual gas used on the original callback context.
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "callback onPacketSend")
+	if ctx.GasMeter().IsOutOfGas() {
+		return errorsmod.Wrapf(types.ErrCallbackFailed, "out of gas")
+	}
+
+	writeFn()
+
 	return nil
 }
 
@@ -84,6 +183,10 @@ func (k ContractKeeper) IBCSendPacketCallback(
 // 7. Executes the callback function on the target contract
 // 8. Validates that all tokens were successfully transferred to the contract
 //
+// If the callback data sets a `fallback_address`, the callback is executed as an IBC hook
+// instead (see executeHook): the contract may use any part of the received tokens, and the
+// remaining tokens are sent to the fallback address, as are all of them if the call fails.
+//
 // Returns:
 //   - error: Returns nil on success, or an error if any step fails including:
 //   - Packet data unmarshaling errors
@@ -108,7 +211,7 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	contractAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := types.UnmarshalTransferPacketData(packet.GetData(), version, types.PacketDataEncoding(ctx))
 	if err != nil {
 		return err
 	}
@@ -121,6 +224,14 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 		return nil
 	}
 
+	fallbackAddr, isHook, err := types.GetFallbackAddress(data)
+	if err != nil {
+		return err
+	}
+	if isHook && len(cbData.Calldata) < types.MinHookCalldataLength {
+		return errorsmod.Wrapf(types.ErrInvalidCalldata, "hook calldata must contain a function selector, got %d bytes", len(cbData.Calldata))
+	}
+
 	// `ProcessCallback` in IBC-Go overrides the infinite gas meter with a basic gas meter,
 	// so we need to generate a new infinite gas meter to run the EVM executions on.
 	// Skipping this causes the EVM gas estimation function to deplete all Cosmos gas.
@@ -154,6 +265,11 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 		return errorsmod.Wrapf(types.ErrInvalidReceiverAddress, "expected %s, got %s", isolatedAddrHex.String(), receiverHex.String())
 	}
 
+	// Ensure the tokens left by a hook are not sent back to the isolated address.
+	if isHook && fallbackAddr == isolatedAddrHex {
+		return errorsmod.Wrap(types.ErrInvalidFallbackAddress, "fallback address cannot be the isolated address")
+	}
+
 	contractAddr := common.HexToAddress(contractAddress)
 
 	// Check if the contract address contains code.
@@ -182,6 +298,10 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 		return errorsmod.Wrapf(types.ErrNumberOverflow, "amount overflow")
 	}
 
+	if isHook {
+		return k.executeHook(ctx, cachedCtx, writeFn, cbData.Calldata, receiverHex, contractAddr, tokenPair.GetERC20Contract(), fallbackAddr, amountInt.BigInt())
+	}
+
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract
 
 	remainingGas := math.NewIntFromUint64(cachedCtx.GasMeter().GasRemaining()).BigInt()
@@ -241,6 +361,107 @@ func (k ContractKeeper) IBCReceivePacketCallback(
 	return nil
 }
 
+// executeHook executes the destination callback as an IBC hook. The received
+// tokens are approved to the contract, which is called with the callback calldata
+// from the isolated address. Unlike a regular destination callback, the contract
+// doesn't need to transfer all the tokens: the tokens left in the isolated address
+// after the call, or all the received tokens if the call fails, are sent to the
+// fallback address. The packet is then acknowledged successfully in both cases.
+//
+// An error is only returned if the callback runs out of gas or if the tokens
+// can't be sent to the fallback address, in which case the packet is rejected.
+func (k ContractKeeper) executeHook(
+	ctx, cachedCtx sdk.Context,
+	writeFn func(),
+	calldata []byte,
+	receiver, contract, token, fallback common.Address,
+	amount *big.Int,
+) error {
+	hookErr := k.callHook(ctx, cachedCtx, calldata, receiver, contract, token, amount)
+	if ctx.GasMeter().IsOutOfGas() {
+		return errorsmod.Wrapf(types.ErrOutOfGas, "out of gas")
+	}
+	if hookErr == nil {
+		writeFn()
+	}
+
+	// Send the tokens left in the isolated address to the fallback address,
+	// since they would become irretrievable.
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract
+	fallbackAmount := k.erc20Keeper.BalanceOf(ctx, erc20.ABI, token, receiver)
+	if fallbackAmount == nil {
+		fallbackAmount = big.NewInt(0)
+	}
+	if fallbackAmount.Sign() > 0 {
+		fallbackCtx, writeFallbackFn := ctx.CacheContext()
+		fallbackCtx = evmante.BuildEvmExecutionCtx(fallbackCtx).
+			WithGasMeter(evmtypes.NewInfiniteGasMeterWithLimit(types.FallbackTransferGasLimit))
+
+		res, err := k.evmKeeper.CallEVM(fallbackCtx, erc20.ABI, receiver, token, true, new(big.Int).SetUint64(types.FallbackTransferGasLimit), "transfer", fallback, fallbackAmount)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrFallbackTransferFailed, "EVM returned error: %s", err.Error())
+		}
+
+		// Consume the actual gas used on the original callback context.
+		ctx.GasMeter().ConsumeGas(res.GasUsed, "callback hook fallback")
+		if ctx.GasMeter().IsOutOfGas() {
+			return errorsmod.Wrapf(types.ErrOutOfGas, "out of gas")
+		}
+
+		writeFallbackFn()
+	}
+
+	errMsg := ""
+	if hookErr != nil {
+		errMsg = hookErr.Error()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHook,
+			sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+			sdk.NewAttribute(types.AttributeKeyToken, token.Hex()),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(hookErr == nil)),
+			sdk.NewAttribute(types.AttributeKeyError, errMsg),
+			sdk.NewAttribute(types.AttributeKeyFallbackAddress, fallback.Hex()),
+			sdk.NewAttribute(types.AttributeKeyFallbackAmount, fallbackAmount.String()),
+		),
+	)
+
+	return nil
+}
+
+// callHook approves the received tokens to the contract and calls it with the
+// hook calldata. The EVM calls are executed on the cached context, while their
+// gas is consumed on the original callback context.
+func (k ContractKeeper) callHook(
+	ctx, cachedCtx sdk.Context,
+	calldata []byte,
+	receiver, contract, token common.Address,
+	amount *big.Int,
+) error {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract
+
+	remainingGas := math.NewIntFromUint64(cachedCtx.GasMeter().GasRemaining()).BigInt()
+	res, err := k.evmKeeper.CallEVM(cachedCtx, erc20.ABI, receiver, token, true, remainingGas, "approve", contract, amount)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrAllowanceFailed, "failed to set allowance: %v", err)
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "callback hook allowance")
+	remainingGas = remainingGas.Sub(remainingGas, math.NewIntFromUint64(res.GasUsed).BigInt())
+	if ctx.GasMeter().IsOutOfGas() || remainingGas.Sign() <= 0 {
+		return errorsmod.Wrapf(types.ErrOutOfGas, "out of gas")
+	}
+
+	res, err = k.evmKeeper.CallEVMWithData(cachedCtx, receiver, &contract, calldata, true, remainingGas)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrEVMCallFailed, "EVM returned error: %s", err.Error())
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "callback hook")
+	return nil
+}
+
 // IBCOnAcknowledgementPacketCallback handles IBC packet acknowledgement callbacks for cross-chain contract execution.
 // This function is triggered when an IBC packet receives an acknowledgement from the destination chain,
 // allowing contracts to react to successful or failed packet delivery.
@@ -276,7 +497,7 @@ func (k ContractKeeper) IBCOnAcknowledgementPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := types.UnmarshalTransferPacketData(packet.GetData(), version, types.PacketDataEncoding(ctx))
 	if err != nil {
 		return err
 	}
@@ -371,7 +592,7 @@ func (k ContractKeeper) IBCOnTimeoutPacketCallback(
 	packetSenderAddress string,
 	version string,
 ) error {
-	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), version, "")
+	data, err := types.UnmarshalTransferPacketData(packet.GetData(), version, types.PacketDataEncoding(ctx))
 	if err != nil {
 		return err
 	}
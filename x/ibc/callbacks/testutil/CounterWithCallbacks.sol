@@ -10,6 +10,9 @@ contract CounterWithCallbacks is ICallbacks {
     // Mapping: user address => token address => balance
     mapping(address => mapping(address => uint256)) public userTokenBalances;
 
+    // Number of packets sent with this contract as source callback
+    uint256 public sentPackets;
+
     // Events
     event CounterIncremented(int newValue, address indexed user);
     event TokensDeposited(
@@ -72,6 +75,19 @@ contract CounterWithCallbacks is ICallbacks {
         return userTokenBalances[user][token];
     }
 
+    /**
+     * @dev Implementation of ICallbacks interface
+     * Called when a packet is sent
+     */
+    function onPacketSend(
+        string memory channelId,
+        string memory portId,
+        uint64 timeoutTimestamp,
+        bytes memory data
+    ) external override {
+        sentPackets += 1; // Count sent packets without changing the counter
+    }
+
     /**
      * @dev Implementation of ICallbacks interface
      * Called when a packet acknowledgement is received
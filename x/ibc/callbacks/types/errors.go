@@ -15,4 +15,6 @@ var (
 	ErrAllowanceFailed        = errorsmod.Register(ModuleName, 7, "allowance failed")
 	ErrEVMCallFailed          = errorsmod.Register(ModuleName, 8, "evm call failed")
 	ErrOutOfGas               = errorsmod.Register(ModuleName, 9, "out of gas")
+	ErrInvalidFallbackAddress = errorsmod.Register(ModuleName, 10, "invalid fallback address")
+	ErrFallbackTransferFailed = errorsmod.Register(ModuleName, 11, "fallback transfer failed")
 )
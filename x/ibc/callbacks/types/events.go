@@ -0,0 +1,13 @@
+package types
+
+// EVM callbacks events
+const (
+	EventTypeHook = "ibc_callback_hook"
+
+	AttributeKeyContract        = "contract"
+	AttributeKeyToken           = "token"
+	AttributeKeySuccess         = "success"
+	AttributeKeyError           = "error"
+	AttributeKeyFallbackAddress = "fallback_address"
+	AttributeKeyFallbackAmount  = "fallback_amount"
+)
@@ -1,6 +1,15 @@
 package types
 
 import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	callbacktypes "github.com/cosmos/ibc-go/v10/modules/apps/callbacks/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -8,6 +17,23 @@ import (
 const (
 	// ModuleName defines the module name
 	ModuleName = "ibc-callbacks"
+
+	// SendCallbackKey is the source callback memo key that enables the
+	// onPacketSend callback when set to true.
+	SendCallbackKey = "send_callback"
+
+	// FallbackAddressKey is the destination callback memo key that executes the
+	// callback as an IBC hook, sending the tokens that are not used by the
+	// contract call to the given address.
+	FallbackAddressKey = "fallback_address"
+
+	// MinHookCalldataLength is the minimum length of the calldata of an IBC hook,
+	// which must at least contain a function selector.
+	MinHookCalldataLength = 4
+
+	// FallbackTransferGasLimit is the gas limit of the transfer of the tokens
+	// left by an IBC hook to the fallback address.
+	FallbackTransferGasLimit uint64 = 100_000
 )
 
 // GenerateIsolatedAddress generates an isolated address for the given channel ID and sender address.
@@ -15,3 +41,52 @@ const (
 func GenerateIsolatedAddress(channelID string, sender string) sdk.AccAddress {
 	return sdk.AccAddress(address.Module(ModuleName, []byte(channelID), []byte(sender))[:20])
 }
+
+// IsSendCallbackEnabled returns true if the source callback data of the packet
+// enables the onPacketSend callback. The send callback is opt-in, so that the
+// packets sent with a source callback contract that doesn't implement it are
+// not rejected.
+func IsSendCallbackEnabled(packetData ibcexported.PacketDataProvider) bool {
+	callbackData, ok := packetData.GetCustomPacketData(callbacktypes.SourceCallbackKey).(map[string]any)
+	if !ok {
+		return false
+	}
+	enabled, ok := callbackData[SendCallbackKey].(bool)
+	return ok && enabled
+}
+
+// GetFallbackAddress returns the fallback address of the destination callback
+// data of the packet, and true if it is set. The fallback address may be a
+// bech32 account address or a hex address and must not be the zero address.
+func GetFallbackAddress(packetData ibcexported.PacketDataProvider) (common.Address, bool, error) {
+	callbackData, ok := packetData.GetCustomPacketData(callbacktypes.DestinationCallbackKey).(map[string]any)
+	if !ok {
+		return common.Address{}, false, nil
+	}
+	value, found := callbackData[FallbackAddressKey]
+	if !found {
+		return common.Address{}, false, nil
+	}
+
+	fallback, ok := value.(string)
+	if !ok {
+		return common.Address{}, true, errorsmod.Wrapf(ErrInvalidFallbackAddress, "expected a string, got %T", value)
+	}
+	fallback = strings.TrimSpace(fallback)
+
+	var addr common.Address
+	if common.IsHexAddress(fallback) {
+		addr = common.HexToAddress(fallback)
+	} else {
+		accAddr, err := sdk.AccAddressFromBech32(fallback)
+		if err != nil {
+			return common.Address{}, true, errorsmod.Wrapf(ErrInvalidFallbackAddress, "%s: %s", fallback, err)
+		}
+		addr = common.BytesToAddress(accAddr)
+	}
+
+	if addr == (common.Address{}) {
+		return common.Address{}, true, errorsmod.Wrap(ErrInvalidFallbackAddress, "fallback address cannot be the zero address")
+	}
+	return addr, true, nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 
@@ -15,11 +17,59 @@ type Unmarshaler struct{}
 // It expects the data to be in the format of transfertypes.FungibleTokenPacketData.
 // If the data is not in the expected format, it returns an error.
 func (u Unmarshaler) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, data []byte) (any, string, error) {
-	transferData, err := transfertypes.UnmarshalPacketData(
-		data, transfertypes.V1, transfertypes.EncodingJSON,
-	)
+	transferData, err := UnmarshalTransferPacketData(data, transfertypes.V1, transfertypes.EncodingJSON)
 	if err != nil {
 		return nil, "", err
 	}
 	return transferData, transfertypes.V1, nil
 }
+
+// UnmarshalTransferPacketData unmarshals the ICS20 packet data of an IBC v1 packet
+// or an IBC v2 payload with the given encoding. IBC v1 packets are always JSON
+// encoded, while IBC v2 payloads declare their encoding. It returns an error for
+// encodings not supported by ICS20.
+func UnmarshalTransferPacketData(data []byte, version, encoding string) (transfertypes.InternalTransferRepresentation, error) {
+	switch encoding {
+	case transfertypes.EncodingJSON, transfertypes.EncodingProtobuf, transfertypes.EncodingABI:
+		return transfertypes.UnmarshalPacketData(data, version, encoding)
+	default:
+		return transfertypes.InternalTransferRepresentation{}, fmt.Errorf("unsupported ICS20 packet data encoding: %q", encoding)
+	}
+}
+
+// payloadInfoKey is the context key under which the PayloadInfo of an IBC v2
+// payload is stored.
+type payloadInfoKey struct{}
+
+// PayloadInfo defines the information of an IBC v2 payload that the IBC v2
+// callbacks middleware doesn't pass to the contract keeper.
+type PayloadInfo struct {
+	// Encoding is the encoding of the payload value.
+	Encoding string
+	// TimeoutTimestamp is the timeout of the packet in seconds. It is only set
+	// when sending the packet.
+	TimeoutTimestamp uint64
+}
+
+// ContextWithPayloadInfo returns a copy of the context that records the
+// information of the IBC v2 payload the callbacks are executed for.
+func ContextWithPayloadInfo(ctx sdk.Context, info PayloadInfo) sdk.Context {
+	return ctx.WithValue(payloadInfoKey{}, info)
+}
+
+// PayloadInfoFromContext returns the information of the IBC v2 payload the
+// callbacks are executed for, if any.
+func PayloadInfoFromContext(ctx sdk.Context) (PayloadInfo, bool) {
+	info, ok := ctx.Value(payloadInfoKey{}).(PayloadInfo)
+	return info, ok
+}
+
+// PacketDataEncoding returns the encoding of the packet data the callbacks are
+// executed for, which is the encoding of the IBC v2 payload if any, and JSON for
+// IBC v1 packets.
+func PacketDataEncoding(ctx sdk.Context) string {
+	if info, ok := PayloadInfoFromContext(ctx); ok {
+		return info.Encoding
+	}
+	return transfertypes.EncodingJSON
+}
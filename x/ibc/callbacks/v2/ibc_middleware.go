@@ -0,0 +1,128 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/evm/x/ibc/callbacks/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ ibcapi.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware records the information of the IBC v2 payloads that the IBC v2
+// callbacks middleware of ibc-go doesn't pass to the contract keeper, i.e. the
+// payload encoding and the packet timeout, in the context of the callbacks.
+// It must wrap the IBC v2 callbacks middleware.
+type IBCMiddleware struct {
+	app ibcapi.IBCModule
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the IBC v2 callbacks middleware
+func NewIBCMiddleware(app ibcapi.IBCModule) IBCMiddleware {
+	if app == nil {
+		panic(errors.New("underlying application cannot be nil"))
+	}
+
+	return IBCMiddleware{
+		app: app,
+	}
+}
+
+// OnSendPacket records the payload encoding and the packet timeout, which is
+// read from the send packet event emitted by the IBC core, and defers to the
+// underlying application.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	timeoutTimestamp, err := sentPacketTimeout(ctx, sourceClient, sequence)
+	if err != nil {
+		return err
+	}
+
+	ctx = types.ContextWithPayloadInfo(ctx, types.PayloadInfo{
+		Encoding:         payload.Encoding,
+		TimeoutTimestamp: timeoutTimestamp,
+	})
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket records the payload encoding and defers to the underlying application.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	ctx = types.ContextWithPayloadInfo(ctx, types.PayloadInfo{Encoding: payload.Encoding})
+	return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket records the payload encoding and defers to the underlying application.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	ctx = types.ContextWithPayloadInfo(ctx, types.PayloadInfo{Encoding: payload.Encoding})
+	return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+}
+
+// OnTimeoutPacket records the payload encoding and defers to the underlying application.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	ctx = types.ContextWithPayloadInfo(ctx, types.PayloadInfo{Encoding: payload.Encoding})
+	return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// sentPacketTimeout returns the timeout of the packet with the given source
+// client and sequence from the send packet event, which the IBC core emits
+// before calling the OnSendPacket callbacks. It returns 0 if the event is not
+// found.
+func sentPacketTimeout(ctx sdk.Context, sourceClient string, sequence uint64) (uint64, error) {
+	events := ctx.EventManager().Events()
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if event.Type != channeltypesv2.EventTypeSendPacket {
+			continue
+		}
+
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		if attrs[channeltypesv2.AttributeKeySrcClient] != sourceClient ||
+			attrs[channeltypesv2.AttributeKeySequence] != strconv.FormatUint(sequence, 10) {
+			continue
+		}
+
+		timeoutTimestamp, err := strconv.ParseUint(attrs[channeltypesv2.AttributeKeyTimeoutTimestamp], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid timeout timestamp in send packet event: %w", err)
+		}
+		return timeoutTimestamp, nil
+	}
+
+	return 0, nil
+}
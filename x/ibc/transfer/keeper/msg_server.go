@@ -45,6 +45,11 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	// use native denom or contract address
 	denom := strings.TrimPrefix(msg.Token.Denom, erc20types.Erc20NativeCoinDenomPrefix)
 
+	// enforce the outflow quotas of the rate limits of the token on the channel
+	if err := k.updateRateLimitOutflow(ctx, msg, denom); err != nil {
+		return nil, err
+	}
+
 	pairID := k.erc20Keeper.GetTokenPairID(ctx, denom)
 	if len(pairID) == 0 {
 		// no-op: token is not registered so we can proceed with regular transfer
@@ -115,3 +120,19 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 
 	return k.Keeper.Transfer(ctx, msg)
 }
+
+// updateRateLimitOutflow records the transferred amount of the token in the
+// outflow of its rate limits on the source channel. The spendable balance of the
+// sender is recorded for the transfers of the unbounded spend limit.
+func (k Keeper) updateRateLimitOutflow(ctx sdk.Context, msg *types.MsgTransfer, denom string) error {
+	amount := msg.Token.Amount
+	if amount.Equal(types.UnboundedSpendLimit()) {
+		sender, err := sdk.AccAddressFromBech32(msg.Sender)
+		if err != nil {
+			return err
+		}
+		amount = k.bankKeeper.SpendableCoin(ctx, sender, msg.Token.Denom).Amount
+	}
+
+	return k.erc20Keeper.UpdateRateLimitOutflow(ctx, msg.SourceChannel, denom, amount)
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
+// FlagDenom defines the flag to select the extended denom to query.
+const FlagDenom = "denom"
+
 // GetQueryCmd returns the parent command for all x/precisebank CLI query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -20,12 +23,79 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		GetParamsCmd(),
+		GetTotalFractionalBalancesCmd(),
 		GetRemainderCmd(),
 		GetFractionalBalanceCmd(),
+		GetReconcileCmd(),
 	)
 	return cmd
 }
 
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the precise bank module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTotalFractionalBalancesCmd queries the sum of all fractional balances
+func GetTotalFractionalBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-fractional-balances",
+		Short: "Get the sum of all fractional balances",
+		Long:  "Get the sum of all fractional balances in the precise bank module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			ctx := cmd.Context()
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TotalFractionalBalances(ctx, &types.QueryTotalFractionalBalancesRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Extended denom to query, defaults to the extended EVM denom")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetRemainderCmd queries the remainder amount
 func GetRemainderCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -42,7 +112,14 @@ func GetRemainderCmd() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 
 			ctx := cmd.Context()
-			res, err := queryClient.Remainder(ctx, &types.QueryRemainderRequest{})
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Remainder(ctx, &types.QueryRemainderRequest{
+				Denom: denom,
+			})
 			if err != nil {
 				return err
 			}
@@ -51,6 +128,7 @@ func GetRemainderCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDenom, "", "Extended denom to query, defaults to the extended EVM denom")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -71,8 +149,14 @@ func GetFractionalBalanceCmd() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 
 			ctx := cmd.Context()
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.FractionalBalance(ctx, &types.QueryFractionalBalanceRequest{
 				Address: args[0],
+				Denom:   denom,
 			})
 			if err != nil {
 				return err
@@ -82,6 +166,7 @@ func GetFractionalBalanceCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDenom, "", "Extended denom to query, defaults to the extended EVM denom")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
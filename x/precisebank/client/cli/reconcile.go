@@ -0,0 +1,235 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/evm/x/precisebank/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ReconciliationReport is the result of auditing the x/precisebank state of a
+// node.
+type ReconciliationReport struct {
+	Height        int64                 `json:"height" yaml:"height"`
+	Denoms        []DenomReconciliation `json:"denoms" yaml:"denoms"`
+	Discrepancies int                   `json:"discrepancies" yaml:"discrepancies"`
+}
+
+// DenomReconciliation is the reconciliation of a single extended denom
+// against the reserve backing it.
+type DenomReconciliation struct {
+	ExtendedDenom           string   `json:"extended_denom" yaml:"extended_denom"`
+	ReserveBalance          sdk.Coin `json:"reserve_balance" yaml:"reserve_balance"`
+	TotalFractionalBalances sdk.Coin `json:"total_fractional_balances" yaml:"total_fractional_balances"`
+	Remainder               sdk.Coin `json:"remainder" yaml:"remainder"`
+	BankSupply              sdk.Coin `json:"bank_supply" yaml:"bank_supply"`
+	Discrepancies           []string `json:"discrepancies,omitempty" yaml:"discrepancies,omitempty"`
+}
+
+// GetReconcileCmd audits the x/precisebank state of a node against the
+// reserve and reports any discrepancies.
+func GetReconcileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Audit the precise bank state against the reserve",
+		Long: `Audit the precise bank state of a node for every extended denom and report any discrepancies.
+
+For each extended denom, the integer balance of the reserve module account must
+fully back the sum of all fractional balances and the remainder, the remainder
+must be lower than the conversion factor, and x/bank must not hold any supply of
+the extended denom. The command fails if any discrepancy is found.
+
+All the queries are made at the same height, which is the latest height of the
+node unless set with --height.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// pin all the queries to the same height, so that the state is not
+			// audited across blocks committed in between the queries
+			height := clientCtx.Height
+			if height <= 0 {
+				height, err = rpc.GetChainHeight(clientCtx)
+				if err != nil {
+					return fmt.Errorf("failed to query the latest height: %w", err)
+				}
+			}
+			ctx := metadata.AppendToOutgoingContext(cmd.Context(), grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+
+			eds, err := queryExtendedDenoms(ctx, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			report := ReconciliationReport{Height: height}
+			for _, ed := range eds {
+				denomReport, err := reconcileDenom(ctx, clientCtx, ed)
+				if err != nil {
+					return err
+				}
+
+				report.Denoms = append(report.Denoms, denomReport)
+				report.Discrepancies += len(denomReport.Discrepancies)
+			}
+
+			if err := clientCtx.PrintObjectLegacy(report); err != nil {
+				return err
+			}
+
+			if report.Discrepancies != 0 {
+				return fmt.Errorf("found %d discrepancies in the precise bank state", report.Discrepancies)
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// queryExtendedDenoms returns all the extended denoms managed by the
+// x/precisebank module of the node, starting with the extended EVM denom.
+func queryExtendedDenoms(ctx context.Context, clientCtx client.Context) ([]types.ExtendedDenom, error) {
+	var eds []types.ExtendedDenom
+
+	evmDenom, extended, err := queryEVMExtendedDenom(ctx, clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	if extended {
+		eds = append(eds, evmDenom)
+	}
+
+	res, err := types.NewQueryClient(clientCtx).Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(eds, res.Params.ExtendedDenoms...), nil
+}
+
+// queryEVMExtendedDenom returns the extended EVM denom of the node, loaded the
+// same way as the EVM coin info: from the x/vm params and the bank denom
+// metadata. It returns false on 18 decimals chains, where the EVM denom is not
+// extended.
+func queryEVMExtendedDenom(ctx context.Context, clientCtx client.Context) (types.ExtendedDenom, bool, error) {
+	evmRes, err := evmtypes.NewQueryClient(clientCtx).Params(ctx, &evmtypes.QueryParamsRequest{})
+	if err != nil {
+		return types.ExtendedDenom{}, false, err
+	}
+
+	params := evmRes.Params
+	metadataRes, err := banktypes.NewQueryClient(clientCtx).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{
+		Denom: params.EvmDenom,
+	})
+	if err != nil {
+		return types.ExtendedDenom{}, false, fmt.Errorf("failed to query denom metadata of %s: %w", params.EvmDenom, err)
+	}
+
+	var decimals evmtypes.Decimals
+	for _, denomUnit := range metadataRes.Metadata.DenomUnits {
+		if denomUnit.Denom == metadataRes.Metadata.Display {
+			decimals = evmtypes.Decimals(denomUnit.Exponent)
+		}
+	}
+
+	if decimals == evmtypes.EighteenDecimals {
+		return types.ExtendedDenom{}, false, nil
+	}
+
+	if params.ExtendedDenomOptions == nil {
+		return types.ExtendedDenom{}, false, fmt.Errorf("extended denom options cannot be nil for non-18-decimal chains")
+	}
+
+	ed := types.NewExtendedDenom(params.EvmDenom, params.ExtendedDenomOptions.ExtendedDenom, decimals.Uint32())
+	if err := ed.Validate(); err != nil {
+		return types.ExtendedDenom{}, false, fmt.Errorf("invalid EVM coin info: %w", err)
+	}
+
+	return ed, true, nil
+}
+
+// reconcileDenom audits the state of a single extended denom at the height set
+// in the gRPC metadata of the given context.
+func reconcileDenom(ctx context.Context, clientCtx client.Context, ed types.ExtendedDenom) (DenomReconciliation, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	bankQueryClient := banktypes.NewQueryClient(clientCtx)
+
+	reserveAddr := authtypes.NewModuleAddress(types.ModuleName)
+	reserveRes, err := bankQueryClient.Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: reserveAddr.String(),
+		Denom:   ed.IntegerDenom,
+	})
+	if err != nil {
+		return DenomReconciliation{}, err
+	}
+
+	totalRes, err := queryClient.TotalFractionalBalances(ctx, &types.QueryTotalFractionalBalancesRequest{
+		Denom: ed.ExtendedDenom,
+	})
+	if err != nil {
+		return DenomReconciliation{}, err
+	}
+
+	remainderRes, err := queryClient.Remainder(ctx, &types.QueryRemainderRequest{
+		Denom: ed.ExtendedDenom,
+	})
+	if err != nil {
+		return DenomReconciliation{}, err
+	}
+
+	supplyRes, err := bankQueryClient.SupplyOf(ctx, &banktypes.QuerySupplyOfRequest{
+		Denom: ed.ExtendedDenom,
+	})
+	if err != nil {
+		return DenomReconciliation{}, err
+	}
+
+	report := DenomReconciliation{
+		ExtendedDenom:           ed.ExtendedDenom,
+		ReserveBalance:          *reserveRes.Balance,
+		TotalFractionalBalances: totalRes.Total,
+		Remainder:               remainderRes.Remainder,
+		BankSupply:              supplyRes.Amount,
+	}
+
+	reserveExtended := report.ReserveBalance.Amount.Mul(ed.ConversionFactor())
+	backed := report.TotalFractionalBalances.Amount.Add(report.Remainder.Amount)
+	if !reserveExtended.Equal(backed) {
+		report.Discrepancies = append(report.Discrepancies, fmt.Sprintf(
+			"reserve balance %s%s does not match fractional balances + remainder %s%s",
+			reserveExtended, ed.ExtendedDenom, backed, ed.ExtendedDenom,
+		))
+	}
+
+	if !report.Remainder.IsZero() {
+		if err := ed.ValidateFractionalAmount(report.Remainder.Amount); err != nil {
+			report.Discrepancies = append(report.Discrepancies, fmt.Sprintf("invalid remainder: %s", err))
+		}
+	}
+
+	if !report.BankSupply.IsZero() {
+		report.Discrepancies = append(report.Discrepancies, fmt.Sprintf(
+			"x/bank holds a supply of %s", report.BankSupply,
+		))
+	}
+
+	return report, nil
+}
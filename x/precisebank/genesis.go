@@ -67,6 +67,34 @@ func InitGenesis(
 
 	// Set remainder amount in state
 	keeper.SetRemainderAmount(ctx, gs.Remainder)
+
+	if err := keeper.SetParams(ctx, gs.Params); err != nil {
+		panic(fmt.Sprintf("failed to set %s params: %s", types.ModuleName, err))
+	}
+
+	// Set the fractional state of the extended denoms registered in params
+	for _, ds := range gs.DenomStates {
+		// Validated in GenesisState.Validate()
+		ed, _ := gs.Params.FindExtendedDenom(ds.ExtendedDenom)
+
+		totalAmt := ds.TotalAmountWithRemainder()
+		moduleBal := bk.GetBalance(ctx, moduleAddr, ed.IntegerDenom)
+		if !totalAmt.Equal(moduleBal.Amount.Mul(ed.ConversionFactor())) {
+			panic(fmt.Sprintf("module account balance does not match sum of %s fractional balances and remainder, balance is %s but expected %v%s (%v%s)",
+				ed.ExtendedDenom,
+				moduleBal,
+				totalAmt, ed.ExtendedDenom,
+				totalAmt.Quo(ed.ConversionFactor()), ed.IntegerDenom,
+			))
+		}
+
+		for _, bal := range ds.Balances {
+			addr := sdk.MustAccAddressFromBech32(bal.Address)
+			keeper.SetDenomFractionalBalance(ctx, ed, addr, bal.Amount)
+		}
+
+		keeper.SetDenomRemainderAmount(ctx, ed, ds.Remainder)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -80,5 +108,22 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 
 	remainder := keeper.GetRemainderAmount(ctx)
 
-	return types.NewGenesisState(balances, remainder)
+	gs := types.NewGenesisState(balances, remainder)
+	gs.Params = keeper.GetParams(ctx)
+
+	for _, ed := range gs.Params.ExtendedDenoms {
+		denomBalances := types.FractionalBalances{}
+		keeper.IterateDenomFractionalBalances(ctx, ed, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
+			denomBalances = append(denomBalances, types.NewFractionalBalance(addr.String(), amount))
+
+			return false
+		})
+
+		gs.DenomStates = append(
+			gs.DenomStates,
+			types.NewDenomState(ed.ExtendedDenom, denomBalances, keeper.GetDenomRemainderAmount(ctx, ed)),
+		)
+	}
+
+	return gs
 }
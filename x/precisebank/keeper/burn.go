@@ -40,15 +40,8 @@ func (k Keeper) BurnCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	// Get non-ExtendedCoinDenom coins
-	passthroughCoins := amt
-
-	extendedAmount := amt.AmountOf(types.ExtendedCoinDenom())
-	if extendedAmount.IsPositive() {
-		// Remove ExtendedCoinDenom from the coins as it is managed by x/precisebank
-		removeCoin := sdk.NewCoin(types.ExtendedCoinDenom(), extendedAmount)
-		passthroughCoins = amt.Sub(removeCoin)
-	}
+	// Remove the extended coins as they are managed by x/precisebank
+	passthroughCoins, extendedCoins := k.splitExtendedCoins(ctx, amt)
 
 	// Coins unmanaged by x/precisebank are passed through to x/bank
 	if !passthroughCoins.Empty() {
@@ -57,9 +50,9 @@ func (k Keeper) BurnCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 		}
 	}
 
-	// Only burn extended coin if the amount is positive
-	if extendedAmount.IsPositive() {
-		if err := k.burnExtendedCoin(ctx, moduleName, extendedAmount); err != nil {
+	// Only burn extended coins with a positive amount
+	for _, ec := range extendedCoins {
+		if err := k.burnExtendedCoin(ctx, ec.denom, moduleName, ec.amount); err != nil {
 			return err
 		}
 	}
@@ -67,12 +60,15 @@ func (k Keeper) BurnCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 	return nil
 }
 
-// burnExtendedCoin burns the fractional amount of the ExtendedCoinDenom from the module account.
+// burnExtendedCoin burns the given amount of an extended denom from the module account.
 func (k Keeper) burnExtendedCoin(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 	moduleName string,
 	amt sdkmath.Int,
 ) error {
+	conversionFactor := ed.ConversionFactor()
+
 	// Get the module address
 	moduleAddr := k.ak.GetModuleAddress(moduleName)
 
@@ -80,9 +76,9 @@ func (k Keeper) burnExtendedCoin(
 	// The precisebank module account is the reserve and should not have fractional balances
 	if moduleName == types.ModuleName {
 		// For the precisebank module account, just burn the integer coins directly
-		integerBurnAmount := amt.Quo(types.ConversionFactor())
+		integerBurnAmount := amt.Quo(conversionFactor)
 		if integerBurnAmount.IsPositive() {
-			integerBurnCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerBurnAmount)
+			integerBurnCoin := sdk.NewCoin(ed.IntegerDenom, integerBurnAmount)
 			if err := k.bk.BurnCoins(ctx, moduleName, sdk.NewCoins(integerBurnCoin)); err != nil {
 				return err
 			}
@@ -92,16 +88,16 @@ func (k Keeper) burnExtendedCoin(
 
 	// We only need the fractional balance to burn coins, as integer burns will
 	// return errors on insufficient funds.
-	prevFractionalBalance := k.GetFractionalBalance(ctx, moduleAddr)
+	prevFractionalBalance := k.GetDenomFractionalBalance(ctx, ed, moduleAddr)
 
 	// Get remainder amount first to optimize direct burn.
-	prevRemainder := k.GetRemainderAmount(ctx)
+	prevRemainder := k.GetDenomRemainderAmount(ctx, ed)
 
 	// -------------------------------------------------------------------------
 	// Pure stateless calculations
 
-	integerBurnAmount := amt.Quo(types.ConversionFactor())
-	fractionalBurnAmount := amt.Mod(types.ConversionFactor())
+	integerBurnAmount := amt.Quo(conversionFactor)
+	fractionalBurnAmount := amt.Mod(conversionFactor)
 
 	// newFractionalBalance can be negative if fractional balance is insufficient.
 	newFractionalBalance := prevFractionalBalance.Sub(fractionalBurnAmount)
@@ -115,7 +111,7 @@ func (k Keeper) burnExtendedCoin(
 
 	// If true, remainder has accumulated enough fractional amounts to burn 1
 	// integer coin.
-	overflowingRemainder := newRemainder.GTE(types.ConversionFactor())
+	overflowingRemainder := newRemainder.GTE(conversionFactor)
 
 	// -------------------------------------------------------------------------
 	// Stateful operations for burn
@@ -131,8 +127,8 @@ func (k Keeper) burnExtendedCoin(
 	// Case #1: (optimization) direct burn instead of borrow (reserve transfer)
 	// & reserve burn. No additional reserve burn would be necessary after this.
 	if requiresBorrow && overflowingRemainder {
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
-		newRemainder = newRemainder.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(conversionFactor)
+		newRemainder = newRemainder.Sub(conversionFactor)
 
 		integerBurnAmount = integerBurnAmount.AddRaw(1)
 	}
@@ -140,32 +136,32 @@ func (k Keeper) burnExtendedCoin(
 	// Case #2: Transfer 1 integer coin to reserve for integer borrow to ensure
 	// reserve fully backs the fractional amount.
 	if requiresBorrow && !overflowingRemainder {
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(conversionFactor)
 
 		// Transfer 1 integer coin to reserve to cover the borrowed fractional
 		// amount. SendCoinsFromModuleToModule will return an error if the
 		// module account has insufficient funds and an error with the full
 		// extended balance will be returned.
-		borrowCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.OneInt())
+		borrowCoin := sdk.NewCoin(ed.IntegerDenom, sdkmath.OneInt())
 		if err := k.bk.SendCoinsFromModuleToModule(
 			ctx,
 			moduleName,
 			types.ModuleName, // borrowed integer is transferred to reserve
 			sdk.NewCoins(borrowCoin),
 		); err != nil {
-			return k.updateInsufficientFundsError(ctx, moduleAddr, amt, err)
+			return k.updateInsufficientFundsError(ctx, ed, moduleAddr, amt, err)
 		}
 	}
 
 	// Case #3: Does not require borrow, but remainder has accumulated enough
 	// fractional amounts to burn 1 integer coin.
 	if !requiresBorrow && overflowingRemainder {
-		reserveBurnCoins := sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.OneInt()))
+		reserveBurnCoins := sdk.NewCoins(sdk.NewCoin(ed.IntegerDenom, sdkmath.OneInt()))
 		if err := k.bk.BurnCoins(ctx, types.ModuleName, reserveBurnCoins); err != nil {
 			return fmt.Errorf("failed to burn %s for reserve: %w", reserveBurnCoins, err)
 		}
 
-		newRemainder = newRemainder.Sub(types.ConversionFactor())
+		newRemainder = newRemainder.Sub(conversionFactor)
 	}
 
 	// Case #4: No additional work required, no borrow needed and no additional
@@ -174,17 +170,17 @@ func (k Keeper) burnExtendedCoin(
 	// Burn the integer amount - this may include the extra optimization burn
 	// from case #1
 	if !integerBurnAmount.IsZero() {
-		coin := sdk.NewCoin(types.IntegerCoinDenom(), integerBurnAmount)
+		coin := sdk.NewCoin(ed.IntegerDenom, integerBurnAmount)
 		if err := k.bk.BurnCoins(ctx, moduleName, sdk.NewCoins(coin)); err != nil {
-			return k.updateInsufficientFundsError(ctx, moduleAddr, amt, err)
+			return k.updateInsufficientFundsError(ctx, ed, moduleAddr, amt, err)
 		}
 	}
 
 	// Assign new fractional balance in x/precisebank
-	k.SetFractionalBalance(ctx, moduleAddr, newFractionalBalance)
+	k.SetDenomFractionalBalance(ctx, ed, moduleAddr, newFractionalBalance)
 
 	// Update remainder for burned fractional coins
-	k.SetRemainderAmount(ctx, newRemainder)
+	k.SetDenomRemainderAmount(ctx, ed, newRemainder)
 
 	// Emit event for fractional balance change
 	types.EmitEventFractionalBalanceChange(ctx, moduleAddr, prevFractionalBalance, newFractionalBalance)
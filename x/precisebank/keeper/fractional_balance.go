@@ -14,7 +14,17 @@ import (
 
 // GetFractionalBalance returns the fractional balance for an address.
 func (k *Keeper) GetFractionalBalance(ctx sdk.Context, address sdk.AccAddress) sdkmath.Int {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	return k.GetDenomFractionalBalance(ctx, types.EVMExtendedDenom(), address)
+}
+
+// GetDenomFractionalBalance returns the fractional balance of the given
+// extended denom for an address.
+func (k *Keeper) GetDenomFractionalBalance(
+	ctx sdk.Context,
+	ed types.ExtendedDenom,
+	address sdk.AccAddress,
+) sdkmath.Int {
+	store := k.fractionalBalanceStore(ctx, ed)
 
 	bz := store.Get(types.FractionalBalanceKey(address))
 	if bz == nil {
@@ -34,23 +44,34 @@ func (k *Keeper) SetFractionalBalance(
 	ctx sdk.Context,
 	address sdk.AccAddress,
 	amount sdkmath.Int,
+) {
+	k.SetDenomFractionalBalance(ctx, types.EVMExtendedDenom(), address, amount)
+}
+
+// SetDenomFractionalBalance sets the fractional balance of the given extended
+// denom for an address.
+func (k *Keeper) SetDenomFractionalBalance(
+	ctx sdk.Context,
+	ed types.ExtendedDenom,
+	address sdk.AccAddress,
+	amount sdkmath.Int,
 ) {
 	if address.Empty() {
 		panic(errors.New("address cannot be empty"))
 	}
 
 	if amount.IsZero() {
-		k.DeleteFractionalBalance(ctx, address)
+		k.DeleteDenomFractionalBalance(ctx, ed, address)
 		return
 	}
 
 	// Ensure the fractional balance is valid before setting it. Use the
 	// ValidateFractionalAmount function to validate the amount.
-	if err := types.ValidateFractionalAmount(amount); err != nil {
+	if err := ed.ValidateFractionalAmount(amount); err != nil {
 		panic(fmt.Errorf("amount is invalid: %w", err))
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	store := k.fractionalBalanceStore(ctx, ed)
 
 	amountBytes, err := amount.Marshal()
 	if err != nil {
@@ -65,7 +86,17 @@ func (k *Keeper) DeleteFractionalBalance(
 	ctx sdk.Context,
 	address sdk.AccAddress,
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	k.DeleteDenomFractionalBalance(ctx, types.EVMExtendedDenom(), address)
+}
+
+// DeleteDenomFractionalBalance deletes the fractional balance of the given
+// extended denom for an address.
+func (k *Keeper) DeleteDenomFractionalBalance(
+	ctx sdk.Context,
+	ed types.ExtendedDenom,
+	address sdk.AccAddress,
+) {
+	store := k.fractionalBalanceStore(ctx, ed)
 	store.Delete(types.FractionalBalanceKey(address))
 }
 
@@ -75,7 +106,17 @@ func (k *Keeper) IterateFractionalBalances(
 	ctx sdk.Context,
 	cb func(address sdk.AccAddress, amount sdkmath.Int) (stop bool),
 ) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	k.IterateDenomFractionalBalances(ctx, types.EVMExtendedDenom(), cb)
+}
+
+// IterateDenomFractionalBalances iterates over all fractional balances of the
+// given extended denom and performs a callback function.
+func (k *Keeper) IterateDenomFractionalBalances(
+	ctx sdk.Context,
+	ed types.ExtendedDenom,
+	cb func(address sdk.AccAddress, amount sdkmath.Int) (stop bool),
+) {
+	store := k.fractionalBalanceStore(ctx, ed)
 
 	iterator := store.Iterator(nil, nil)
 	defer iterator.Close()
@@ -96,12 +137,28 @@ func (k *Keeper) IterateFractionalBalances(
 
 // GetTotalSumFractionalBalances returns the sum of all fractional balances.
 func (k *Keeper) GetTotalSumFractionalBalances(ctx sdk.Context) sdkmath.Int {
+	return k.GetDenomTotalSumFractionalBalances(ctx, types.EVMExtendedDenom())
+}
+
+// GetDenomTotalSumFractionalBalances returns the sum of all fractional balances
+// of the given extended denom.
+func (k *Keeper) GetDenomTotalSumFractionalBalances(ctx sdk.Context, ed types.ExtendedDenom) sdkmath.Int {
 	sum := sdkmath.ZeroInt()
 
-	k.IterateFractionalBalances(ctx, func(_ sdk.AccAddress, amount sdkmath.Int) bool {
+	k.IterateDenomFractionalBalances(ctx, ed, func(_ sdk.AccAddress, amount sdkmath.Int) bool {
 		sum = sum.Add(amount)
 		return false
 	})
 
 	return sum
 }
+
+// fractionalBalanceStore returns the store of the fractional balances of the
+// given extended denom. The EVM denom balances are kept under the original
+// prefix for backwards compatibility.
+func (k *Keeper) fractionalBalanceStore(ctx sdk.Context, ed types.ExtendedDenom) prefix.Store {
+	if ed.ExtendedDenom == types.ExtendedCoinDenom() {
+		return prefix.NewStore(ctx.KVStore(k.storeKey), types.FractionalBalancePrefix)
+	}
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomFractionalBalancesPrefix(ed.ExtendedDenom))
+}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/cosmos/evm/x/precisebank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +22,37 @@ func NewQueryServerImpl(k Keeper) types.QueryServer {
 
 var _ types.QueryServer = queryServer{}
 
+// Params returns the x/precisebank module parameters.
+func (s queryServer) Params(
+	goCtx context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: s.keeper.GetParams(ctx),
+	}, nil
+}
+
+// TotalFractionalBalances returns the sum of all fractional balances.
+func (s queryServer) TotalFractionalBalances(
+	goCtx context.Context,
+	req *types.QueryTotalFractionalBalancesRequest,
+) (*types.QueryTotalFractionalBalancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ed, err := s.queryExtendedDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	totalAmount := s.keeper.GetDenomTotalSumFractionalBalances(ctx, ed)
+
+	return &types.QueryTotalFractionalBalancesResponse{
+		Total: sdk.NewCoin(ed.ExtendedDenom, totalAmount),
+	}, nil
+}
+
 // Remainder returns the remainder amount in x/precisebank.
 func (s queryServer) Remainder(
 	goCtx context.Context,
@@ -26,8 +60,13 @@ func (s queryServer) Remainder(
 ) (*types.QueryRemainderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	remainder := s.keeper.GetRemainderAmount(ctx)
-	remainderCoin := sdk.NewCoin(types.ExtendedCoinDenom(), remainder)
+	ed, err := s.queryExtendedDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	remainder := s.keeper.GetDenomRemainderAmount(ctx, ed)
+	remainderCoin := sdk.NewCoin(ed.ExtendedDenom, remainder)
 
 	return &types.QueryRemainderResponse{
 		Remainder: remainderCoin,
@@ -46,10 +85,30 @@ func (s queryServer) FractionalBalance(
 		return nil, err
 	}
 
-	amt := s.keeper.GetFractionalBalance(ctx, address)
-	fractionalBalance := sdk.NewCoin(types.ExtendedCoinDenom(), amt)
+	ed, err := s.queryExtendedDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := s.keeper.GetDenomFractionalBalance(ctx, ed, address)
+	fractionalBalance := sdk.NewCoin(ed.ExtendedDenom, amt)
 
 	return &types.QueryFractionalBalanceResponse{
 		FractionalBalance: fractionalBalance,
 	}, nil
 }
+
+// queryExtendedDenom returns the extended denom for the given query denom,
+// defaulting to the extended EVM denom if empty.
+func (s queryServer) queryExtendedDenom(ctx sdk.Context, denom string) (types.ExtendedDenom, error) {
+	if denom == "" {
+		return types.EVMExtendedDenom(), nil
+	}
+
+	ed, found := s.keeper.extendedDenom(ctx, denom)
+	if !found {
+		return types.ExtendedDenom{}, status.Errorf(codes.NotFound, "%s is not an extended denom", denom)
+	}
+
+	return ed, nil
+}
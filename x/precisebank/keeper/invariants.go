@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/evm/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the x/precisebank module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) { //nolint:staticcheck // InvariantRegistry is deprecated along with x/crisis
+	ir.RegisterRoute(types.ModuleName, "reserve-backs-fractions", ReserveBacksFractionsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-fractional-balances", ValidFractionalAmountsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-remainder-amount", ValidRemainderAmountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "fractional-denom-not-in-bank", FractionalDenomNotInBankInvariant(k))
+}
+
+// AllInvariants runs all invariants of the x/precisebank module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []func(Keeper) sdk.Invariant{
+			ReserveBacksFractionsInvariant,
+			ValidFractionalAmountsInvariant,
+			ValidRemainderAmountInvariant,
+			FractionalDenomNotInBankInvariant,
+		} {
+			if res, stop := inv(k)(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// ReserveBacksFractionsInvariant checks that the integer balance of the
+// reserve module account is equal to the sum of all fractional balances and
+// the remainder, for every extended denom.
+func ReserveBacksFractionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		reserveAddr := k.ak.GetModuleAddress(types.ModuleName)
+
+		for _, ed := range k.extendedDenoms(ctx) {
+			reserveBalance := k.bk.GetBalance(ctx, reserveAddr, ed.IntegerDenom)
+			reserveExtended := reserveBalance.Amount.Mul(ed.ConversionFactor())
+
+			fractionalTotal := k.GetDenomTotalSumFractionalBalances(ctx, ed)
+			remainder := k.GetDenomRemainderAmount(ctx, ed)
+			backed := fractionalTotal.Add(remainder)
+
+			if !reserveExtended.Equal(backed) {
+				broken = true
+				msg += fmt.Sprintf(
+					"%s reserve balance %s mismatches %s (fractional balances %s + remainder %s)\n",
+					ed.ExtendedDenom, reserveExtended, backed, fractionalTotal, remainder,
+				)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "reserve-backs-fractions",
+			msg,
+		), broken
+	}
+}
+
+// ValidFractionalAmountsInvariant checks that all fractional balances are in
+// the valid range of their extended denom.
+func ValidFractionalAmountsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, ed := range k.extendedDenoms(ctx) {
+			k.IterateDenomFractionalBalances(ctx, ed, func(addr sdk.AccAddress, amount sdkmath.Int) bool {
+				if err := ed.ValidateFractionalAmount(amount); err != nil {
+					count++
+					msg += fmt.Sprintf("\t%s %s fractional balance %s is invalid: %s\n", addr, ed.ExtendedDenom, amount, err)
+				}
+
+				return false
+			})
+		}
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "valid-fractional-balances",
+			fmt.Sprintf("amount of invalid fractional balances found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// ValidRemainderAmountInvariant checks that the remainder of every extended
+// denom is zero or a valid fractional amount.
+func ValidRemainderAmountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, ed := range k.extendedDenoms(ctx) {
+			remainder := k.GetDenomRemainderAmount(ctx, ed)
+			if remainder.IsZero() {
+				continue
+			}
+
+			if err := ed.ValidateFractionalAmount(remainder); err != nil {
+				broken = true
+				msg += fmt.Sprintf("%s remainder %s is invalid: %s\n", ed.ExtendedDenom, remainder, err)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "valid-remainder-amount",
+			msg,
+		), broken
+	}
+}
+
+// FractionalDenomNotInBankInvariant checks that the bank does not hold any
+// supply of the extended denoms, as they are only managed by x/precisebank.
+func FractionalDenomNotInBankInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, ed := range k.extendedDenoms(ctx) {
+			extendedSupply := k.bk.GetSupply(ctx, ed.ExtendedDenom)
+			if !extendedSupply.IsZero() {
+				broken = true
+				msg += fmt.Sprintf("x/bank should not hold any %s but has supply of %s\n", ed.ExtendedDenom, extendedSupply)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "fractional-denom-not-in-bank",
+			msg,
+		), broken
+	}
+}
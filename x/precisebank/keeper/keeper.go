@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/cosmos/evm/x/precisebank/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,9 +21,16 @@ var _ evmtypes.BankKeeper = Keeper{}
 type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey storetypes.StoreKey
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
 
-	bk types.BankKeeper
-	ak types.AccountKeeper
+	bk          types.BankKeeper
+	ak          types.AccountKeeper
+	erc20Keeper types.Erc20Keeper
+
+	// denomSet caches the extended denoms registered in the params. It is
+	// shared between the copies of the keeper.
+	denomSet *atomic.Pointer[extendedDenomSet]
 }
 
 // NewKeeper creates a new keeper
@@ -30,15 +39,60 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bk types.BankKeeper,
 	ak types.AccountKeeper,
+	erc20Keeper types.Erc20Keeper,
+	authority sdk.AccAddress,
 ) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		bk:       bk,
-		ak:       ak,
+		cdc:         cdc,
+		storeKey:    storeKey,
+		authority:   authority,
+		bk:          bk,
+		ak:          ak,
+		erc20Keeper: erc20Keeper,
+		denomSet:    &atomic.Pointer[extendedDenomSet]{},
 	}
 }
 
+// GetAuthority returns the x/precisebank module authority.
+func (k Keeper) GetAuthority() sdk.AccAddress {
+	return k.authority
+}
+
+// extendedCoin is an amount of an extended denom managed by x/precisebank.
+type extendedCoin struct {
+	denom  types.ExtendedDenom
+	amount sdkmath.Int
+}
+
+// splitExtendedCoins splits the given coins into the coins that are passed
+// through to x/bank and the amounts of the extended denoms managed by
+// x/precisebank.
+func (k Keeper) splitExtendedCoins(ctx sdk.Context, amt sdk.Coins) (sdk.Coins, []extendedCoin) {
+	var (
+		passthroughCoins sdk.Coins
+		extendedCoins    []extendedCoin
+	)
+
+	for _, coin := range amt {
+		ed, found := k.extendedDenom(ctx, coin.Denom)
+		if !found {
+			passthroughCoins = append(passthroughCoins, coin)
+			continue
+		}
+
+		if coin.Amount.IsPositive() {
+			extendedCoins = append(extendedCoins, extendedCoin{denom: ed, amount: coin.Amount})
+		}
+	}
+
+	return passthroughCoins, extendedCoins
+}
+
 // BANK KEEPER INTERFACE PASSTHROUGHS
 func (k Keeper) SendCoinsFromModuleToAccountVirtual(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
 	return k.bk.SendCoinsFromModuleToAccountVirtual(ctx, senderModule, recipientAddr, amt)
@@ -52,6 +106,21 @@ func (k Keeper) IterateTotalSupply(ctx context.Context, cb func(coin sdk.Coin) b
 	k.bk.IterateTotalSupply(ctx, cb)
 }
 
+// GetSupply returns the supply of the given denom. The supply of an extended
+// denom is the integer supply scaled to 18 decimals minus the remainder, as the
+// remainder is backed by the reserve but not owned by any account.
 func (k Keeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
-	return k.bk.GetSupply(ctx, denom)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ed, found := k.extendedDenom(sdkCtx, denom)
+	if !found || ed.IntegerDenom == ed.ExtendedDenom {
+		return k.bk.GetSupply(ctx, denom)
+	}
+
+	integerSupply := k.bk.GetSupply(ctx, ed.IntegerDenom)
+	extendedSupply := integerSupply.Amount.
+		Mul(ed.ConversionFactor()).
+		Sub(k.GetDenomRemainderAmount(sdkCtx, ed))
+
+	return sdk.NewCoin(ed.ExtendedDenom, extendedSupply)
 }
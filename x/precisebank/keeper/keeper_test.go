@@ -17,6 +17,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // testData defines necessary fields for testing keeper store methods and mocks
@@ -45,7 +47,7 @@ func newMockedTestData(t *testing.T) testData {
 	chainID := testconstants.SixDecimalsChainID.EVMChainID
 	cfg := evmencoding.MakeConfig(chainID)
 	cdc := cfg.Codec
-	k := keeper.NewKeeper(cdc, storeKey, bk, ak) //nolint: staticcheck // this variable is used
+	k := keeper.NewKeeper(cdc, storeKey, bk, ak, nil, authtypes.NewModuleAddress(govtypes.ModuleName)) //nolint: staticcheck // this variable is used
 	evmConfigurator := vmtypes.NewEVMConfigurator().
 		WithEVMCoinInfo(testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID])
 	evmConfigurator.ResetTestConfig()
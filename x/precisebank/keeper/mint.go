@@ -15,7 +15,7 @@ import (
 )
 
 // MintCoins creates new coins from thin air and adds it to the module account.
-// If ExtendedCoinDenom is provided, the corresponding fractional amount is
+// If extended denoms are provided, the corresponding fractional amounts are
 // added to the module state.
 // It will panic if the module account does not exist or is unauthorized.
 func (k Keeper) MintCoins(goCtx context.Context, moduleName string, amt sdk.Coins) error {
@@ -42,15 +42,8 @@ func (k Keeper) MintCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	// Get non-ExtendedCoinDenom coins
-	passthroughCoins := amt
-
-	extendedAmount := amt.AmountOf(types.ExtendedCoinDenom())
-	if extendedAmount.IsPositive() {
-		// Remove ExtendedCoinDenom from the coins as it is managed by x/precisebank
-		removeCoin := sdk.NewCoin(types.ExtendedCoinDenom(), extendedAmount)
-		passthroughCoins = amt.Sub(removeCoin)
-	}
+	// Remove the extended coins as they are managed by x/precisebank
+	passthroughCoins, extendedCoins := k.splitExtendedCoins(ctx, amt)
 
 	// Coins unmanaged by x/precisebank are passed through to x/bank
 	if !passthroughCoins.Empty() {
@@ -59,9 +52,9 @@ func (k Keeper) MintCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 		}
 	}
 
-	// Only mint extended coin if the amount is positive
-	if extendedAmount.IsPositive() {
-		if err := k.mintExtendedCoin(ctx, moduleName, extendedAmount); err != nil {
+	// Only mint extended coins with a positive amount
+	for _, ec := range extendedCoins {
+		if err := k.mintExtendedCoin(ctx, ec.denom, moduleName, ec.amount); err != nil {
 			return err
 		}
 	}
@@ -87,18 +80,21 @@ func (k Keeper) MintCoins(goCtx context.Context, moduleName string, amt sdk.Coin
 //   - Increase direct account mint amount by 1, no extra reserve mint
 func (k Keeper) mintExtendedCoin(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 	recipientModuleName string,
 	amt sdkmath.Int,
 ) error {
+	conversionFactor := ed.ConversionFactor()
+
 	moduleAddr := k.ak.GetModuleAddress(recipientModuleName)
 
 	// Don't create fractional balances for the precisebank module account itself
 	// The precisebank module account is the reserve and should not have fractional balances
 	if recipientModuleName == types.ModuleName {
 		// For the precisebank module account, just mint the integer coins directly
-		integerMintAmount := amt.Quo(types.ConversionFactor())
+		integerMintAmount := amt.Quo(conversionFactor)
 		if integerMintAmount.IsPositive() {
-			integerMintCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerMintAmount)
+			integerMintCoin := sdk.NewCoin(ed.IntegerDenom, integerMintAmount)
 			if err := k.bk.MintCoins(ctx, recipientModuleName, sdk.NewCoins(integerMintCoin)); err != nil {
 				return err
 			}
@@ -107,15 +103,15 @@ func (k Keeper) mintExtendedCoin(
 	}
 
 	// Get current module account fractional balance - 0 if not found
-	fractionalAmount := k.GetFractionalBalance(ctx, moduleAddr)
+	fractionalAmount := k.GetDenomFractionalBalance(ctx, ed, moduleAddr)
 
 	// Get separated mint amounts
-	integerMintAmount := amt.Quo(types.ConversionFactor())
-	fractionalMintAmount := amt.Mod(types.ConversionFactor())
+	integerMintAmount := amt.Quo(conversionFactor)
+	fractionalMintAmount := amt.Mod(conversionFactor)
 
 	// Get previous remainder amount, as we need to it before carry calculation
 	// for the optimization path.
-	prevRemainder := k.GetRemainderAmount(ctx)
+	prevRemainder := k.GetDenomRemainderAmount(ctx, ed)
 
 	// Deduct new remainder with minted fractional amount. This will result in
 	// two cases:
@@ -133,11 +129,11 @@ func (k Keeper) mintExtendedCoin(
 	newFractionalBalance := fractionalAmount.Add(fractionalMintAmount)
 
 	// Case #3 - Integer carry, remainder is sufficient (0 or positive)
-	if newFractionalBalance.GTE(types.ConversionFactor()) && newRemainder.GTE(sdkmath.ZeroInt()) {
+	if newFractionalBalance.GTE(conversionFactor) && newRemainder.GTE(sdkmath.ZeroInt()) {
 		// Carry should send from reserve -> account, instead of minting an
 		// extra integer coin. Otherwise doing an extra mint will require a burn
 		// from reserves to maintain exact backing.
-		carryCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.OneInt())
+		carryCoin := sdk.NewCoin(ed.IntegerDenom, sdkmath.OneInt())
 
 		// SendCoinsFromModuleToModule allows for sending coins even if the
 		// recipient module account is blocked.
@@ -154,7 +150,7 @@ func (k Keeper) mintExtendedCoin(
 	// Case #4 - Integer carry, remainder is insufficient
 	// This is the optimization path where the integer mint amount is increased
 	// by 1, instead of doing both a reserve -> account transfer and reserve mint.
-	if newFractionalBalance.GTE(types.ConversionFactor()) && newRemainder.IsNegative() {
+	if newFractionalBalance.GTE(conversionFactor) && newRemainder.IsNegative() {
 		integerMintAmount = integerMintAmount.AddRaw(1)
 	}
 
@@ -163,16 +159,16 @@ func (k Keeper) mintExtendedCoin(
 	// fractional amounts x and y where both x and y < ConversionFactor
 	// x + y < (2 * ConversionFactor) - 2
 	// x + y < 1 integer amount + fractional amount
-	if newFractionalBalance.GTE(types.ConversionFactor()) {
+	if newFractionalBalance.GTE(conversionFactor) {
 		// Subtract 1 integer equivalent amount of fractional balance. Same
 		// behavior as using .Mod() in this case.
-		newFractionalBalance = newFractionalBalance.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Sub(conversionFactor)
 	}
 
 	// Mint new integer amounts in x/bank - including carry over from fractional
 	// amount if any.
 	if integerMintAmount.IsPositive() {
-		integerMintCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerMintAmount)
+		integerMintCoin := sdk.NewCoin(ed.IntegerDenom, integerMintAmount)
 
 		if err := k.bk.MintCoins(
 			ctx,
@@ -184,7 +180,7 @@ func (k Keeper) mintExtendedCoin(
 	}
 
 	// Assign new fractional balance in x/precisebank
-	k.SetFractionalBalance(ctx, moduleAddr, newFractionalBalance)
+	k.SetDenomFractionalBalance(ctx, ed, moduleAddr, newFractionalBalance)
 
 	// ----------------------------------------
 	// Update remainder & reserves to back minted fractional coins
@@ -197,10 +193,10 @@ func (k Keeper) mintExtendedCoin(
 	// Optimization: This is only done when the integer amount does NOT carry,
 	// as a direct account mint is done instead of integer carry transfer +
 	// insufficient remainder reserve mint.
-	wasCarried := fractionalAmount.Add(fractionalMintAmount).GTE(types.ConversionFactor())
+	wasCarried := fractionalAmount.Add(fractionalMintAmount).GTE(conversionFactor)
 	if prevRemainder.LT(fractionalMintAmount) && !wasCarried {
 		// Always only 1 integer coin, as fractionalMintAmount < ConversionFactor
-		reserveMintCoins := sdk.NewCoins(sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.OneInt()))
+		reserveMintCoins := sdk.NewCoins(sdk.NewCoin(ed.IntegerDenom, sdkmath.OneInt()))
 		if err := k.bk.MintCoins(ctx, types.ModuleName, reserveMintCoins); err != nil {
 			return fmt.Errorf("failed to mint %s for reserve: %w", reserveMintCoins, err)
 		}
@@ -210,10 +206,10 @@ func (k Keeper) mintExtendedCoin(
 	// This needs to be adjusted back to the corresponding positive value. The
 	// remainder will be always < conversionFactor after add if it is negative.
 	if newRemainder.IsNegative() {
-		newRemainder = newRemainder.Add(types.ConversionFactor())
+		newRemainder = newRemainder.Add(conversionFactor)
 	}
 
-	k.SetRemainderAmount(ctx, newRemainder)
+	k.SetDenomRemainderAmount(ctx, ed, newRemainder)
 
 	// Emit event for fractional balance change
 	types.EmitEventFractionalBalanceChange(ctx, moduleAddr, fractionalAmount, newFractionalBalance)
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/cosmos/evm/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetParams returns the total set of precisebank parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the precisebank parameters to the param space and refreshes
+// the cached extended denoms. Extended denoms can only be removed or modified
+// if they don't have any outstanding fractional balance or remainder.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	for _, ed := range params.ExtendedDenoms {
+		if ed.IntegerDenom == types.IntegerCoinDenom() || ed.ExtendedDenom == types.ExtendedCoinDenom() ||
+			ed.IntegerDenom == types.ExtendedCoinDenom() || ed.ExtendedDenom == types.IntegerCoinDenom() {
+			return fmt.Errorf("the EVM denom is always extended and cannot be registered in params")
+		}
+	}
+
+	for _, prev := range k.GetParams(ctx).ExtendedDenoms {
+		if ed, found := params.FindExtendedDenom(prev.ExtendedDenom); found && ed == prev {
+			continue
+		}
+
+		if k.hasFractionalState(ctx, prev) {
+			return fmt.Errorf("cannot remove or modify extended denom %s with outstanding fractional balances", prev.ExtendedDenom)
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+	k.denomSet.Store(newExtendedDenomSet(params.ExtendedDenoms))
+	return nil
+}
+
+// BeginBlock reloads the cached extended denoms from the params, which discards
+// any cached params update whose state changes were reverted afterwards.
+func (k Keeper) BeginBlock(ctx sdk.Context) {
+	k.denomSet.Store(newExtendedDenomSet(k.GetParams(ctx).ExtendedDenoms))
+}
+
+// validateAllowances returns an error if the given params change the decimals
+// of a denom whose ERC20 token has any allowance, i.e. if they extend a denom,
+// revert an extended denom or modify its decimals. The allowances are
+// denominated in the token decimals, so their value would change otherwise.
+func (k Keeper) validateAllowances(ctx sdk.Context, params types.Params) error {
+	prevParams := k.GetParams(ctx)
+
+	for _, prev := range prevParams.ExtendedDenoms {
+		if ed, found := params.FindIntegerDenom(prev.IntegerDenom); found && ed.Decimals == prev.Decimals {
+			continue
+		}
+		if err := k.validateNoAllowances(ctx, prev.IntegerDenom); err != nil {
+			return err
+		}
+	}
+
+	for _, ed := range params.ExtendedDenoms {
+		if _, found := prevParams.FindIntegerDenom(ed.IntegerDenom); found {
+			// checked above
+			continue
+		}
+		if err := k.validateNoAllowances(ctx, ed.IntegerDenom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateNoAllowances returns an error if the ERC20 token pair of the given
+// integer denom has any allowance.
+func (k Keeper) validateNoAllowances(ctx sdk.Context, integerDenom string) error {
+	if k.erc20Keeper == nil {
+		return nil
+	}
+
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, integerDenom))
+	if !found {
+		return nil
+	}
+
+	if k.erc20Keeper.HasAllowances(ctx, pair.GetERC20Contract()) {
+		return fmt.Errorf("cannot change the decimals of denom %s: its ERC20 token %s has outstanding allowances", integerDenom, pair.Erc20Address)
+	}
+	return nil
+}
+
+// GetExtendedDenom returns the extended denom registered in the params for the
+// given integer denom. The EVM denom is not included, as its extended balances
+// are handled by the EVM directly.
+func (k Keeper) GetExtendedDenom(ctx sdk.Context, integerDenom string) (types.ExtendedDenom, bool) {
+	ed, found := k.extendedDenomSet(ctx).byInteger[integerDenom]
+	return ed, found
+}
+
+// extendedDenom returns the ExtendedDenom for the given denom if it is managed
+// by x/precisebank, i.e. if it is the extended EVM denom or one of the extended
+// denoms registered in the params.
+func (k Keeper) extendedDenom(ctx sdk.Context, denom string) (types.ExtendedDenom, bool) {
+	if denom == types.ExtendedCoinDenom() {
+		return types.EVMExtendedDenom(), true
+	}
+	ed, found := k.extendedDenomSet(ctx).byExtended[denom]
+	return ed, found
+}
+
+// extendedDenoms returns all the extended denoms managed by x/precisebank,
+// starting with the extended EVM denom. The EVM denom is omitted on 18 decimals
+// chains, where it is not extended.
+func (k Keeper) extendedDenoms(ctx sdk.Context) []types.ExtendedDenom {
+	var eds []types.ExtendedDenom
+	if !types.IsExtendedDenomSameAsIntegerDenom() {
+		eds = append(eds, types.EVMExtendedDenom())
+	}
+	return append(eds, k.extendedDenomSet(ctx).denoms...)
+}
+
+// extendedDenomSet is an index of the extended denoms registered in the params.
+// It must not be modified once created, as it is shared between goroutines.
+type extendedDenomSet struct {
+	denoms     []types.ExtendedDenom
+	byInteger  map[string]types.ExtendedDenom
+	byExtended map[string]types.ExtendedDenom
+}
+
+// newExtendedDenomSet creates the index of the given extended denoms.
+func newExtendedDenomSet(eds []types.ExtendedDenom) *extendedDenomSet {
+	set := &extendedDenomSet{
+		denoms:     slices.Clone(eds),
+		byInteger:  make(map[string]types.ExtendedDenom, len(eds)),
+		byExtended: make(map[string]types.ExtendedDenom, len(eds)),
+	}
+	for _, ed := range eds {
+		set.byInteger[ed.IntegerDenom] = ed
+		set.byExtended[ed.ExtendedDenom] = ed
+	}
+	return set
+}
+
+// extendedDenomSet returns the cached extended denoms registered in the params,
+// loading them from the store if they have not been cached yet.
+func (k Keeper) extendedDenomSet(ctx sdk.Context) *extendedDenomSet {
+	if set := k.denomSet.Load(); set != nil {
+		return set
+	}
+
+	set := newExtendedDenomSet(k.GetParams(ctx).ExtendedDenoms)
+	k.denomSet.Store(set)
+	return set
+}
+
+// hasFractionalState returns true if there is any fractional balance or
+// remainder for the given extended denom.
+func (k Keeper) hasFractionalState(ctx sdk.Context, ed types.ExtendedDenom) bool {
+	if !k.GetDenomRemainderAmount(ctx, ed).IsZero() {
+		return true
+	}
+
+	found := false
+	k.IterateDenomFractionalBalances(ctx, ed, func(_ sdk.AccAddress, _ sdkmath.Int) bool {
+		found = true
+		return true
+	})
+	return found
+}
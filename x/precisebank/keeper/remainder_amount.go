@@ -13,10 +13,19 @@ import (
 // GetRemainderAmount returns the internal remainder amount.
 func (k *Keeper) GetRemainderAmount(
 	ctx sdk.Context,
+) sdkmath.Int {
+	return k.GetDenomRemainderAmount(ctx, types.EVMExtendedDenom())
+}
+
+// GetDenomRemainderAmount returns the internal remainder amount of the given
+// extended denom.
+func (k *Keeper) GetDenomRemainderAmount(
+	ctx sdk.Context,
+	ed types.ExtendedDenom,
 ) sdkmath.Int {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(types.RemainderBalanceKey)
+	bz := store.Get(remainderKey(ed))
 	if bz == nil {
 		return sdkmath.ZeroInt()
 	}
@@ -33,18 +42,28 @@ func (k *Keeper) GetRemainderAmount(
 func (k *Keeper) SetRemainderAmount(
 	ctx sdk.Context,
 	amount sdkmath.Int,
+) {
+	k.SetDenomRemainderAmount(ctx, types.EVMExtendedDenom(), amount)
+}
+
+// SetDenomRemainderAmount sets the internal remainder amount of the given
+// extended denom.
+func (k *Keeper) SetDenomRemainderAmount(
+	ctx sdk.Context,
+	ed types.ExtendedDenom,
+	amount sdkmath.Int,
 ) {
 	// Prevent storing zero amounts. In practice, the remainder amount should
 	// only be non-zero during transactions as mint and burns should net zero
 	// due to only being used for EVM transfers.
 	if amount.IsZero() {
-		k.DeleteRemainderAmount(ctx)
+		k.DeleteDenomRemainderAmount(ctx, ed)
 		return
 	}
 
 	// Ensure the remainder is valid before setting it. Follows the same
 	// validation as FractionalBalance with the same value range.
-	if err := types.ValidateFractionalAmount(amount); err != nil {
+	if err := ed.ValidateFractionalAmount(amount); err != nil {
 		panic(fmt.Errorf("remainder amount is invalid: %w", err))
 	}
 
@@ -55,13 +74,32 @@ func (k *Keeper) SetRemainderAmount(
 		panic(fmt.Errorf("failed to marshal remainder amount: %w", err))
 	}
 
-	store.Set(types.RemainderBalanceKey, amountBytes)
+	store.Set(remainderKey(ed), amountBytes)
 }
 
 // DeleteRemainderAmount deletes the internal remainder amount.
 func (k *Keeper) DeleteRemainderAmount(
 	ctx sdk.Context,
+) {
+	k.DeleteDenomRemainderAmount(ctx, types.EVMExtendedDenom())
+}
+
+// DeleteDenomRemainderAmount deletes the internal remainder amount of the
+// given extended denom.
+func (k *Keeper) DeleteDenomRemainderAmount(
+	ctx sdk.Context,
+	ed types.ExtendedDenom,
 ) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.RemainderBalanceKey)
+	store.Delete(remainderKey(ed))
+}
+
+// remainderKey returns the store key of the remainder of the given extended
+// denom. The EVM denom remainder is kept under the original key for backwards
+// compatibility.
+func remainderKey(ed types.ExtendedDenom) []byte {
+	if ed.ExtendedDenom == types.ExtendedCoinDenom() {
+		return types.RemainderBalanceKey
+	}
+	return types.DenomRemainderBalanceKey(ed.ExtendedDenom)
 }
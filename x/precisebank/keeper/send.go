@@ -8,7 +8,6 @@ import (
 	"github.com/hashicorp/go-metrics"
 
 	"github.com/cosmos/evm/x/precisebank/types"
-	evmtypes "github.com/cosmos/evm/x/vm/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -39,20 +38,20 @@ func (k Keeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 	return nil
 }
 
-// IsSendEnabledCoin checks whether the sent coin is the extended denom, in which case
-// it also checks for the SendEnabled status on the EVM denom. The rest pass through the
-// regular bank keeper implementation.
+// IsSendEnabledCoin checks whether the sent coin is an extended denom, in which case
+// it also checks for the SendEnabled status on the corresponding integer denom. The rest
+// pass through the regular bank keeper implementation.
 func (k Keeper) IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bool {
-	if coin.Denom == evmtypes.GetEVMCoinExtendedDenom() {
-		return k.bk.IsSendEnabledCoin(ctx, sdk.NewCoin(evmtypes.GetEVMCoinDenom(), coin.Amount.Quo(types.ConversionFactor())))
+	if ed, found := k.extendedDenom(sdk.UnwrapSDKContext(ctx), coin.Denom); found {
+		return k.bk.IsSendEnabledCoin(ctx, sdk.NewCoin(ed.IntegerDenom, coin.Amount.Quo(ed.ConversionFactor())))
 	}
 	return k.bk.IsSendEnabledCoin(ctx, coin)
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure. This handles transfers including
-// ExtendedCoinDenom and supports non-ExtendedCoinDenom transfers by passing
-// through to x/bank.
+// An error is returned upon failure. This handles transfers including the
+// extended denoms and supports non-extended transfers by passing through to
+// x/bank.
 func (k Keeper) SendCoins(
 	goCtx context.Context,
 	from, to sdk.AccAddress,
@@ -67,14 +66,8 @@ func (k Keeper) SendCoins(
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	passthroughCoins := amt
-	extendedCoinAmount := amt.AmountOf(types.ExtendedCoinDenom())
-
-	// Remove the extended coin amount from the passthrough coins
-	if extendedCoinAmount.IsPositive() {
-		subCoin := sdk.NewCoin(types.ExtendedCoinDenom(), extendedCoinAmount)
-		passthroughCoins = amt.Sub(subCoin)
-	}
+	// Remove the extended coins from the passthrough coins
+	passthroughCoins, extendedCoins := k.splitExtendedCoins(ctx, amt)
 
 	// Send the passthrough coins through x/bank
 	if passthroughCoins.IsAllPositive() {
@@ -83,9 +76,9 @@ func (k Keeper) SendCoins(
 		}
 	}
 
-	// Send the extended coin amount through x/precisebank
-	if extendedCoinAmount.IsPositive() {
-		if err := k.sendExtendedCoins(ctx, from, to, extendedCoinAmount); err != nil {
+	// Send the extended coin amounts through x/precisebank
+	for _, ec := range extendedCoins {
+		if err := k.sendExtendedCoins(ctx, ec.denom, from, to, ec.amount); err != nil {
 			return err
 		}
 	}
@@ -124,19 +117,22 @@ func (k Keeper) SendCoins(
 // | F             | F              | F               |
 func (k Keeper) sendExtendedCoins(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 	from, to sdk.AccAddress,
 	amt sdkmath.Int,
 ) error {
+	conversionFactor := ed.ConversionFactor()
+
 	// Don't create fractional balances for the precisebank module account
 	// The precisebank module account is the reserve and should not have fractional balances
 	moduleAddr := k.ak.GetModuleAddress(types.ModuleName)
 	if from.Equals(moduleAddr) || to.Equals(moduleAddr) {
 		// For transfers involving the precisebank module account, just do the integer transfer
-		integerAmt := amt.Quo(types.ConversionFactor())
+		integerAmt := amt.Quo(conversionFactor)
 		if integerAmt.IsPositive() {
-			transferCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerAmt)
+			transferCoin := sdk.NewCoin(ed.IntegerDenom, integerAmt)
 			if err := k.bk.SendCoins(ctx, from, to, sdk.NewCoins(transferCoin)); err != nil {
-				return k.updateInsufficientFundsError(ctx, from, amt, err)
+				return k.updateInsufficientFundsError(ctx, ed, from, amt, err)
 			}
 		}
 		return nil
@@ -161,17 +157,17 @@ func (k Keeper) sendExtendedCoins(
 	// sender does not have sufficient integer balance.
 
 	// Load required state: Account old balances
-	senderFracBal := k.GetFractionalBalance(ctx, from)
-	recipientFracBal := k.GetFractionalBalance(ctx, to)
+	senderFracBal := k.GetDenomFractionalBalance(ctx, ed, from)
+	recipientFracBal := k.GetDenomFractionalBalance(ctx, ed, to)
 
 	// -------------------------------------------------------------------------
 	// Pure stateless calculations
-	integerAmt := amt.Quo(types.ConversionFactor())
-	fractionalAmt := amt.Mod(types.ConversionFactor())
+	integerAmt := amt.Quo(conversionFactor)
+	fractionalAmt := amt.Mod(conversionFactor)
 
 	// Account new fractional balances
-	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(senderFracBal, fractionalAmt)
-	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(recipientFracBal, fractionalAmt)
+	senderNewFracBal, senderNeedsBorrow := subFromFractionalBalance(conversionFactor, senderFracBal, fractionalAmt)
+	recipientNewFracBal, recipientNeedsCarry := addToFractionalBalance(conversionFactor, recipientFracBal, fractionalAmt)
 
 	// Case #1: Sender borrow, recipient carry
 	if senderNeedsBorrow && recipientNeedsCarry {
@@ -186,9 +182,9 @@ func (k Keeper) sendExtendedCoins(
 	// Full integer amount transfer, including direct transfer of borrow/carry
 	// if any.
 	if integerAmt.IsPositive() {
-		transferCoin := sdk.NewCoin(types.IntegerCoinDenom(), integerAmt)
+		transferCoin := sdk.NewCoin(ed.IntegerDenom, integerAmt)
 		if err := k.bk.SendCoins(ctx, from, to, sdk.NewCoins(transferCoin)); err != nil {
-			return k.updateInsufficientFundsError(ctx, from, amt, err)
+			return k.updateInsufficientFundsError(ctx, ed, from, amt, err)
 		}
 	}
 
@@ -196,14 +192,14 @@ func (k Keeper) sendExtendedCoins(
 	// Sender borrows by transferring 1 integer amount to reserve to account for
 	// lack of fractional balance.
 	if senderNeedsBorrow && !recipientNeedsCarry {
-		borrowCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))
+		borrowCoin := sdk.NewCoin(ed.IntegerDenom, sdkmath.NewInt(1))
 		if err := k.bk.SendCoinsFromAccountToModule(
 			ctx,
 			from, // sender borrowing
 			types.ModuleName,
 			sdk.NewCoins(borrowCoin),
 		); err != nil {
-			return k.updateInsufficientFundsError(ctx, from, amt, err)
+			return k.updateInsufficientFundsError(ctx, ed, from, amt, err)
 		}
 	}
 
@@ -219,7 +215,7 @@ func (k Keeper) sendExtendedCoins(
 		// a SendCoins operation. Only SendCoinsFromModuleToAccount should check
 		// blocked addrs which is done by the parent SendCoinsFromModuleToAccount
 		// method.
-		carryCoin := sdk.NewCoin(types.IntegerCoinDenom(), sdkmath.NewInt(1))
+		carryCoin := sdk.NewCoin(ed.IntegerDenom, sdkmath.NewInt(1))
 		if err := k.bk.SendCoins(
 			ctx,
 			reserveAddr,
@@ -239,8 +235,8 @@ func (k Keeper) sendExtendedCoins(
 	// already calculated and just need to be set.
 
 	// Persist new fractional balances to store.
-	k.SetFractionalBalance(ctx, from, senderNewFracBal)
-	k.SetFractionalBalance(ctx, to, recipientNewFracBal)
+	k.SetDenomFractionalBalance(ctx, ed, from, senderNewFracBal)
+	k.SetDenomFractionalBalance(ctx, ed, to, recipientNewFracBal)
 
 	// Emit event for fractional balance change
 	types.EmitEventFractionalBalanceChange(ctx, from, senderFracBal, senderNewFracBal)
@@ -253,15 +249,16 @@ func (k Keeper) sendExtendedCoins(
 // current fractional balance, returning the new fractional balance and true if
 // an integer borrow is required.
 func subFromFractionalBalance(
+	conversionFactor sdkmath.Int,
 	currentFractionalBalance sdkmath.Int,
 	amountToSub sdkmath.Int,
 ) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToSub.GTE(types.ConversionFactor()) {
+	if amountToSub.GTE(conversionFactor) {
 		panic("amountToSub must be less than ConversionFactor")
 	}
 
@@ -274,7 +271,7 @@ func subFromFractionalBalance(
 		// Borrowing 1 integer equivalent amount of fractional coins. We need to
 		// add 1 integer equivalent amount to the fractional balance otherwise
 		// the new fractional balance will be negative.
-		newFractionalBalance = newFractionalBalance.Add(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Add(conversionFactor)
 	}
 
 	return newFractionalBalance, borrowRequired
@@ -283,13 +280,13 @@ func subFromFractionalBalance(
 // addToFractionalBalance adds a fractional amount to the provided current
 // fractional balance, returning the new fractional balance and true if a carry
 // is required.
-func addToFractionalBalance(currentFractionalBalance sdkmath.Int, amountToAdd sdkmath.Int) (sdkmath.Int, bool) {
+func addToFractionalBalance(conversionFactor, currentFractionalBalance, amountToAdd sdkmath.Int) (sdkmath.Int, bool) {
 	// Enforce that currentFractionalBalance is not a full balance.
-	if currentFractionalBalance.GTE(types.ConversionFactor()) {
+	if currentFractionalBalance.GTE(conversionFactor) {
 		panic("currentFractionalBalance must be less than ConversionFactor")
 	}
 
-	if amountToAdd.GTE(types.ConversionFactor()) {
+	if amountToAdd.GTE(conversionFactor) {
 		panic("amountToAdd must be less than ConversionFactor")
 	}
 
@@ -297,11 +294,11 @@ func addToFractionalBalance(currentFractionalBalance sdkmath.Int, amountToAdd sd
 
 	// New balance exceeds max fractional balance, so we need to carry it over
 	// to the integer balance.
-	carryRequired := newFractionalBalance.GTE(types.ConversionFactor())
+	carryRequired := newFractionalBalance.GTE(conversionFactor)
 
 	if carryRequired {
 		// Carry over to integer amount
-		newFractionalBalance = newFractionalBalance.Sub(types.ConversionFactor())
+		newFractionalBalance = newFractionalBalance.Sub(conversionFactor)
 	}
 
 	return newFractionalBalance, carryRequired
@@ -402,6 +399,7 @@ func (k Keeper) SendCoinsFromModuleToModule(
 // contains the full extended coin balance and send amounts.
 func (k Keeper) updateInsufficientFundsError(
 	ctx sdk.Context,
+	ed types.ExtendedDenom,
 	addr sdk.AccAddress,
 	amt sdkmath.Int,
 	err error,
@@ -411,8 +409,8 @@ func (k Keeper) updateInsufficientFundsError(
 	}
 
 	// Check balance is sufficient
-	bal := k.SpendableCoin(ctx, addr, types.ExtendedCoinDenom())
-	coin := sdk.NewCoin(types.ExtendedCoinDenom(), amt)
+	bal := k.SpendableCoin(ctx, addr, ed.ExtendedDenom)
+	coin := sdk.NewCoin(ed.ExtendedDenom, amt)
 
 	// TODO: This checks spendable coins and returns error with spendable
 	// coins, not full balance. If GetBalance() is modified to return the
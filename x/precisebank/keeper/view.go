@@ -11,8 +11,8 @@ import (
 )
 
 // GetBalance returns the balance of a specific denom for an address. This will
-// return the extended balance for the ExtendedCoinDenom, and the regular
-// balance for all other denoms.
+// return the extended balance for the extended denoms, and the regular balance
+// for all other denoms.
 func (k Keeper) GetBalance(
 	goCtx context.Context,
 	addr sdk.AccAddress,
@@ -20,32 +20,33 @@ func (k Keeper) GetBalance(
 ) sdk.Coin {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Pass through to x/bank for denoms except the extended denoms
+	ed, found := k.extendedDenom(ctx, denom)
+	if !found {
+		return k.bk.GetBalance(ctx, addr, denom)
+	}
+
 	// Module balance should display as empty for extended denom. Module
 	// balances are **only** for the reserve which backs the fractional
 	// balances. Returning the backing balances if querying extended denom would
 	// result in a double counting of the fractional balances.
-	if denom == types.ExtendedCoinDenom() && addr.Equals(k.ak.GetModuleAddress(types.ModuleName)) {
+	if addr.Equals(k.ak.GetModuleAddress(types.ModuleName)) {
 		return sdk.NewCoin(denom, sdkmath.ZeroInt())
 	}
 
-	// Pass through to x/bank for denoms except ExtendedCoinDenom
-	if denom != types.ExtendedCoinDenom() {
-		return k.bk.GetBalance(ctx, addr, denom)
-	}
-
 	// x/bank for integer balance - full balance, including locked
-	integerCoins := k.bk.GetBalance(ctx, addr, types.IntegerCoinDenom())
+	integerCoins := k.bk.GetBalance(ctx, addr, ed.IntegerDenom)
 
 	// x/precisebank for fractional balance
-	fractionalAmount := k.GetFractionalBalance(ctx, addr)
+	fractionalAmount := k.GetDenomFractionalBalance(ctx, ed, addr)
 
 	// (Integer * ConversionFactor) + Fractional
 	fullAmount := integerCoins.
 		Amount.
-		Mul(types.ConversionFactor()).
+		Mul(ed.ConversionFactor()).
 		Add(fractionalAmount)
 
-	return sdk.NewCoin(types.ExtendedCoinDenom(), fullAmount)
+	return sdk.NewCoin(ed.ExtendedDenom, fullAmount)
 }
 
 func (k Keeper) IterateAccountBalances(ctx context.Context, account sdk.AccAddress, cb func(coin sdk.Coin) bool) {
@@ -62,26 +63,27 @@ func (k Keeper) SpendableCoin(
 ) sdk.Coin {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Same as GetBalance, extended denom balances are transparent to consumers.
-	if denom == types.ExtendedCoinDenom() && addr.Equals(k.ak.GetModuleAddress(types.ModuleName)) {
-		return sdk.NewCoin(denom, sdkmath.ZeroInt())
+	// Pass through to x/bank for denoms except the extended denoms
+	ed, found := k.extendedDenom(ctx, denom)
+	if !found {
+		return k.bk.SpendableCoin(ctx, addr, denom)
 	}
 
-	// Pass through to x/bank for denoms except ExtendedCoinDenom
-	if denom != types.ExtendedCoinDenom() {
-		return k.bk.SpendableCoin(ctx, addr, denom)
+	// Same as GetBalance, extended denom balances are transparent to consumers.
+	if addr.Equals(k.ak.GetModuleAddress(types.ModuleName)) {
+		return sdk.NewCoin(denom, sdkmath.ZeroInt())
 	}
 
 	// x/bank for integer balance - excluding locked
-	integerCoin := k.bk.SpendableCoin(ctx, addr, types.IntegerCoinDenom())
+	integerCoin := k.bk.SpendableCoin(ctx, addr, ed.IntegerDenom)
 
 	// x/precisebank for fractional balance
-	fractionalAmount := k.GetFractionalBalance(ctx, addr)
+	fractionalAmount := k.GetDenomFractionalBalance(ctx, ed, addr)
 
 	// Spendable = (Integer * ConversionFactor) + Fractional
 	fullAmount := integerCoin.Amount.
-		Mul(types.ConversionFactor()).
+		Mul(ed.ConversionFactor()).
 		Add(fractionalAmount)
 
-	return sdk.NewCoin(types.ExtendedCoinDenom(), fullAmount)
+	return sdk.NewCoin(ed.ExtendedDenom, fullAmount)
 }
@@ -29,9 +29,11 @@ var (
 	_ module.AppModule      = AppModule{} //nolint:staticcheck // keep for legacy purposes
 	_ module.AppModuleBasic = AppModuleBasic{}
 	_ module.HasABCIGenesis = AppModule{}
+	_ module.HasInvariants  = AppModule{}
 
-	_ appmodule.AppModule   = AppModule{}
-	_ module.HasABCIGenesis = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+	_ module.HasABCIGenesis     = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -133,9 +135,21 @@ func (am AppModule) Name() string {
 // RegisterServices registers a GRPC query service to respond to the
 // module-specific GRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
 }
 
+// RegisterInvariants registers precisebank module's invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) { //nolint:staticcheck // InvariantRegistry is deprecated along with x/crisis
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
+// BeginBlock reloads the cached extended denoms of the precisebank module.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	am.keeper.BeginBlock(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // InitGenesis performs precisebank module's genesis initialization It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
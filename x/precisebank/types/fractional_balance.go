@@ -50,18 +50,31 @@ func NewFractionalBalance(address string, amount sdkmath.Int) FractionalBalance
 // Validate returns an error if the FractionalBalance has an invalid address or
 // negative amount.
 func (fb FractionalBalance) Validate() error {
+	return fb.validate(ConversionFactor())
+}
+
+// validate returns an error if the FractionalBalance has an invalid address or
+// an amount that is not a valid fractional amount for the given conversion
+// factor.
+func (fb FractionalBalance) validate(conversionFactor sdkmath.Int) error {
 	if _, err := sdk.AccAddressFromBech32(fb.Address); err != nil {
 		return err
 	}
 
 	// Validate the amount with the FractionalAmount wrapper
-	return ValidateFractionalAmount(fb.Amount)
+	return validateFractionalAmount(fb.Amount, conversionFactor)
 }
 
 // ValidateFractionalAmount checks if an sdkmath.Int is a valid fractional
 // amount, ensuring it is positive and less than or equal to the maximum
 // fractional amount.
 func ValidateFractionalAmount(amt sdkmath.Int) error {
+	return validateFractionalAmount(amt, ConversionFactor())
+}
+
+// validateFractionalAmount checks if an sdkmath.Int is a valid fractional
+// amount for the given conversion factor.
+func validateFractionalAmount(amt sdkmath.Int, conversionFactor sdkmath.Int) error {
 	if amt.IsNil() {
 		return fmt.Errorf("nil amount")
 	}
@@ -70,8 +83,8 @@ func ValidateFractionalAmount(amt sdkmath.Int) error {
 		return fmt.Errorf("non-positive amount %v", amt)
 	}
 
-	if amt.GTE(ConversionFactor()) {
-		return fmt.Errorf("amount %v exceeds max of %v", amt, ConversionFactor().SubRaw(1))
+	if amt.GTE(conversionFactor) {
+		return fmt.Errorf("amount %v exceeds max of %v", amt, conversionFactor.SubRaw(1))
 	}
 
 	return nil
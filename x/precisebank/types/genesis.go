@@ -14,6 +14,7 @@ func NewGenesisState(
 	return &GenesisState{
 		Balances:  balances,
 		Remainder: remainder,
+		Params:    DefaultParams(),
 	}
 }
 
@@ -25,37 +26,72 @@ func DefaultGenesisState() *GenesisState {
 // Validate performs basic validation of genesis data returning an  error for
 // any failed validation criteria.
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	if err := validateFractionalState(gs.Balances, gs.Remainder, ConversionFactor()); err != nil {
+		return err
+	}
+
+	seenDenoms := make(map[string]struct{})
+	for _, ds := range gs.DenomStates {
+		ed, found := gs.Params.FindExtendedDenom(ds.ExtendedDenom)
+		if !found {
+			return fmt.Errorf("denom state for %s is not registered in params", ds.ExtendedDenom)
+		}
+
+		if _, found := seenDenoms[ds.ExtendedDenom]; found {
+			return fmt.Errorf("duplicate denom state for %s", ds.ExtendedDenom)
+		}
+		seenDenoms[ds.ExtendedDenom] = struct{}{}
+
+		if err := validateFractionalState(ds.Balances, ds.Remainder, ed.ConversionFactor()); err != nil {
+			return fmt.Errorf("invalid denom state for %s: %w", ds.ExtendedDenom, err)
+		}
+	}
+
+	return nil
+}
+
+// validateFractionalState checks that the fractional balances and remainder of
+// an extended denom are valid and fully backed by whole integer amounts.
+func validateFractionalState(
+	balances FractionalBalances,
+	remainder sdkmath.Int,
+	conversionFactor sdkmath.Int,
+) error {
 	// Validate all FractionalBalances
-	if err := gs.Balances.Validate(); err != nil {
+	if err := balances.validate(conversionFactor); err != nil {
 		return fmt.Errorf("invalid balances: %w", err)
 	}
 
-	if gs.Remainder.IsNil() {
+	if remainder.IsNil() {
 		return fmt.Errorf("nil remainder amount")
 	}
 
 	// Validate remainder, 0 <= remainder <= maxFractionalAmount
-	if gs.Remainder.IsNegative() {
-		return fmt.Errorf("negative remainder amount %s", gs.Remainder)
+	if remainder.IsNegative() {
+		return fmt.Errorf("negative remainder amount %s", remainder)
 	}
 
-	if gs.Remainder.GTE(ConversionFactor()) {
-		return fmt.Errorf("remainder %v exceeds max of %v", gs.Remainder, ConversionFactor().SubRaw(1))
+	if remainder.GTE(conversionFactor) {
+		return fmt.Errorf("remainder %v exceeds max of %v", remainder, conversionFactor.SubRaw(1))
 	}
 
 	// Determine if sum(fractionalBalances) + remainder = whole integer value
 	// i.e total of all fractional balances + remainder == 0 fractional digits
-	sum := gs.Balances.SumAmount()
-	sumWithRemainder := sum.Add(gs.Remainder)
+	sum := balances.SumAmount()
+	sumWithRemainder := sum.Add(remainder)
 
-	offBy := sumWithRemainder.Mod(ConversionFactor())
+	offBy := sumWithRemainder.Mod(conversionFactor)
 
 	if !offBy.IsZero() {
 		return fmt.Errorf(
 			"sum of fractional balances %v + remainder %v is not a multiple of %v",
 			sum,
-			gs.Remainder,
-			ConversionFactor(),
+			remainder,
+			conversionFactor,
 		)
 	}
 
@@ -68,3 +104,22 @@ func (gs *GenesisState) Validate() error {
 func (gs *GenesisState) TotalAmountWithRemainder() sdkmath.Int {
 	return gs.Balances.SumAmount().Add(gs.Remainder)
 }
+
+// NewDenomState creates a new DenomState for the given extended denom.
+func NewDenomState(
+	extendedDenom string,
+	balances FractionalBalances,
+	remainder sdkmath.Int,
+) DenomState {
+	return DenomState{
+		ExtendedDenom: extendedDenom,
+		Balances:      balances,
+		Remainder:     remainder,
+	}
+}
+
+// TotalAmountWithRemainder returns the total amount of all fractional balances
+// of the denom state, including the remainder.
+func (ds DenomState) TotalAmountWithRemainder() sdkmath.Int {
+	return ds.Balances.SumAmount().Add(ds.Remainder)
+}
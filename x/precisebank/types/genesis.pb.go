@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -32,6 +33,11 @@ type GenesisState struct {
 	// remainder is an internal value of how much extra fractional digits are
 	// still backed by the reserve, but not assigned to any account.
 	Remainder cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remainder,proto3,customtype=cosmossdk.io/math.Int" json:"remainder"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// denom_states contains the fractional balances and remainder of each of
+	// the extended denoms registered in the params.
+	DenomStates []DenomState `protobuf:"bytes,4,rep,name=denom_states,json=denomStates,proto3" json:"denom_states"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,13 +80,200 @@ func (m *GenesisState) GetBalances() FractionalBalances {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDenomStates() []DenomState {
+	if m != nil {
+		return m.DenomStates
+	}
+	return nil
+}
+
+// Params defines the precisebank module parameters.
+type Params struct {
+	// extended_denoms is the list of bank denoms, other than the EVM denom, that
+	// are extended to 18 decimals.
+	ExtendedDenoms []ExtendedDenom `protobuf:"bytes,1,rep,name=extended_denoms,json=extendedDenoms,proto3" json:"extended_denoms"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59d417e91f4d8e9, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetExtendedDenoms() []ExtendedDenom {
+	if m != nil {
+		return m.ExtendedDenoms
+	}
+	return nil
+}
+
+// ExtendedDenom defines a bank denom whose balances are extended to 18
+// decimals by tracking the fractional balances in x/precisebank.
+type ExtendedDenom struct {
+	// integer_denom is the denom managed by x/bank, e.g. uatom.
+	IntegerDenom string `protobuf:"bytes,1,opt,name=integer_denom,json=integerDenom,proto3" json:"integer_denom,omitempty"`
+	// extended_denom is the denom of the 18 decimals representation of the
+	// integer denom, e.g. aatom.
+	ExtendedDenom string `protobuf:"bytes,2,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
+	// decimals is the number of decimals of the integer denom, used to derive
+	// the conversion factor to 18 decimals.
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *ExtendedDenom) Reset()         { *m = ExtendedDenom{} }
+func (m *ExtendedDenom) String() string { return proto.CompactTextString(m) }
+func (*ExtendedDenom) ProtoMessage()    {}
+func (*ExtendedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59d417e91f4d8e9, []int{2}
+}
+func (m *ExtendedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedDenom.Merge(m, src)
+}
+func (m *ExtendedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedDenom proto.InternalMessageInfo
+
+func (m *ExtendedDenom) GetIntegerDenom() string {
+	if m != nil {
+		return m.IntegerDenom
+	}
+	return ""
+}
+
+func (m *ExtendedDenom) GetExtendedDenom() string {
+	if m != nil {
+		return m.ExtendedDenom
+	}
+	return ""
+}
+
+func (m *ExtendedDenom) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// DenomState defines the fractional balances and remainder of an extended
+// denom other than the EVM denom.
+type DenomState struct {
+	// extended_denom is the extended denom the balances are tracked for.
+	ExtendedDenom string `protobuf:"bytes,1,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
+	// balances is a list of all the fractional balances of the extended denom.
+	Balances FractionalBalances `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=FractionalBalances" json:"balances"`
+	// remainder is the amount of fractional digits of the extended denom that
+	// are backed by the reserve, but not assigned to any account.
+	Remainder cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remainder,proto3,customtype=cosmossdk.io/math.Int" json:"remainder"`
+}
+
+func (m *DenomState) Reset()         { *m = DenomState{} }
+func (m *DenomState) String() string { return proto.CompactTextString(m) }
+func (*DenomState) ProtoMessage()    {}
+func (*DenomState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59d417e91f4d8e9, []int{3}
+}
+func (m *DenomState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomState.Merge(m, src)
+}
+func (m *DenomState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomState proto.InternalMessageInfo
+
+func (m *DenomState) GetExtendedDenom() string {
+	if m != nil {
+		return m.ExtendedDenom
+	}
+	return ""
+}
+
+func (m *DenomState) GetBalances() FractionalBalances {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
 // FractionalBalance defines the fractional portion of an account balance
 type FractionalBalance struct {
 	// address is the address of the balance holder.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// amount indicates amount of only the fractional balance owned by the
-	// address. FractionalBalance currently only supports tracking 1 single asset,
-	// e.g. fractional balances of uatom.
+	// address. The asset is determined by the list the balance belongs to, e.g.
+	// fractional balances of uatom.
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
@@ -88,7 +281,7 @@ func (m *FractionalBalance) Reset()         { *m = FractionalBalance{} }
 func (m *FractionalBalance) String() string { return proto.CompactTextString(m) }
 func (*FractionalBalance) ProtoMessage()    {}
 func (*FractionalBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e59d417e91f4d8e9, []int{1}
+	return fileDescriptor_e59d417e91f4d8e9, []int{4}
 }
 func (m *FractionalBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,6 +312,9 @@ var xxx_messageInfo_FractionalBalance proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evm.precisebank.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "cosmos.evm.precisebank.v1.Params")
+	proto.RegisterType((*ExtendedDenom)(nil), "cosmos.evm.precisebank.v1.ExtendedDenom")
+	proto.RegisterType((*DenomState)(nil), "cosmos.evm.precisebank.v1.DenomState")
 	proto.RegisterType((*FractionalBalance)(nil), "cosmos.evm.precisebank.v1.FractionalBalance")
 }
 
@@ -127,29 +323,41 @@ func init() {
 }
 
 var fileDescriptor_e59d417e91f4d8e9 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0x3f, 0x4f, 0x32, 0x31,
-	0x18, 0xbf, 0x7b, 0xdf, 0x84, 0x17, 0xfa, 0xba, 0x78, 0xc1, 0x04, 0x19, 0xee, 0x08, 0x8b, 0x18,
-	0xa5, 0x0d, 0xb8, 0xb9, 0x79, 0x24, 0x1a, 0x56, 0xd8, 0x5c, 0x4c, 0xef, 0xae, 0x39, 0x1a, 0x68,
-	0x4b, 0xda, 0x72, 0xd1, 0x6f, 0xe0, 0xe8, 0xe4, 0xcc, 0xec, 0xcc, 0xee, 0xca, 0x48, 0x98, 0x8c,
-	0x03, 0x1a, 0x58, 0xfc, 0x18, 0xe6, 0xe8, 0xa9, 0x18, 0xe2, 0xe2, 0xd6, 0x3e, 0xbf, 0x7f, 0xcf,
-	0x93, 0x1f, 0x38, 0x08, 0x85, 0x62, 0x42, 0x21, 0x92, 0x30, 0x34, 0x94, 0x24, 0xa4, 0x8a, 0x04,
-	0x98, 0xf7, 0x51, 0xd2, 0x40, 0x31, 0xe1, 0x44, 0x51, 0x05, 0x87, 0x52, 0x68, 0xe1, 0xec, 0x1b,
-	0x22, 0x24, 0x09, 0x83, 0x1b, 0x44, 0x98, 0x34, 0xca, 0x19, 0x74, 0xb5, 0x26, 0xa2, 0x8c, 0xb7,
-	0xfe, 0x94, 0x8b, 0xb1, 0x88, 0x85, 0x99, 0xa7, 0x2f, 0x33, 0xad, 0x3e, 0xda, 0x60, 0xe7, 0xc2,
-	0xb8, 0x77, 0x35, 0xd6, 0xc4, 0x89, 0x40, 0x3e, 0xc0, 0x03, 0xcc, 0x43, 0xa2, 0x4a, 0x76, 0xe5,
-	0x6f, 0xed, 0x7f, 0xf3, 0x18, 0xfe, 0x98, 0x07, 0xcf, 0x25, 0x0e, 0x35, 0x15, 0x1c, 0x0f, 0x7c,
-	0x23, 0xf2, 0xcb, 0xd3, 0x85, 0x67, 0x3d, 0xbc, 0x78, 0xce, 0x16, 0xa4, 0x3a, 0x9f, 0xce, 0x4e,
-	0x1b, 0x14, 0x24, 0x61, 0x98, 0xf2, 0x88, 0xc8, 0xd2, 0x9f, 0x8a, 0x5d, 0x2b, 0xf8, 0x47, 0xa9,
-	0xf0, 0x79, 0xe1, 0xed, 0x99, 0x34, 0x15, 0xf5, 0x21, 0x15, 0x88, 0x61, 0xdd, 0x83, 0x6d, 0xae,
-	0xe7, 0x93, 0x3a, 0xc8, 0xd6, 0x68, 0x73, 0xdd, 0xf9, 0x52, 0x57, 0xef, 0x6d, 0xb0, 0xbb, 0x95,
-	0xe5, 0x34, 0xc1, 0x3f, 0x1c, 0x45, 0x92, 0xa8, 0xf4, 0x8a, 0xd4, 0xbe, 0x34, 0x9f, 0xd4, 0x8b,
-	0x99, 0xc3, 0x99, 0x41, 0xba, 0x5a, 0x52, 0x1e, 0x77, 0x3e, 0x88, 0x4e, 0x0b, 0xe4, 0x30, 0x13,
-	0x23, 0xae, 0x7f, 0xb3, 0x51, 0x26, 0x3d, 0xcd, 0xdf, 0x8e, 0x3d, 0xeb, 0x6d, 0xec, 0x59, 0x7e,
-	0x6b, 0xba, 0x74, 0xed, 0xd9, 0xd2, 0xb5, 0x5f, 0x97, 0xae, 0x7d, 0xb7, 0x72, 0xad, 0xd9, 0xca,
-	0xb5, 0x9e, 0x56, 0xae, 0x75, 0x79, 0x18, 0x53, 0xdd, 0x1b, 0x05, 0x30, 0x14, 0x0c, 0x6d, 0x94,
-	0x7e, 0xfd, 0xad, 0x76, 0x7d, 0x33, 0x24, 0x2a, 0xc8, 0xad, 0x6b, 0x3a, 0x79, 0x0f, 0x00, 0x00,
-	0xff, 0xff, 0x0c, 0x88, 0x85, 0xa7, 0x1d, 0x02, 0x00, 0x00,
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6b, 0x13, 0x41,
+	0x14, 0xdf, 0x49, 0x4a, 0x4c, 0x26, 0x49, 0xa5, 0x43, 0x85, 0x35, 0x87, 0x4d, 0x8c, 0x14, 0xe3,
+	0x9f, 0xee, 0xd2, 0x78, 0xf3, 0xe6, 0xb6, 0x2a, 0xb9, 0x88, 0x6c, 0x6f, 0x22, 0x84, 0xc9, 0xee,
+	0x73, 0xbb, 0x34, 0x33, 0x13, 0x76, 0xa6, 0xb1, 0x7e, 0x03, 0x8f, 0x9e, 0x3c, 0xf7, 0x28, 0x9e,
+	0x3c, 0xf4, 0x43, 0xf4, 0x58, 0x0a, 0x82, 0x78, 0xa8, 0x92, 0x08, 0xfa, 0x31, 0x64, 0x77, 0xb6,
+	0xe9, 0x86, 0xd6, 0x1c, 0x14, 0x7a, 0x09, 0x99, 0xf7, 0x7e, 0x7f, 0xde, 0xfc, 0xf6, 0x31, 0xf8,
+	0x8e, 0x2f, 0x24, 0x13, 0xd2, 0x81, 0x31, 0x73, 0x46, 0x31, 0xf8, 0x91, 0x84, 0x01, 0xe5, 0xbb,
+	0xce, 0x78, 0xc3, 0x09, 0x81, 0x83, 0x8c, 0xa4, 0x3d, 0x8a, 0x85, 0x12, 0xe4, 0xa6, 0x06, 0xda,
+	0x30, 0x66, 0x76, 0x0e, 0x68, 0x8f, 0x37, 0x1a, 0x2b, 0x94, 0x45, 0x5c, 0x38, 0xe9, 0xaf, 0x46,
+	0x37, 0x32, 0x74, 0x3f, 0x3d, 0x39, 0x19, 0x55, 0xb7, 0x56, 0x43, 0x11, 0x0a, 0x5d, 0x4f, 0xfe,
+	0xe9, 0x6a, 0xfb, 0x4b, 0x01, 0xd7, 0x9e, 0x69, 0xc3, 0x6d, 0x45, 0x15, 0x90, 0x00, 0x97, 0x07,
+	0x74, 0x48, 0xb9, 0x0f, 0xd2, 0x44, 0xad, 0x62, 0xa7, 0xda, 0x7d, 0x60, 0xff, 0x75, 0x04, 0xfb,
+	0x69, 0x4c, 0x7d, 0x15, 0x09, 0x4e, 0x87, 0xae, 0x26, 0xb9, 0x8d, 0xa3, 0xd3, 0xa6, 0xf1, 0xe9,
+	0x7b, 0x93, 0x5c, 0x68, 0x49, 0x6f, 0xa6, 0x4c, 0x7a, 0xb8, 0x12, 0x03, 0xa3, 0x11, 0x0f, 0x20,
+	0x36, 0x0b, 0x2d, 0xd4, 0xa9, 0xb8, 0xf7, 0x13, 0xe2, 0xb7, 0xd3, 0xe6, 0x0d, 0xed, 0x26, 0x83,
+	0x5d, 0x3b, 0x12, 0x0e, 0xa3, 0x6a, 0xc7, 0xee, 0x71, 0x75, 0x72, 0xb8, 0x8e, 0xb3, 0x31, 0x7a,
+	0x5c, 0x79, 0xe7, 0x6c, 0xb2, 0x85, 0x4b, 0x23, 0x1a, 0x53, 0x26, 0xcd, 0x62, 0x0b, 0x75, 0xaa,
+	0xdd, 0x5b, 0x0b, 0xc6, 0x7d, 0x91, 0x02, 0xdd, 0x4a, 0x62, 0xf5, 0xf1, 0xd7, 0xe7, 0x7b, 0xc8,
+	0xcb, 0xb8, 0xe4, 0x39, 0xae, 0x05, 0xc0, 0x05, 0xeb, 0xcb, 0x24, 0x05, 0x69, 0x2e, 0xa5, 0x57,
+	0x5f, 0x5b, 0xa0, 0xb5, 0x95, 0xc0, 0xd3, 0xcc, 0xdc, 0xa5, 0x44, 0xcf, 0xab, 0x06, 0xb3, 0x8a,
+	0x6c, 0xbf, 0xc6, 0x25, 0x6d, 0x46, 0x5e, 0xe1, 0xeb, 0xb0, 0xaf, 0x80, 0x07, 0x10, 0xf4, 0x53,
+	0xc4, 0x59, 0xae, 0x9d, 0x05, 0xe2, 0x4f, 0x32, 0x46, 0x6a, 0x92, 0x9f, 0x77, 0x19, 0xf2, 0x1d,
+	0xd9, 0x7e, 0x83, 0xeb, 0x73, 0x58, 0x72, 0x1b, 0xd7, 0x23, 0xae, 0x20, 0x84, 0x58, 0xbb, 0x99,
+	0x28, 0x49, 0xd7, 0xab, 0x65, 0x45, 0x0d, 0x5a, 0xc3, 0xcb, 0xf3, 0x33, 0xe9, 0x6f, 0xe0, 0xd5,
+	0xe7, 0xd4, 0x49, 0x03, 0x97, 0x03, 0xf0, 0x23, 0x46, 0x87, 0x3a, 0xdc, 0xba, 0x37, 0x3b, 0xb7,
+	0x7f, 0x22, 0x8c, 0xcf, 0x23, 0xb8, 0x44, 0x11, 0x5d, 0xa6, 0x98, 0xdf, 0xae, 0xc2, 0xd5, 0x6c,
+	0x57, 0xf1, 0x7f, 0xb6, 0xab, 0xfd, 0x01, 0xe1, 0x95, 0x0b, 0x5e, 0xa4, 0x8b, 0xaf, 0xd1, 0x20,
+	0x88, 0x41, 0x4a, 0x7d, 0x4d, 0xd7, 0x3c, 0x39, 0x5c, 0x5f, 0xcd, 0x14, 0x1e, 0xeb, 0xce, 0xb6,
+	0x8a, 0x23, 0x1e, 0x7a, 0x67, 0x40, 0xb2, 0x89, 0x4b, 0x94, 0x89, 0x3d, 0xae, 0xfe, 0x65, 0xdf,
+	0x33, 0xea, 0xa3, 0xf2, 0xbb, 0x83, 0xa6, 0xf1, 0xfb, 0xa0, 0x69, 0xb8, 0x9b, 0x47, 0x13, 0x0b,
+	0x1d, 0x4f, 0x2c, 0xf4, 0x63, 0x62, 0xa1, 0xf7, 0x53, 0xcb, 0x38, 0x9e, 0x5a, 0xc6, 0xd7, 0xa9,
+	0x65, 0xbc, 0xbc, 0x1b, 0x46, 0x6a, 0x67, 0x6f, 0x60, 0xfb, 0x82, 0x39, 0xb9, 0x57, 0x66, 0x7f,
+	0xee, 0x9d, 0x51, 0x6f, 0x47, 0x20, 0x07, 0xa5, 0xf4, 0x11, 0x78, 0xf8, 0x27, 0x00, 0x00, 0xff,
+	0xff, 0x3b, 0x8a, 0x24, 0x83, 0x8e, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -172,6 +380,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomStates) > 0 {
+		for iNdEx := len(m.DenomStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.Remainder.Size()
 		i -= size
@@ -199,6 +431,139 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExtendedDenoms) > 0 {
+		for iNdEx := len(m.ExtendedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtendedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtendedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ExtendedDenom) > 0 {
+		i -= len(m.ExtendedDenom)
+		copy(dAtA[i:], m.ExtendedDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExtendedDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IntegerDenom) > 0 {
+		i -= len(m.IntegerDenom)
+		copy(dAtA[i:], m.IntegerDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.IntegerDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remainder.Size()
+		i -= size
+		if _, err := m.Remainder.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExtendedDenom) > 0 {
+		i -= len(m.ExtendedDenom)
+		copy(dAtA[i:], m.ExtendedDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExtendedDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FractionalBalance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -264,33 +629,97 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Remainder.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomStates) > 0 {
+		for _, e := range m.DenomStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *FractionalBalance) Size() (n int) {
+func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	if len(m.ExtendedDenoms) > 0 {
+		for _, e := range m.ExtendedDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExtendedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntegerDenom)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.ExtendedDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovGenesis(uint64(m.Decimals))
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *DenomState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExtendedDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Remainder.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FractionalBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -385,6 +814,440 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomStates = append(m.DenomStates, DenomState{})
+			if err := m.DenomStates[len(m.DenomStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedDenoms = append(m.ExtendedDenoms, ExtendedDenom{})
+			if err := m.ExtendedDenoms[len(m.ExtendedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtendedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegerDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtendedDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, FractionalBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,10 @@ package types
 import (
 	"context"
 
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
 	"cosmossdk.io/core/address"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -45,3 +49,11 @@ type BankKeeper interface {
 	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 }
+
+// Erc20Keeper defines the expected ERC20 keeper interface, used to check that
+// the decimals of a denom are not changed while its ERC20 token has allowances.
+type Erc20Keeper interface {
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	HasAllowances(ctx sdk.Context, erc20 common.Address) bool
+}
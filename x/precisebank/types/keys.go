@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName name that will be used throughout the module
@@ -14,15 +17,30 @@ const (
 
 // key prefixes for store
 var (
-	FractionalBalancePrefix = []byte{0x01} // address -> fractional balance
+	FractionalBalancePrefix      = []byte{0x01} // address -> fractional balance
+	DenomFractionalBalancePrefix = []byte{0x04} // denom + address -> fractional balance
+	DenomRemainderBalancePrefix  = []byte{0x05} // denom -> fractional balance remainder
 )
 
 // Keys for store that are not prefixed
 var (
 	RemainderBalanceKey = []byte{0x02} // fractional balance remainder
+	ParamsKey           = []byte{0x03} // module params
 )
 
 // FractionalBalanceKey returns a key from an address
 func FractionalBalanceKey(address sdk.AccAddress) []byte {
 	return address.Bytes()
 }
+
+// DenomFractionalBalancesPrefix returns the store prefix of the fractional
+// balances of an extended denom other than the EVM denom.
+func DenomFractionalBalancesPrefix(extendedDenom string) []byte {
+	return append(DenomFractionalBalancePrefix, address.MustLengthPrefix([]byte(extendedDenom))...)
+}
+
+// DenomRemainderBalanceKey returns the store key of the remainder of an
+// extended denom other than the EVM denom.
+func DenomRemainderBalanceKey(extendedDenom string) []byte {
+	return append(DenomRemainderBalancePrefix, []byte(extendedDenom)...)
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(extendedDenoms ...ExtendedDenom) Params {
+	return Params{
+		ExtendedDenoms: extendedDenoms,
+	}
+}
+
+// DefaultParams returns the default precisebank module parameters, which do
+// not extend any denom other than the EVM denom.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// Validate returns an error if any of the extended denoms is invalid or if the
+// same denom is registered more than once.
+func (p Params) Validate() error {
+	seenDenoms := make(map[string]struct{})
+
+	for _, ed := range p.ExtendedDenoms {
+		if err := ed.Validate(); err != nil {
+			return err
+		}
+
+		for _, denom := range []string{ed.IntegerDenom, ed.ExtendedDenom} {
+			if _, found := seenDenoms[denom]; found {
+				return fmt.Errorf("duplicate denom %s", denom)
+			}
+			seenDenoms[denom] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// FindExtendedDenom returns the extended denom entry for the given extended
+// denom.
+func (p Params) FindExtendedDenom(extendedDenom string) (ExtendedDenom, bool) {
+	for _, ed := range p.ExtendedDenoms {
+		if ed.ExtendedDenom == extendedDenom {
+			return ed, true
+		}
+	}
+	return ExtendedDenom{}, false
+}
+
+// FindIntegerDenom returns the extended denom entry for the given integer
+// denom.
+func (p Params) FindIntegerDenom(integerDenom string) (ExtendedDenom, bool) {
+	for _, ed := range p.ExtendedDenoms {
+		if ed.IntegerDenom == integerDenom {
+			return ed, true
+		}
+	}
+	return ExtendedDenom{}, false
+}
+
+// NewExtendedDenom creates a new ExtendedDenom instance.
+func NewExtendedDenom(integerDenom, extendedDenom string, decimals uint32) ExtendedDenom {
+	return ExtendedDenom{
+		IntegerDenom:  integerDenom,
+		ExtendedDenom: extendedDenom,
+		Decimals:      decimals,
+	}
+}
+
+// EVMExtendedDenom returns the ExtendedDenom of the EVM coin, which is always
+// extended by x/precisebank.
+func EVMExtendedDenom() ExtendedDenom {
+	return NewExtendedDenom(
+		IntegerCoinDenom(),
+		ExtendedCoinDenom(),
+		evmtypes.GetEVMCoinDecimals().Uint32(),
+	)
+}
+
+// Validate returns an error if the denoms are invalid or equal, or if the
+// decimals are not lower than 18.
+func (ed ExtendedDenom) Validate() error {
+	if err := sdk.ValidateDenom(ed.IntegerDenom); err != nil {
+		return fmt.Errorf("invalid integer denom: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(ed.ExtendedDenom); err != nil {
+		return fmt.Errorf("invalid extended denom: %w", err)
+	}
+
+	if ed.IntegerDenom == ed.ExtendedDenom {
+		return fmt.Errorf("extended denom %s must be different from the integer denom", ed.ExtendedDenom)
+	}
+
+	decimals := evmtypes.Decimals(ed.Decimals)
+	if err := decimals.Validate(); err != nil {
+		return fmt.Errorf("invalid decimals for %s: %w", ed.IntegerDenom, err)
+	}
+
+	if decimals == evmtypes.EighteenDecimals {
+		return fmt.Errorf("%s already has 18 decimals and does not need to be extended", ed.IntegerDenom)
+	}
+
+	return nil
+}
+
+// ConversionFactor returns the conversion factor between the integer denom and
+// the extended denom. This is also 1 greater than the max valid fractional
+// amount of the extended denom.
+func (ed ExtendedDenom) ConversionFactor() sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(evmtypes.Decimals(ed.Decimals).ConversionFactor().BigInt())
+}
+
+// ValidateFractionalAmount checks if an sdkmath.Int is a valid fractional
+// amount of the extended denom.
+func (ed ExtendedDenom) ValidateFractionalAmount(amt sdkmath.Int) error {
+	return validateFractionalAmount(amt, ed.ConversionFactor())
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/evm/x/precisebank/types"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  types.Params
+		wantErr string
+	}{
+		{
+			"valid - default params",
+			types.DefaultParams(),
+			"",
+		},
+		{
+			"valid - multiple extended denoms",
+			types.NewParams(
+				types.NewExtendedDenom("uusdc", "ausdc", 6),
+				types.NewExtendedDenom("sat", "asat", 8),
+			),
+			"",
+		},
+		{
+			"invalid - integer denom",
+			types.NewParams(types.NewExtendedDenom("1usdc", "ausdc", 6)),
+			"invalid integer denom",
+		},
+		{
+			"invalid - extended denom",
+			types.NewParams(types.NewExtendedDenom("uusdc", "", 6)),
+			"invalid extended denom",
+		},
+		{
+			"invalid - same integer and extended denom",
+			types.NewParams(types.NewExtendedDenom("uusdc", "uusdc", 6)),
+			"extended denom uusdc must be different from the integer denom",
+		},
+		{
+			"invalid - zero decimals",
+			types.NewParams(types.NewExtendedDenom("uusdc", "ausdc", 0)),
+			"invalid decimals for uusdc",
+		},
+		{
+			"invalid - 18 decimals",
+			types.NewParams(types.NewExtendedDenom("wei", "awei", 18)),
+			"wei already has 18 decimals and does not need to be extended",
+		},
+		{
+			"invalid - duplicate integer denom",
+			types.NewParams(
+				types.NewExtendedDenom("uusdc", "ausdc", 6),
+				types.NewExtendedDenom("uusdc", "ausdc2", 6),
+			),
+			"duplicate denom uusdc",
+		},
+		{
+			"invalid - extended denom reused as integer denom",
+			types.NewParams(
+				types.NewExtendedDenom("uusdc", "ausdc", 6),
+				types.NewExtendedDenom("ausdc", "aausdc", 6),
+			),
+			"duplicate denom ausdc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestExtendedDenom_ConversionFactor(t *testing.T) {
+	require.Equal(t, sdkmath.NewInt(1_000_000_000_000), types.NewExtendedDenom("uusdc", "ausdc", 6).ConversionFactor())
+	require.Equal(t, sdkmath.NewInt(10_000_000_000), types.NewExtendedDenom("sat", "asat", 8).ConversionFactor())
+}
+
+func TestParams_Find(t *testing.T) {
+	usdc := types.NewExtendedDenom("uusdc", "ausdc", 6)
+	params := types.NewParams(usdc)
+
+	ed, found := params.FindExtendedDenom("ausdc")
+	require.True(t, found)
+	require.Equal(t, usdc, ed)
+
+	ed, found = params.FindIntegerDenom("uusdc")
+	require.True(t, found)
+	require.Equal(t, usdc, ed)
+
+	_, found = params.FindExtendedDenom("uusdc")
+	require.False(t, found)
+
+	_, found = params.FindIntegerDenom("ausdc")
+	require.False(t, found)
+}
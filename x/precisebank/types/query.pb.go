@@ -30,15 +30,176 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QueryParamsRequest defines the request type for Query/Params method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{0}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for Query/Params method.
+type QueryParamsResponse struct {
+	// params define the precisebank module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{1}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+// QueryTotalFractionalBalancesRequest defines the request type for
+// Query/TotalFractionalBalances method.
+type QueryTotalFractionalBalancesRequest struct {
+	// denom is the extended denom to query the total for. Defaults to the
+	// extended EVM denom if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryTotalFractionalBalancesRequest) Reset()         { *m = QueryTotalFractionalBalancesRequest{} }
+func (m *QueryTotalFractionalBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFractionalBalancesRequest) ProtoMessage()    {}
+func (*QueryTotalFractionalBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{2}
+}
+func (m *QueryTotalFractionalBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalFractionalBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFractionalBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalFractionalBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFractionalBalancesRequest.Merge(m, src)
+}
+func (m *QueryTotalFractionalBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalFractionalBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFractionalBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalFractionalBalancesRequest proto.InternalMessageInfo
+
+// QueryTotalFractionalBalancesResponse defines the response type for
+// Query/TotalFractionalBalances method.
+type QueryTotalFractionalBalancesResponse struct {
+	// total is the total sum of all fractional balances managed by the precisebank
+	// module.
+	Total types.Coin `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+}
+
+func (m *QueryTotalFractionalBalancesResponse) Reset()         { *m = QueryTotalFractionalBalancesResponse{} }
+func (m *QueryTotalFractionalBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFractionalBalancesResponse) ProtoMessage()    {}
+func (*QueryTotalFractionalBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8c5456889057ce50, []int{3}
+}
+func (m *QueryTotalFractionalBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalFractionalBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFractionalBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalFractionalBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFractionalBalancesResponse.Merge(m, src)
+}
+func (m *QueryTotalFractionalBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalFractionalBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFractionalBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalFractionalBalancesResponse proto.InternalMessageInfo
+
 // QueryRemainderRequest defines the request type for Query/Remainder method.
 type QueryRemainderRequest struct {
+	// denom is the extended denom to query the remainder for. Defaults to the
+	// extended EVM denom if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryRemainderRequest) Reset()         { *m = QueryRemainderRequest{} }
 func (m *QueryRemainderRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRemainderRequest) ProtoMessage()    {}
 func (*QueryRemainderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{0}
+	return fileDescriptor_8c5456889057ce50, []int{4}
 }
 func (m *QueryRemainderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -78,7 +239,7 @@ func (m *QueryRemainderResponse) Reset()         { *m = QueryRemainderResponse{}
 func (m *QueryRemainderResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRemainderResponse) ProtoMessage()    {}
 func (*QueryRemainderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{1}
+	return fileDescriptor_8c5456889057ce50, []int{5}
 }
 func (m *QueryRemainderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -112,13 +273,16 @@ var xxx_messageInfo_QueryRemainderResponse proto.InternalMessageInfo
 type QueryFractionalBalanceRequest struct {
 	// address is the account address to query  fractional balance for.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the extended denom to query the fractional balance for. Defaults
+	// to the extended EVM denom if empty.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryFractionalBalanceRequest) Reset()         { *m = QueryFractionalBalanceRequest{} }
 func (m *QueryFractionalBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFractionalBalanceRequest) ProtoMessage()    {}
 func (*QueryFractionalBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{2}
+	return fileDescriptor_8c5456889057ce50, []int{6}
 }
 func (m *QueryFractionalBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,7 +322,7 @@ func (m *QueryFractionalBalanceResponse) Reset()         { *m = QueryFractionalB
 func (m *QueryFractionalBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFractionalBalanceResponse) ProtoMessage()    {}
 func (*QueryFractionalBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8c5456889057ce50, []int{3}
+	return fileDescriptor_8c5456889057ce50, []int{7}
 }
 func (m *QueryFractionalBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,6 +352,10 @@ func (m *QueryFractionalBalanceResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryFractionalBalanceResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.precisebank.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evm.precisebank.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTotalFractionalBalancesRequest)(nil), "cosmos.evm.precisebank.v1.QueryTotalFractionalBalancesRequest")
+	proto.RegisterType((*QueryTotalFractionalBalancesResponse)(nil), "cosmos.evm.precisebank.v1.QueryTotalFractionalBalancesResponse")
 	proto.RegisterType((*QueryRemainderRequest)(nil), "cosmos.evm.precisebank.v1.QueryRemainderRequest")
 	proto.RegisterType((*QueryRemainderResponse)(nil), "cosmos.evm.precisebank.v1.QueryRemainderResponse")
 	proto.RegisterType((*QueryFractionalBalanceRequest)(nil), "cosmos.evm.precisebank.v1.QueryFractionalBalanceRequest")
@@ -199,34 +367,43 @@ func init() {
 }
 
 var fileDescriptor_8c5456889057ce50 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0xd5, 0x2f, 0x28, 0x4a, 0x4d, 0xce, 0x2c, 0x4e, 0x4d, 0x4a,
-	0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x84, 0x28, 0xd3, 0x4b, 0x2d, 0xcb, 0xd5, 0x43, 0x52, 0xa6, 0x57, 0x66, 0x28,
-	0x25, 0x07, 0x35, 0x21, 0x29, 0xb1, 0x38, 0x55, 0xbf, 0xcc, 0x30, 0x29, 0xb5, 0x24, 0xd1, 0x50,
-	0x3f, 0x39, 0x3f, 0x33, 0x0f, 0xa2, 0x55, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07,
-	0xb1, 0xa0, 0xa2, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x10, 0x59, 0x25, 0x71, 0x2e, 0xd1,
-	0x40, 0x90, 0xed, 0x41, 0xa9, 0xb9, 0x89, 0x99, 0x79, 0x29, 0xa9, 0x45, 0x41, 0xa9, 0x85, 0xa5,
-	0xa9, 0xc5, 0x25, 0x4a, 0xe1, 0x5c, 0x62, 0xe8, 0x12, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42,
-	0xb6, 0x5c, 0x9c, 0x45, 0x30, 0x41, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x49, 0x3d, 0xa8,
-	0xab, 0x41, 0x4e, 0xd3, 0x83, 0x3a, 0x4d, 0xcf, 0x39, 0x3f, 0x33, 0xcf, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0x84, 0x0e, 0x25, 0x4b, 0x2e, 0x59, 0xb0, 0xc1, 0x6e, 0x45, 0x89, 0xc9, 0x20,
-	0x97, 0x24, 0xe6, 0x38, 0x25, 0xe6, 0x24, 0xe6, 0x25, 0xa7, 0x42, 0x6d, 0x16, 0x92, 0xe0, 0x62,
-	0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x06, 0x9b, 0xce, 0x19, 0x04, 0xe3, 0x2a, 0x15, 0x70,
-	0xc9, 0xe1, 0xd2, 0x0a, 0x75, 0x9b, 0x1f, 0x97, 0x50, 0x1a, 0x5c, 0x32, 0x3e, 0x09, 0x22, 0x4b,
-	0xac, 0x23, 0x05, 0xd3, 0xd0, 0xcd, 0x35, 0xfa, 0xc2, 0xc4, 0xc5, 0x0a, 0xb6, 0x52, 0x68, 0x1e,
-	0x23, 0x17, 0x27, 0x3c, 0x2c, 0x84, 0x0c, 0xf4, 0x70, 0x46, 0x93, 0x1e, 0xd6, 0xf0, 0x94, 0x32,
-	0x24, 0x41, 0x07, 0xc4, 0x33, 0x4a, 0x3a, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x52, 0x13, 0x52, 0xd1,
-	0xc7, 0x9d, 0x74, 0xe0, 0xe1, 0x2a, 0x74, 0x92, 0x91, 0x4b, 0x10, 0x23, 0x60, 0x84, 0x2c, 0x08,
-	0x59, 0x8b, 0x2b, 0x1a, 0xa4, 0x2c, 0xc9, 0xd0, 0x09, 0x75, 0xb8, 0x3d, 0xd8, 0xe1, 0x96, 0x42,
-	0xe6, 0x78, 0x1c, 0x8e, 0x19, 0x4d, 0xfa, 0xd5, 0xd0, 0x78, 0xae, 0x75, 0x72, 0x3f, 0xf1, 0x50,
-	0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0,
-	0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xd3, 0x33, 0x4b,
-	0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0x91, 0x2d, 0xa8, 0x40, 0xb1, 0xa2, 0xa4, 0xb2, 0x20,
-	0xb5, 0x38, 0x89, 0x0d, 0x9c, 0xca, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x79, 0x05, 0x2f,
-	0x39, 0x7d, 0x03, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0xe3, 0x8a, 0x14, 0xe5, 0xb0, 0xea, 0x10, 0xa0, 0xb5, 0xc0, 0x50, 0xb7, 0x5c, 0x2a,
+	0xd1, 0x19, 0x52, 0xae, 0x15, 0x97, 0x48, 0x41, 0x82, 0x1d, 0x97, 0x08, 0x81, 0x84, 0x84, 0xaa,
+	0xb1, 0x33, 0x35, 0x16, 0xf1, 0x8c, 0xeb, 0x71, 0x22, 0x2a, 0xc4, 0x86, 0x27, 0x40, 0xb0, 0xe7,
+	0x79, 0xc2, 0xae, 0x12, 0x9b, 0xae, 0x10, 0x24, 0x7d, 0x10, 0x94, 0xf1, 0x38, 0x4d, 0x63, 0xd9,
+	0x09, 0xdd, 0xd9, 0xc7, 0xe7, 0x3f, 0xff, 0x77, 0x26, 0xff, 0x04, 0x2e, 0xbb, 0x42, 0x06, 0x42,
+	0x12, 0xd6, 0x0d, 0x48, 0x18, 0x31, 0xd7, 0x97, 0xcc, 0xa1, 0xfc, 0x03, 0xe9, 0xd6, 0xc8, 0x4e,
+	0x87, 0x45, 0xbb, 0x38, 0x8c, 0x44, 0x2c, 0xd0, 0x52, 0xd2, 0x86, 0x59, 0x37, 0xc0, 0x63, 0x6d,
+	0xb8, 0x5b, 0x33, 0x2d, 0x3d, 0xc1, 0xa1, 0x92, 0x91, 0x6e, 0xcd, 0x61, 0x31, 0xad, 0x11, 0x57,
+	0xf8, 0x3c, 0x91, 0x9a, 0x57, 0xf3, 0x1d, 0x3c, 0xc6, 0x99, 0xf4, 0xa5, 0x6e, 0xac, 0x7a, 0xc2,
+	0x13, 0xea, 0x91, 0x0c, 0x9f, 0x74, 0xf5, 0xbc, 0x27, 0x84, 0xd7, 0x66, 0x84, 0x86, 0x3e, 0xa1,
+	0x9c, 0x8b, 0x98, 0xc6, 0xbe, 0xe0, 0x5a, 0x63, 0x57, 0x01, 0xbd, 0x1c, 0x62, 0xbe, 0xa0, 0x11,
+	0x0d, 0x64, 0x93, 0xed, 0x74, 0x98, 0x8c, 0xed, 0xd7, 0x70, 0xfa, 0x48, 0x55, 0x86, 0x82, 0x4b,
+	0x86, 0xea, 0x30, 0x1f, 0xaa, 0xca, 0xa2, 0x71, 0xc9, 0xb8, 0x76, 0x6a, 0x63, 0x19, 0xe7, 0x6e,
+	0x85, 0x13, 0x69, 0xe3, 0x44, 0xef, 0xf7, 0xc5, 0x52, 0x53, 0xcb, 0xec, 0xfb, 0xb0, 0xa2, 0xe6,
+	0xbe, 0x12, 0x31, 0x6d, 0x3f, 0x89, 0xa8, 0x3b, 0x44, 0xa1, 0xed, 0x06, 0x6d, 0x53, 0xee, 0xb2,
+	0xd4, 0x1e, 0x55, 0xa1, 0xdc, 0x62, 0x5c, 0x04, 0xca, 0xa6, 0xd2, 0x4c, 0x5e, 0xec, 0x77, 0xb0,
+	0x5a, 0x2c, 0xd6, 0x94, 0xb7, 0xa1, 0x1c, 0x0f, 0x5b, 0x34, 0xe4, 0x52, 0x0a, 0x39, 0x3c, 0x5f,
+	0xac, 0xcf, 0x17, 0x3f, 0x16, 0x3e, 0xd7, 0x70, 0x49, 0xb7, 0xbd, 0x0e, 0x67, 0xd4, 0xf8, 0x26,
+	0x0b, 0xa8, 0xcf, 0x5b, 0x2c, 0x2a, 0xa6, 0x79, 0x03, 0x67, 0x27, 0xdb, 0xb5, 0xff, 0x43, 0xa8,
+	0x44, 0x69, 0x71, 0x56, 0x86, 0x43, 0x85, 0xfd, 0x1c, 0x2e, 0xa8, 0xc1, 0x99, 0x0d, 0x53, 0x9e,
+	0x45, 0x38, 0x49, 0x5b, 0xad, 0x88, 0x49, 0xa9, 0x89, 0xd2, 0xd7, 0x43, 0xd2, 0xb9, 0x71, 0xd2,
+	0x10, 0xac, 0xbc, 0x81, 0x9a, 0xf8, 0x19, 0xa0, 0xed, 0xd1, 0xc7, 0x2d, 0x27, 0xf9, 0x3a, 0x2b,
+	0xfa, 0xc2, 0xf6, 0xe4, 0xdc, 0x8d, 0x83, 0x32, 0x94, 0x95, 0x25, 0xfa, 0x66, 0xc0, 0x7c, 0x92,
+	0x04, 0xb4, 0x5e, 0x10, 0x96, 0x6c, 0x04, 0x4d, 0x3c, 0x6b, 0x7b, 0xb2, 0x83, 0xbd, 0xf6, 0xe5,
+	0xd7, 0xc1, 0xf7, 0xb9, 0x15, 0xb4, 0x4c, 0xf2, 0xaf, 0x4b, 0x92, 0x42, 0xb4, 0x6f, 0xc0, 0xb9,
+	0x9c, 0x10, 0xa1, 0x47, 0xd3, 0x6c, 0x8b, 0xa3, 0x6b, 0xd6, 0x8f, 0xad, 0xd7, 0x7b, 0x3c, 0x50,
+	0x7b, 0xdc, 0x41, 0xb7, 0x0a, 0xf6, 0x50, 0x81, 0xdd, 0xca, 0xfe, 0x64, 0x12, 0xfd, 0x30, 0xa0,
+	0x32, 0x4a, 0x24, 0xba, 0x31, 0x0d, 0x66, 0x32, 0xeb, 0x66, 0xed, 0x3f, 0x14, 0x1a, 0xf8, 0xba,
+	0x02, 0xbe, 0x82, 0x56, 0x0b, 0x80, 0x47, 0xe9, 0x46, 0x3f, 0x0d, 0x58, 0xc8, 0x6c, 0x8f, 0xee,
+	0x4d, 0xb3, 0xcd, 0xbb, 0x0c, 0xe6, 0xe6, 0x31, 0x94, 0x1a, 0xbc, 0xae, 0xc0, 0x37, 0xd1, 0xdd,
+	0x02, 0xf0, 0xec, 0x19, 0x93, 0x4f, 0xfa, 0xb6, 0x7d, 0x6e, 0x3c, 0xed, 0xfd, 0xb5, 0x4a, 0xbd,
+	0xbe, 0x65, 0xec, 0xf5, 0x2d, 0xe3, 0x4f, 0xdf, 0x32, 0xbe, 0x0e, 0xac, 0xd2, 0xde, 0xc0, 0x2a,
+	0xed, 0x0f, 0xac, 0xd2, 0xdb, 0x35, 0xcf, 0x8f, 0xdf, 0x77, 0x1c, 0xec, 0x8a, 0x60, 0xdc, 0xe0,
+	0xe3, 0x11, 0x8b, 0x78, 0x37, 0x64, 0xd2, 0x99, 0x57, 0xff, 0xc5, 0x37, 0xff, 0x05, 0x00, 0x00,
+	0xff, 0xff, 0xdb, 0x56, 0x6f, 0xa3, 0x4c, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -241,6 +418,11 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	// Params returns the precisebank module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TotalFractionalBalances returns the sum of all fractional balances managed
+	// by the module.
+	TotalFractionalBalances(ctx context.Context, in *QueryTotalFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryTotalFractionalBalancesResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error)
@@ -257,6 +439,24 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.precisebank.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalFractionalBalances(ctx context.Context, in *QueryTotalFractionalBalancesRequest, opts ...grpc.CallOption) (*QueryTotalFractionalBalancesResponse, error) {
+	out := new(QueryTotalFractionalBalancesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.precisebank.v1.Query/TotalFractionalBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error) {
 	out := new(QueryRemainderResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.precisebank.v1.Query/Remainder", in, out, opts...)
@@ -277,6 +477,11 @@ func (c *queryClient) FractionalBalance(ctx context.Context, in *QueryFractional
 
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	// Params returns the precisebank module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TotalFractionalBalances returns the sum of all fractional balances managed
+	// by the module.
+	TotalFractionalBalances(context.Context, *QueryTotalFractionalBalancesRequest) (*QueryTotalFractionalBalancesResponse, error)
 	// Remainder returns the amount backed by the reserve, but not yet owned by
 	// any account, i.e. not in circulation.
 	Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error)
@@ -289,6 +494,12 @@ type QueryServer interface {
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) TotalFractionalBalances(ctx context.Context, req *QueryTotalFractionalBalancesRequest) (*QueryTotalFractionalBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalFractionalBalances not implemented")
+}
 func (*UnimplementedQueryServer) Remainder(ctx context.Context, req *QueryRemainderRequest) (*QueryRemainderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Remainder not implemented")
 }
@@ -300,6 +511,42 @@ func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.precisebank.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalFractionalBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalFractionalBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalFractionalBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.precisebank.v1.Query/TotalFractionalBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalFractionalBalances(ctx, req.(*QueryTotalFractionalBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Remainder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRemainderRequest)
 	if err := dec(in); err != nil {
@@ -340,6 +587,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.precisebank.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "TotalFractionalBalances",
+			Handler:    _Query_TotalFractionalBalances_Handler,
+		},
 		{
 			MethodName: "Remainder",
 			Handler:    _Query_Remainder_Handler,
@@ -353,6 +608,125 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	Metadata: "cosmos/evm/precisebank/v1/query.proto",
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFractionalBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFractionalBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFractionalBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFractionalBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFractionalBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFractionalBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryRemainderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -373,6 +747,13 @@ func (m *QueryRemainderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -429,6 +810,13 @@ func (m *QueryFractionalBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -483,7 +871,7 @@ func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *QueryRemainderRequest) Size() (n int) {
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -492,48 +880,398 @@ func (m *QueryRemainderRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryRemainderResponse) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Remainder.Size()
+	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryFractionalBalanceRequest) Size() (n int) {
+func (m *QueryTotalFractionalBalancesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryFractionalBalanceResponse) Size() (n int) {
+func (m *QueryTotalFractionalBalancesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.FractionalBalance.Size()
+	l = m.Total.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemainderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Remainder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFractionalBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFractionalBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FractionalBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalFractionalBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalFractionalBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalFractionalBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalFractionalBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalFractionalBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalFractionalBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -562,6 +1300,38 @@ func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryRemainderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -727,6 +1497,38 @@ func (m *QueryFractionalBalanceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -32,6 +32,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetStorageCmd(),
 		GetCodeCmd(),
+		GetCodeDelegationCmd(),
 		GetAccountCmd(),
 		GetParamsCmd(),
 		GetConfigCmd(),
@@ -120,6 +121,43 @@ func GetCodeCmd() *cobra.Command {
 	return cmd
 }
 
+// GetCodeDelegationCmd queries the EIP-7702 code delegation of an account
+func GetCodeDelegationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-delegation ADDRESS",
+		Short: "Gets the EIP-7702 code delegation of an account",
+		Long:  "Gets the address an account delegates its code to with EIP-7702. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCodeDelegationRequest{
+				Address: address,
+			}
+
+			res, err := queryClient.CodeDelegation(rpctypes.NewContextWithHeight(clientCtx.Height), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetAccountCmd queries the account of a given address
 func GetAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
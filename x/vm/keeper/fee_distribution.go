@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	evmtrace "github.com/cosmos/evm/trace"
+	"github.com/cosmos/evm/x/vm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DistributeFees splits the fees paid for the gas consumed by an EVM transaction
+// according to the fee distribution params. The base fee portion is partially
+// burned and partially sent to the community pool, while the priority tip is
+// paid to the block proposer coinbase. Anything left stays in the fee collector.
+//
+// NOTE: it must be called after the leftover gas has been refunded, so that the
+// fee collector only holds the fees for the consumed gas.
+func (k *Keeper) DistributeFees(ctx sdk.Context, msg core.Message, gasUsed uint64, baseFee *big.Int, coinbase common.Address) (err error) {
+	ctx, span := ctx.StartSpan(tracer, "DistributeFees", trace.WithAttributes(
+		attribute.Int64("gas_used", int64(gasUsed)), //nolint:gosec // G115
+		attribute.String("coinbase", coinbase.Hex()),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	fd := k.GetParams(ctx).FeeDistribution
+	if !fd.IsEnabled() || gasUsed == 0 || msg.GasPrice == nil || msg.GasPrice.Sign() <= 0 {
+		return nil
+	}
+	// the fees are not held by the fee collector account with the virtual fee
+	// collection, so they can't be distributed
+	if k.virtualFeeCollection {
+		return errorsmod.Wrap(types.ErrInvalidFeeDistribution, "fee distribution is not supported with virtual fee collection")
+	}
+
+	// fees paid with a whitelisted fee token are kept in the fee collector
+	if _, ok := types.FeeTokenPaymentFromContext(ctx); ok {
+		return nil
+	}
+
+	// the effective gas price is split between the base fee and the priority tip
+	basePrice := new(big.Int).Set(msg.GasPrice)
+	if baseFee != nil && baseFee.Cmp(msg.GasPrice) < 0 {
+		basePrice.Set(baseFee)
+	}
+	tipPrice := new(big.Int).Sub(msg.GasPrice, basePrice)
+
+	gas := new(big.Int).SetUint64(gasUsed)
+	baseFeeAmt := sdkmath.LegacyNewDecFromBigInt(new(big.Int).Mul(gas, basePrice))
+	tipAmt := new(big.Int).Mul(gas, tipPrice)
+
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	evmDenom := types.GetEVMCoinDenom()
+
+	burnAmt := baseFeeAmt.Mul(fd.GetBaseFeeBurnRatio()).TruncateInt()
+	if burnAmt.IsPositive() {
+		if err := k.bankWrapper.BurnAmountFromAccount(ctx, feeCollector, burnAmt.BigInt()); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn %s base fee", burnAmt)
+		}
+	}
+
+	// the community pool is funded through the distribution keeper, which is not
+	// aware of the extended denom, so the amount is truncated to the evm denom decimals.
+	communityAmt := baseFeeAmt.Mul(fd.GetCommunityPoolRatio()).TruncateInt().
+		Quo(types.GetEVMCoinDecimals().ConversionFactor())
+	communityCoins := sdk.Coins{}
+	if communityAmt.IsPositive() {
+		if k.distributionKeeper == nil {
+			return errorsmod.Wrap(errortypes.ErrLogic, "distribution keeper is required to fund the community pool")
+		}
+		communityCoins = sdk.Coins{sdk.NewCoin(evmDenom, communityAmt)}
+		if err := k.distributionKeeper.FundCommunityPool(ctx, communityCoins, feeCollector); err != nil {
+			return errorsmod.Wrapf(err, "failed to fund community pool with %s", communityCoins)
+		}
+	}
+
+	proposerTip := sdkmath.ZeroInt()
+	if fd.ProposerTips && tipAmt.Sign() > 0 && coinbase != (common.Address{}) {
+		proposerTip = sdkmath.NewIntFromBigInt(tipAmt)
+		tipCoins := sdk.Coins{sdk.NewCoin(evmDenom, proposerTip)}
+		if err := k.bankWrapper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, coinbase.Bytes(), tipCoins); err != nil {
+			return errorsmod.Wrapf(err, "failed to pay priority tip to proposer %s", coinbase)
+		}
+	}
+
+	extendedDenom := types.GetEVMCoinExtendedDenom()
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeDistribution,
+			sdk.NewAttribute(types.AttributeKeyBurnedFee, sdk.NewCoin(extendedDenom, burnAmt).String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPoolFee, communityCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyProposerTip, sdk.NewCoin(extendedDenom, proposerTip).String()),
+			sdk.NewAttribute(types.AttributeKeyProposer, coinbase.Hex()),
+		),
+	)
+
+	return nil
+}
+
+// validateFeeDistribution checks that the keeper is able to apply the given fee
+// distribution.
+func (k Keeper) validateFeeDistribution(fd types.FeeDistribution) error {
+	if fd.GetCommunityPoolRatio().IsPositive() && k.distributionKeeper == nil {
+		return errorsmod.Wrap(types.ErrInvalidFeeDistribution, "community pool ratio requires a distribution keeper")
+	}
+	return nil
+}
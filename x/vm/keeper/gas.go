@@ -54,6 +54,10 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	case 1:
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		// refund in the fee token if it was used to pay for the fees, rounding down
+		if payment, ok := types.FeeTokenPaymentFromContext(ctx); ok {
+			refundedCoins = sdk.Coins{sdk.NewCoin(payment.Denom, payment.ConvertFrom18Decimals(remaining, false))}
+		}
 
 		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
 		var err error
@@ -237,6 +237,42 @@ func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (_ *types.Q
 	}, nil
 }
 
+// CodeDelegation implements the Query/CodeDelegation gRPC method
+func (k Keeper) CodeDelegation(c context.Context, req *types.QueryCodeDelegationRequest) (_ *types.QueryCodeDelegationResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, span := ctx.StartSpan(tracer, "CodeDelegation", trace.WithAttributes(
+		attribute.String("address", req.Address),
+	))
+	defer func() { evmtrace.EndSpanErr(span, err) }()
+
+	if err := utils.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	address := common.HexToAddress(req.Address)
+	acct := k.GetAccountWithoutBalance(ctx, address)
+	if acct == nil || !acct.HasCodeHash() {
+		return &types.QueryCodeDelegationResponse{}, nil
+	}
+
+	target, ok := ethtypes.ParseDelegation(k.GetCode(ctx, common.BytesToHash(acct.CodeHash)))
+	if !ok {
+		return &types.QueryCodeDelegationResponse{}, nil
+	}
+
+	return &types.QueryCodeDelegationResponse{
+		Delegated: true,
+		Address:   target.Hex(),
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (_ *types.QueryParamsResponse, err error) {
 	ctx := sdk.UnwrapSDKContext(c)
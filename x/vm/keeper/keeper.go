@@ -67,6 +67,8 @@ type Keeper struct {
 	feeMarketWrapper *wrappers.FeeMarketWrapper
 	// optional erc20Keeper interface needed to instantiate erc20 precompiles
 	erc20Keeper types.Erc20Keeper
+	// optional distributionKeeper needed to send part of the fees to the community pool
+	distributionKeeper types.DistributionKeeper
 	// consensusKeeper is used to get consensus params during query contexts.
 	// This is needed as block.gasLimit is expected to be available in eth_call, which is routed through Cosmos SDK's
 	// grpc query router. This query router builds a context WITHOUT consensus params, so we manually supply the context
@@ -157,6 +159,15 @@ func NewKeeper(
 // Note: Do NOT use this if your chain does not have an 18 decimal point precision gas token.
 func (k *Keeper) EnableVirtualFeeCollection() {
 	k.virtualFeeCollection = true
+	types.SetVirtualFeeCollection(true)
+}
+
+// WithDistributionKeeper sets the distribution keeper used to fund the community
+// pool with the share of the EVM transaction fees defined in the fee
+// distribution params.
+func (k *Keeper) WithDistributionKeeper(dk types.DistributionKeeper) *Keeper {
+	k.distributionKeeper = dk
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -52,6 +52,12 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (_
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
 
+	// a failed transaction reverts the whole batch it belongs to, while the
+	// fees and nonces of the batch are kept as they are handled by the ante handler
+	if types.IsEthBatch(ctx) && response.Failed() {
+		return nil, errorsmod.Wrapf(types.ErrBatchReverted, "ethereum tx %s failed: %s", response.Hash, response.VmError)
+	}
+
 	defer func() {
 		telemetry.IncrCounterWithLabels( //nolint:staticcheck // TODO: fix
 			[]string{"tx", "msg", "ethereum_tx", "total"},
@@ -45,6 +45,10 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) (err error) {
 		return err
 	}
 
+	if err := k.validateFeeDistribution(params.FeeDistribution); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&params)
 	if err != nil {
@@ -324,6 +324,11 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (_
 		return nil, errorsmod.Wrapf(err, "failed to refund leftover gas to sender %s", msg.From)
 	}
 
+	// split the fees of the consumed gas between burn, community pool and proposer
+	if err = k.DistributeFees(ctx, *msg, msg.GasLimit-remainingGas, cfg.BaseFee, cfg.CoinBase); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to distribute transaction fees")
+	}
+
 	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/evm/x/vm/store/types"
 	vmtypes "github.com/cosmos/evm/x/vm/types"
 
+	"cosmossdk.io/store/cachemulti"
 	storetypes "cosmossdk.io/store/types"
 )
 
@@ -97,16 +98,22 @@ func (s *Store) CacheWrap() storetypes.CacheWrap {
 //
 // NOTE: CacheWrapWithTrace is a method that enables a Store to satisfy the CacheWrapper interface.
 // Although it accepts an io.Writer and tracingContext as inputs, these are not used in the implementation.
-// Instead, it simply adds an additional cache layer on top of the existing KVStores.
-// As a result, while the return value differs, the behavior is effectively the same as the Snapshot() method.
+// Instead, it simply returns a branch of the current layer of the existing KVStores,
+// so the behavior is the same as the CacheWrap() method.
 func (s *Store) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
 	return s.CacheWrap()
 }
 
-// CacheMultiStore snapshots store and return current store.
+// CacheMultiStore returns a branch of the current layer of each store.
+// Changes made on the branch are only written into that layer when Write is
+// called on the branch, so a dropped branch doesn't modify the store and
+// writing it doesn't flush the snapshot stack to the initial store.
 func (s *Store) CacheMultiStore() storetypes.CacheMultiStore {
-	s.Snapshot()
-	return s
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(s.storeKeys))
+	for _, key := range s.storeKeys {
+		stores[key] = s.stores[key].CurrentStore()
+	}
+	return cachemulti.NewStore(stores, nil, nil)
 }
 
 // CacheMultiStoreWithVersion load stores at a snapshot version.
@@ -130,10 +130,11 @@ func TestSnapshotMultiCacheWrap(t *testing.T) {
 	snapshotStore, _ := setupStore()
 
 	wrap := snapshotStore.CacheWrap()
-	require.Equal(t, snapshotStore, wrap)
+	require.NotEqual(t, snapshotStore, wrap)
 
+	// branching doesn't push a snapshot on the store
 	idx := snapshotStore.Snapshot()
-	require.Equal(t, 1, idx)
+	require.Equal(t, 0, idx)
 }
 
 func TestSnapshotMultiCacheWrapWithTrace(t *testing.T) {
@@ -142,20 +143,62 @@ func TestSnapshotMultiCacheWrapWithTrace(t *testing.T) {
 	// NOTES: CacheWrapWithTrace of snapshotmulti.Store is same with regualr CacheWrap,
 	// and arguments are not actually used.
 	wrap := snapshotStore.CacheWrapWithTrace(nil, nil)
-	require.Equal(t, snapshotStore, wrap)
+	require.NotEqual(t, snapshotStore, wrap)
 
 	idx := snapshotStore.Snapshot()
-	require.Equal(t, 1, idx)
+	require.Equal(t, 0, idx)
 }
 
 func TestSnapshotMultiCacheMultiStore(t *testing.T) {
-	snapshotStore, _ := setupStore()
+	snapshotStore, key := setupStore()
+	kv := snapshotStore.GetKVStore(key)
+
+	idx0 := snapshotStore.Snapshot()
+	snapshotStore.GetKVStore(key).Set([]byte("a"), []byte("1"))
 
 	m := snapshotStore.CacheMultiStore()
-	require.Equal(t, snapshotStore, m)
+	require.NotEqual(t, snapshotStore, m)
+	require.Equal(t, []byte("1"), m.GetKVStore(key).Get([]byte("a")))
+	m.GetKVStore(key).Set([]byte("b"), []byte("2"))
 
-	idx := snapshotStore.Snapshot()
-	require.Equal(t, 1, idx)
+	// the branch is isolated until it is written
+	require.Nil(t, snapshotStore.GetKVStore(key).Get([]byte("b")))
+
+	// writing the branch only writes into the current layer
+	m.Write()
+	require.Equal(t, []byte("2"), snapshotStore.GetKVStore(key).Get([]byte("b")))
+	require.Nil(t, kv.Get([]byte("a")))
+	require.Nil(t, kv.Get([]byte("b")))
+	require.Equal(t, int64(idx0+1), snapshotStore.LatestVersion())
+
+	// the written changes are reverted with the snapshot of the layer
+	snapshotStore.RevertToSnapshot(idx0)
+	require.Nil(t, snapshotStore.GetKVStore(key).Get([]byte("b")))
+
+	// writing the store flushes the written branch to the initial store
+	m = snapshotStore.CacheMultiStore()
+	m.GetKVStore(key).Set([]byte("c"), []byte("3"))
+	m.Write()
+	snapshotStore.Write()
+	require.Equal(t, []byte("3"), kv.Get([]byte("c")))
+}
+
+func TestSnapshotMultiCacheMultiStoreDropped(t *testing.T) {
+	snapshotStore, key := setupStore()
+	kv := snapshotStore.GetKVStore(key)
+	kv.Set([]byte("b"), []byte("2"))
+
+	snapshotStore.Snapshot()
+	m := snapshotStore.CacheMultiStore()
+	m.GetKVStore(key).Set([]byte("a"), []byte("1"))
+	m.GetKVStore(key).Delete([]byte("b"))
+
+	// a dropped branch doesn't leak its writes into the store
+	require.Nil(t, snapshotStore.GetKVStore(key).Get([]byte("a")))
+	require.Equal(t, []byte("2"), snapshotStore.GetKVStore(key).Get([]byte("b")))
+	snapshotStore.Write()
+	require.Nil(t, kv.Get([]byte("a")))
+	require.Equal(t, []byte("2"), kv.Get([]byte("b")))
 }
 
 func TestSnapshotMultiCacheMultiStoreWithVersion(t *testing.T) {
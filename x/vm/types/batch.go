@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ethBatchKey is the context key under which the atomic execution of the
+// Ethereum transactions of a Cosmos transaction is recorded.
+type ethBatchKey struct{}
+
+// ContextWithEthBatch returns a copy of the context that records that the
+// Ethereum transactions of the Cosmos transaction are executed as an atomic
+// batch, so that the failure of any of them reverts all of them.
+func ContextWithEthBatch(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(ethBatchKey{}, true)
+}
+
+// IsEthBatch returns true if the Ethereum transactions of the Cosmos
+// transaction are executed as an atomic batch.
+func IsEthBatch(ctx sdk.Context) bool {
+	batch, ok := ctx.Value(ethBatchKey{}).(bool)
+	return ok && batch
+}
+
+// IsBatchEnabled returns true if Cosmos transactions can batch the given
+// number of Ethereum transactions.
+func (p Params) IsBatchEnabled(msgCount int) bool {
+	return p.MaxBatchMsgs > 1 && uint64(msgCount) <= p.MaxBatchMsgs //#nosec G115 -- msgCount is never negative
+}
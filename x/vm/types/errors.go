@@ -32,6 +32,8 @@ const (
 	codeErrABIPack
 	codeErrABIUnpack
 	codeErrInvalidPreinstall
+	codeErrInvalidFeeDistribution
+	codeErrBatchReverted
 )
 
 var (
@@ -92,6 +94,12 @@ var (
 	// ErrInvalidPreinstall returns an error if a preinstall is invalid
 	ErrInvalidPreinstall = errorsmod.Register(ModuleName, codeErrInvalidPreinstall, "invalid preinstall")
 
+	// ErrInvalidFeeDistribution returns an error if the fee distribution cannot be applied
+	ErrInvalidFeeDistribution = errorsmod.Register(ModuleName, codeErrInvalidFeeDistribution, "invalid fee distribution")
+
+	// ErrBatchReverted returns an error if an Ethereum transaction of an atomic batch fails, reverting the whole batch
+	ErrBatchReverted = errorsmod.Register(ModuleName, codeErrBatchReverted, "ethereum transaction batch reverted")
+
 	// RevertSelector is selector of ErrExecutionReverted
 	RevertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
 )
@@ -5,6 +5,9 @@ const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeFeeMarket  = "evm_fee_market"
+	// EventTypeFeeDistribution is emitted when the fees of an EVM transaction
+	// are split according to the fee distribution params.
+	EventTypeFeeDistribution = "evm_fee_distribution"
 
 	AttributeKeyBaseFee         = "base_fee"
 	AttributeKeyContractAddress = "contract"
@@ -16,6 +19,11 @@ const (
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
 
+	AttributeKeyBurnedFee        = "burned"
+	AttributeKeyCommunityPoolFee = "community_pool"
+	AttributeKeyProposerTip      = "proposer_tip"
+	AttributeKeyProposer         = "proposer"
+
 	// tx failed in eth vm execution
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
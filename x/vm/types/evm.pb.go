@@ -73,6 +73,13 @@ type Params struct {
 	ActiveStaticPrecompiles []string              `protobuf:"bytes,9,rep,name=active_static_precompiles,json=activeStaticPrecompiles,proto3" json:"active_static_precompiles,omitempty"`
 	HistoryServeWindow      uint64                `protobuf:"varint,10,opt,name=history_serve_window,json=historyServeWindow,proto3" json:"history_serve_window,omitempty"`
 	ExtendedDenomOptions    *ExtendedDenomOptions `protobuf:"bytes,11,opt,name=extended_denom_options,json=extendedDenomOptions,proto3" json:"extended_denom_options,omitempty"`
+	// fee_distribution defines how the fees paid for the gas consumed by EVM
+	// transactions are distributed
+	FeeDistribution FeeDistribution `protobuf:"bytes,12,opt,name=fee_distribution,json=feeDistribution,proto3" json:"fee_distribution"`
+	// max_batch_msgs is the maximum number of Ethereum transactions that can be
+	// batched in a single Cosmos transaction, which are executed atomically.
+	// Batching is disabled if it is lower than 2.
+	MaxBatchMsgs uint64 `protobuf:"varint,13,opt,name=max_batch_msgs,json=maxBatchMsgs,proto3" json:"max_batch_msgs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -157,6 +164,76 @@ func (m *Params) GetExtendedDenomOptions() *ExtendedDenomOptions {
 	return nil
 }
 
+func (m *Params) GetFeeDistribution() FeeDistribution {
+	if m != nil {
+		return m.FeeDistribution
+	}
+	return FeeDistribution{}
+}
+
+func (m *Params) GetMaxBatchMsgs() uint64 {
+	if m != nil {
+		return m.MaxBatchMsgs
+	}
+	return 0
+}
+
+// FeeDistribution defines how the fees paid for the gas consumed by EVM
+// transactions are split. The fee of a transaction is made of a base fee
+// portion (gas used * base fee) and a priority tip portion (gas used *
+// effective gas tip). Any amount that is not burned or redirected stays in the
+// fee collector and is distributed like any other Cosmos SDK fee.
+type FeeDistribution struct {
+	// base_fee_burn_ratio is the share of the base fee portion that is burned
+	BaseFeeBurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee_burn_ratio,json=baseFeeBurnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_burn_ratio"`
+	// community_pool_ratio is the share of the base fee portion that is sent to
+	// the community pool
+	CommunityPoolRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=community_pool_ratio,json=communityPoolRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_ratio"`
+	// proposer_tips sends the priority tip portion to the block proposer, i.e.
+	// the block coinbase, instead of the fee collector
+	ProposerTips bool `protobuf:"varint,3,opt,name=proposer_tips,json=proposerTips,proto3" json:"proposer_tips,omitempty"`
+}
+
+func (m *FeeDistribution) Reset()         { *m = FeeDistribution{} }
+func (m *FeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*FeeDistribution) ProtoMessage()    {}
+func (*FeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1129b8db63d55c7, []int{1}
+}
+func (m *FeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDistribution.Merge(m, src)
+}
+func (m *FeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDistribution proto.InternalMessageInfo
+
+func (m *FeeDistribution) GetProposerTips() bool {
+	if m != nil {
+		return m.ProposerTips
+	}
+	return false
+}
+
 type ExtendedDenomOptions struct {
 	ExtendedDenom string `protobuf:"bytes,1,opt,name=extended_denom,json=extendedDenom,proto3" json:"extended_denom,omitempty"`
 }
@@ -165,7 +242,7 @@ func (m *ExtendedDenomOptions) Reset()         { *m = ExtendedDenomOptions{} }
 func (m *ExtendedDenomOptions) String() string { return proto.CompactTextString(m) }
 func (*ExtendedDenomOptions) ProtoMessage()    {}
 func (*ExtendedDenomOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{1}
+	return fileDescriptor_d1129b8db63d55c7, []int{2}
 }
 func (m *ExtendedDenomOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +291,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{2}
+	return fileDescriptor_d1129b8db63d55c7, []int{3}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +352,7 @@ func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
 func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
 func (*AccessControlType) ProtoMessage()    {}
 func (*AccessControlType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{3}
+	return fileDescriptor_d1129b8db63d55c7, []int{4}
 }
 func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +462,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{4}
+	return fileDescriptor_d1129b8db63d55c7, []int{5}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +531,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{5}
+	return fileDescriptor_d1129b8db63d55c7, []int{6}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -511,7 +588,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{6}
+	return fileDescriptor_d1129b8db63d55c7, []int{7}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +666,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{7}
+	return fileDescriptor_d1129b8db63d55c7, []int{8}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +788,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{8}
+	return fileDescriptor_d1129b8db63d55c7, []int{9}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +829,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{9}
+	return fileDescriptor_d1129b8db63d55c7, []int{10}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +889,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{10}
+	return fileDescriptor_d1129b8db63d55c7, []int{11}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1010,7 @@ func (m *Preinstall) Reset()         { *m = Preinstall{} }
 func (m *Preinstall) String() string { return proto.CompactTextString(m) }
 func (*Preinstall) ProtoMessage()    {}
 func (*Preinstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{11}
+	return fileDescriptor_d1129b8db63d55c7, []int{12}
 }
 func (m *Preinstall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1071,7 @@ func (m *EvmCoinInfo) Reset()         { *m = EvmCoinInfo{} }
 func (m *EvmCoinInfo) String() string { return proto.CompactTextString(m) }
 func (*EvmCoinInfo) ProtoMessage()    {}
 func (*EvmCoinInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d1129b8db63d55c7, []int{12}
+	return fileDescriptor_d1129b8db63d55c7, []int{13}
 }
 func (m *EvmCoinInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1054,6 +1131,7 @@ func (m *EvmCoinInfo) GetDecimals() uint32 {
 func init() {
 	proto.RegisterEnum("cosmos.evm.vm.v1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.evm.vm.v1.Params")
+	proto.RegisterType((*FeeDistribution)(nil), "cosmos.evm.vm.v1.FeeDistribution")
 	proto.RegisterType((*ExtendedDenomOptions)(nil), "cosmos.evm.vm.v1.ExtendedDenomOptions")
 	proto.RegisterType((*AccessControl)(nil), "cosmos.evm.vm.v1.AccessControl")
 	proto.RegisterType((*AccessControlType)(nil), "cosmos.evm.vm.v1.AccessControlType")
@@ -1071,139 +1149,149 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/evm.proto", fileDescriptor_d1129b8db63d55c7) }
 
 var fileDescriptor_d1129b8db63d55c7 = []byte{
-	// 2111 bytes of a gzipped FileDescriptorProto
+	// 2267 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0x16, 0xc5, 0x95, 0xb4, 0x1c, 0x52, 0xd4, 0x7a, 0x44, 0xcb, 0x34, 0xed, 0x68, 0xd5, 0x4d,
-	0x5b, 0xa8, 0x46, 0x2a, 0x59, 0x72, 0xd4, 0x1a, 0x4e, 0xd3, 0x42, 0x94, 0x98, 0x56, 0xaa, 0x6c,
-	0x0b, 0x43, 0x35, 0x46, 0x8a, 0x14, 0x8b, 0xe1, 0xee, 0x78, 0xb9, 0xd1, 0xee, 0x0e, 0xb1, 0xb3,
-	0xa4, 0xc5, 0xfe, 0x81, 0x06, 0xee, 0x25, 0xed, 0xdd, 0x40, 0x80, 0x5e, 0x72, 0xcc, 0x4f, 0xe8,
-	0x31, 0xc7, 0x1c, 0x8b, 0x02, 0x5d, 0x14, 0xf4, 0x21, 0x80, 0x8e, 0xfa, 0x05, 0xc5, 0x7c, 0xf0,
-	0x5b, 0x61, 0x15, 0x40, 0xb0, 0xe7, 0x79, 0x3f, 0x9e, 0x67, 0x3e, 0xde, 0xdd, 0x79, 0x97, 0xa0,
-	0xe2, 0x50, 0x16, 0x52, 0xb6, 0x4d, 0x3a, 0xe1, 0x36, 0xff, 0xdb, 0xe1, 0xa3, 0xad, 0x56, 0x4c,
-	0x13, 0x0a, 0x0d, 0xe9, 0xdb, 0xe2, 0x16, 0xfe, 0xb7, 0x53, 0xb9, 0x85, 0x43, 0x3f, 0xa2, 0xdb,
-	0xe2, 0x5f, 0x19, 0x54, 0x29, 0x79, 0xd4, 0xa3, 0x62, 0xb8, 0xcd, 0x47, 0xd2, 0x6a, 0xfd, 0x5d,
-	0x03, 0x8b, 0xa7, 0x38, 0xc6, 0x21, 0x83, 0x3b, 0x20, 0x47, 0x3a, 0xa1, 0xed, 0x92, 0x88, 0x86,
-	0xe5, 0xcc, 0x46, 0x66, 0x33, 0x57, 0x2d, 0x5d, 0xa5, 0xa6, 0xd1, 0xc5, 0x61, 0xf0, 0xc4, 0x1a,
-	0xb8, 0x2c, 0xa4, 0x93, 0x4e, 0x78, 0xc8, 0x87, 0x70, 0x1f, 0x00, 0x72, 0x91, 0xc4, 0xd8, 0x26,
-	0x7e, 0x8b, 0x95, 0xb5, 0x8d, 0xec, 0x66, 0xb6, 0x6a, 0xf5, 0x52, 0x33, 0x57, 0xe3, 0xd6, 0xda,
-	0xd1, 0x29, 0xbb, 0x4a, 0xcd, 0x5b, 0x8a, 0x60, 0x10, 0x68, 0xa1, 0x9c, 0x00, 0x35, 0xbf, 0xc5,
-	0xe0, 0x2e, 0x28, 0x70, 0x6a, 0xa7, 0x89, 0xa3, 0x88, 0x04, 0xac, 0xbc, 0xb4, 0x91, 0xdd, 0xcc,
-	0x55, 0x57, 0x7a, 0xa9, 0x99, 0xaf, 0x7d, 0xfc, 0xf4, 0x40, 0x99, 0x51, 0x9e, 0x74, 0xc2, 0x3e,
-	0x80, 0x7f, 0x02, 0x45, 0xec, 0x38, 0x84, 0x31, 0xdb, 0xa1, 0x51, 0x12, 0xd3, 0xa0, 0xac, 0x6f,
-	0x64, 0x36, 0xf3, 0xbb, 0xe6, 0xd6, 0xe4, 0x46, 0x6c, 0xed, 0x8b, 0xb8, 0x03, 0x19, 0x56, 0xbd,
-	0xfd, 0x4d, 0x6a, 0xce, 0xf5, 0x52, 0x73, 0x79, 0xcc, 0x8c, 0x96, 0xf1, 0x28, 0x84, 0x4f, 0xc0,
-	0x5d, 0xec, 0x24, 0x7e, 0x87, 0xd8, 0x2c, 0xc1, 0x89, 0xef, 0xd8, 0xad, 0x98, 0x38, 0x34, 0x6c,
-	0xf9, 0x01, 0x61, 0xe5, 0x1c, 0x9f, 0x1f, 0xba, 0x23, 0x03, 0xea, 0xc2, 0x7f, 0x3a, 0x74, 0xc3,
-	0x87, 0xa0, 0xd4, 0xf4, 0x59, 0x42, 0xe3, 0xae, 0xcd, 0x48, 0xdc, 0x21, 0xf6, 0x2b, 0x3f, 0x72,
-	0xe9, 0xab, 0x32, 0xd8, 0xc8, 0x6c, 0x6a, 0x08, 0x2a, 0x5f, 0x9d, 0xbb, 0x5e, 0x08, 0x0f, 0xfc,
-	0x14, 0xac, 0x91, 0x8b, 0x84, 0x44, 0x2e, 0x71, 0xe5, 0x06, 0xdb, 0xb4, 0x95, 0xf8, 0x34, 0x62,
-	0xe5, 0xbc, 0x58, 0xd4, 0x4f, 0xa7, 0x17, 0x55, 0x53, 0xf1, 0xe2, 0x10, 0x9e, 0xcb, 0x68, 0x54,
-	0x22, 0xd7, 0x58, 0x9f, 0xdc, 0x7b, 0xfd, 0xdd, 0xd7, 0x0f, 0xd6, 0x46, 0x6a, 0xe7, 0x82, 0x57,
-	0x8f, 0x3c, 0xf1, 0x63, 0x4d, 0x9f, 0x37, 0xb2, 0xc7, 0x9a, 0x9e, 0x35, 0xb4, 0x63, 0x4d, 0x5f,
-	0x30, 0x16, 0x8f, 0x35, 0x7d, 0xd1, 0x58, 0xb2, 0x3e, 0x04, 0xa5, 0xeb, 0x24, 0xe0, 0x4f, 0x40,
-	0x71, 0x7c, 0xaa, 0xb2, 0x4c, 0xd0, 0xf2, 0x98, 0xb4, 0xf5, 0xb7, 0x0c, 0x18, 0xdf, 0x60, 0xb8,
-	0x0f, 0x16, 0x9d, 0x98, 0xe0, 0x84, 0x88, 0x84, 0xfc, 0xee, 0xbb, 0xff, 0xe7, 0xa0, 0xce, 0xba,
-	0x2d, 0x52, 0xd5, 0xf8, 0x61, 0x21, 0x95, 0x08, 0x3f, 0x04, 0x9a, 0x83, 0x83, 0xa0, 0x3c, 0xff,
-	0x43, 0x09, 0x44, 0x9a, 0xf5, 0x9f, 0x0c, 0xb8, 0x35, 0x15, 0x01, 0x1d, 0x90, 0x57, 0x85, 0x94,
-	0x74, 0x5b, 0x72, 0x72, 0xc5, 0xdd, 0xfb, 0xdf, 0xc7, 0x2d, 0x48, 0x7f, 0xdc, 0x4b, 0x4d, 0x30,
-	0xc4, 0x57, 0xa9, 0x09, 0x65, 0x7d, 0x8f, 0x10, 0x59, 0x08, 0xe0, 0x41, 0x04, 0x74, 0xc0, 0xea,
-	0x78, 0xb5, 0xda, 0x81, 0xcf, 0x92, 0xf2, 0xbc, 0x28, 0xf4, 0x47, 0xbd, 0xd4, 0x1c, 0x9f, 0xd8,
-	0x89, 0xcf, 0x92, 0xab, 0xd4, 0xac, 0x8c, 0xb1, 0x8e, 0x66, 0x5a, 0xe8, 0x16, 0x9e, 0x4c, 0xb0,
-	0xbe, 0x32, 0x40, 0xfe, 0xa0, 0x89, 0xfd, 0xe8, 0x80, 0x46, 0x2f, 0x7d, 0x0f, 0x7e, 0x0a, 0x56,
-	0x9a, 0x34, 0x24, 0x2c, 0x21, 0xd8, 0xb5, 0x1b, 0x01, 0x75, 0xce, 0xd5, 0x23, 0xfd, 0xe8, 0xdf,
-	0xa9, 0x79, 0x5b, 0x2e, 0x90, 0xb9, 0xe7, 0x5b, 0x3e, 0xdd, 0x0e, 0x71, 0xd2, 0xdc, 0x3a, 0x8a,
-	0xb8, 0xe8, 0x9a, 0x14, 0x9d, 0xc8, 0xb4, 0x50, 0x71, 0x60, 0xa9, 0x72, 0x03, 0x6c, 0x82, 0xa2,
-	0x8b, 0xa9, 0xfd, 0x92, 0xc6, 0xe7, 0x8a, 0x7c, 0x5e, 0x90, 0x57, 0xbf, 0x97, 0xbc, 0x97, 0x9a,
-	0x85, 0xc3, 0xfd, 0xe7, 0x1f, 0xd1, 0xf8, 0x5c, 0x50, 0x5c, 0xa5, 0xe6, 0x6d, 0x29, 0x36, 0x4e,
-	0x64, 0xa1, 0x82, 0x8b, 0xe9, 0x20, 0x0c, 0xbe, 0x00, 0xc6, 0x20, 0x80, 0xb5, 0x5b, 0x2d, 0x1a,
-	0x27, 0xe5, 0xec, 0x46, 0x66, 0x53, 0xaf, 0xfe, 0xbc, 0x97, 0x9a, 0x45, 0x45, 0x59, 0x97, 0x9e,
-	0xab, 0xd4, 0xbc, 0x33, 0x41, 0xaa, 0x72, 0x2c, 0x54, 0x54, 0xb4, 0x2a, 0x14, 0x36, 0x40, 0x81,
-	0xf8, 0xad, 0x9d, 0xbd, 0x87, 0x6a, 0x01, 0x9a, 0x58, 0xc0, 0x6f, 0x66, 0x2d, 0x20, 0x5f, 0x3b,
-	0x3a, 0xdd, 0xd9, 0x7b, 0xd8, 0x9f, 0xff, 0xaa, 0x7a, 0xaf, 0x8d, 0xb0, 0x58, 0x28, 0x2f, 0xa1,
-	0x9c, 0x7c, 0x5f, 0x63, 0x4f, 0x69, 0x2c, 0xde, 0x54, 0x63, 0xef, 0x3a, 0x8d, 0xbd, 0x71, 0x8d,
-	0xbd, 0x71, 0x8d, 0xc7, 0x4a, 0x63, 0xe9, 0xa6, 0x1a, 0x8f, 0xaf, 0xd3, 0x78, 0x3c, 0xae, 0x21,
-	0x63, 0x78, 0x31, 0x35, 0xba, 0x7f, 0xc6, 0x51, 0xe2, 0xb7, 0x43, 0x25, 0xa3, 0xdf, 0xb8, 0x98,
-	0x26, 0x32, 0x2d, 0x54, 0x1c, 0x58, 0x24, 0xfb, 0x39, 0x28, 0x39, 0x34, 0x62, 0x09, 0xb7, 0x45,
-	0xb4, 0x15, 0x10, 0x25, 0x91, 0x13, 0x12, 0x8f, 0x67, 0x49, 0xdc, 0x93, 0x12, 0xd7, 0xa5, 0x5b,
-	0x68, 0x75, 0xdc, 0x2c, 0xc5, 0x6c, 0x60, 0xb4, 0x48, 0x42, 0x62, 0xd6, 0x68, 0xc7, 0x9e, 0x12,
-	0x02, 0x42, 0xe8, 0xfd, 0x59, 0x42, 0xaa, 0xac, 0x26, 0x53, 0x2d, 0xb4, 0x32, 0x34, 0x49, 0x81,
-	0x4f, 0x40, 0xd1, 0xe7, 0xaa, 0x8d, 0x76, 0xa0, 0xe8, 0xf3, 0x82, 0x7e, 0x77, 0x16, 0xbd, 0x7a,
-	0x14, 0xc6, 0x13, 0x2d, 0xb4, 0xdc, 0x37, 0x48, 0x6a, 0x17, 0xc0, 0xb0, 0xed, 0xc7, 0xb6, 0x17,
-	0x60, 0xc7, 0x27, 0xb1, 0xa2, 0x2f, 0x08, 0xfa, 0x5f, 0xcc, 0xa2, 0xbf, 0x2b, 0xe9, 0xa7, 0x93,
-	0x2d, 0x64, 0x70, 0xe3, 0x6f, 0xa5, 0x4d, 0xaa, 0xd4, 0x41, 0xa1, 0x41, 0xe2, 0xc0, 0x8f, 0x14,
-	0xff, 0xb2, 0xe0, 0x7f, 0x38, 0x8b, 0x5f, 0x55, 0xd0, 0x68, 0x9a, 0x85, 0xf2, 0x12, 0x0e, 0x48,
-	0x03, 0x1a, 0xb9, 0xb4, 0x4f, 0x7a, 0xeb, 0xc6, 0xa4, 0xa3, 0x69, 0x16, 0xca, 0x4b, 0x28, 0x49,
-	0x3d, 0xb0, 0x8a, 0xe3, 0x98, 0xbe, 0x9a, 0xd8, 0x10, 0x28, 0xb8, 0x7f, 0x39, 0x8b, 0xbb, 0xff,
-	0x72, 0x9d, 0xce, 0xe6, 0x2f, 0x57, 0x6e, 0x1d, 0xdb, 0x12, 0x17, 0x40, 0x2f, 0xc6, 0xdd, 0x09,
-	0x9d, 0xd2, 0x8d, 0x37, 0x7e, 0x3a, 0xd9, 0x42, 0x06, 0x37, 0x8e, 0xa9, 0x7c, 0x06, 0x4a, 0x21,
-	0x89, 0x3d, 0x62, 0x47, 0x24, 0x61, 0xad, 0xc0, 0x4f, 0x94, 0xce, 0xed, 0x1b, 0x3f, 0x07, 0xd7,
-	0xa5, 0x5b, 0x08, 0x0a, 0xf3, 0x33, 0x65, 0x95, 0x5a, 0x77, 0x81, 0xee, 0xf0, 0xdb, 0xc2, 0xf6,
-	0xdd, 0x72, 0x59, 0xb4, 0x26, 0x4b, 0x02, 0x1f, 0xb9, 0xb0, 0x04, 0x16, 0xe4, 0xdd, 0x7e, 0x57,
-	0xdc, 0xed, 0x12, 0xc0, 0x0a, 0xd0, 0x5d, 0xe2, 0xf8, 0x21, 0x0e, 0x58, 0xb9, 0x22, 0x12, 0x06,
-	0x18, 0x7e, 0x0c, 0x96, 0x59, 0x13, 0x47, 0x5e, 0x13, 0xfb, 0x76, 0xe2, 0x87, 0xa4, 0x7c, 0x4f,
-	0xcc, 0x78, 0x67, 0xd6, 0x8c, 0x4b, 0x72, 0xc6, 0x63, 0x79, 0x16, 0x2a, 0xf4, 0xf1, 0x99, 0x1f,
-	0x12, 0x78, 0x0a, 0xf2, 0x0e, 0x8e, 0x9c, 0x76, 0x24, 0x59, 0xef, 0x0b, 0xd6, 0xed, 0x59, 0xac,
-	0xea, 0x2a, 0x1e, 0xc9, 0xb2, 0x10, 0x90, 0xa8, 0xcf, 0xd8, 0x8a, 0xb1, 0xd7, 0x26, 0x92, 0xf1,
-	0x9d, 0x1b, 0x33, 0x8e, 0x64, 0x59, 0x08, 0x48, 0xd4, 0x67, 0xec, 0x90, 0xf8, 0x3c, 0x50, 0x8c,
-	0xeb, 0x37, 0x66, 0x1c, 0xc9, 0xb2, 0x10, 0x90, 0x48, 0x30, 0x3e, 0x05, 0x80, 0x32, 0x7c, 0x8e,
-	0x25, 0xa1, 0x29, 0x08, 0xb7, 0x66, 0x11, 0xaa, 0xfe, 0x7a, 0x98, 0x64, 0xa1, 0x9c, 0x00, 0x9c,
-	0x6e, 0xd0, 0xd7, 0xad, 0x19, 0x77, 0x8e, 0x35, 0xfd, 0x8e, 0x51, 0xb6, 0xb6, 0xc1, 0x02, 0xef,
-	0x5b, 0x09, 0x34, 0x40, 0xf6, 0x9c, 0x74, 0x55, 0x0f, 0xc7, 0x87, 0xfc, 0xec, 0x3b, 0x38, 0x68,
-	0x13, 0x79, 0x9d, 0x23, 0x09, 0xac, 0x53, 0xb0, 0x72, 0x16, 0xe3, 0x88, 0xf1, 0x9e, 0x97, 0x46,
-	0x27, 0xd4, 0x63, 0x10, 0x02, 0xad, 0x89, 0x59, 0x53, 0xe5, 0x8a, 0x31, 0xfc, 0x19, 0xd0, 0x02,
-	0xea, 0x31, 0xd1, 0xd8, 0xe4, 0x77, 0x6f, 0x4f, 0x77, 0x51, 0x27, 0xd4, 0x43, 0x22, 0xc4, 0xfa,
-	0x4b, 0x16, 0x64, 0x4f, 0xa8, 0x07, 0xcb, 0x60, 0x09, 0xbb, 0x6e, 0x4c, 0x18, 0x53, 0x4c, 0x7d,
-	0x08, 0xd7, 0xc0, 0x62, 0x42, 0x5b, 0xbe, 0x23, 0xe9, 0x72, 0x48, 0x21, 0x2e, 0xec, 0xe2, 0x04,
-	0x8b, 0x1e, 0xa0, 0x80, 0xc4, 0x98, 0x7f, 0x42, 0x88, 0x52, 0xb7, 0xa3, 0x76, 0xd8, 0x20, 0xb1,
-	0xb8, 0xca, 0xb5, 0xea, 0xca, 0x65, 0x6a, 0xe6, 0x85, 0xfd, 0x99, 0x30, 0xa3, 0x51, 0x00, 0xdf,
-	0x03, 0x4b, 0xc9, 0x85, 0x2d, 0xd6, 0xb0, 0x20, 0xb6, 0x78, 0xf5, 0x32, 0x35, 0x57, 0x92, 0xe1,
-	0x32, 0x7f, 0x87, 0x59, 0x13, 0x2d, 0x26, 0x17, 0xfc, 0x7f, 0xb8, 0x0d, 0xf4, 0xe4, 0xc2, 0xf6,
-	0x23, 0x97, 0x5c, 0x88, 0x4b, 0x5c, 0xab, 0x96, 0x2e, 0x53, 0xd3, 0x18, 0x09, 0x3f, 0xe2, 0x3e,
-	0xb4, 0x94, 0x5c, 0x88, 0x01, 0x7c, 0x0f, 0x00, 0x39, 0x25, 0xa1, 0x20, 0xef, 0xe4, 0xe5, 0xcb,
-	0xd4, 0xcc, 0x09, 0xab, 0xe0, 0x1e, 0x0e, 0xa1, 0x05, 0x16, 0x24, 0xb7, 0x2e, 0xb8, 0x0b, 0x97,
-	0xa9, 0xa9, 0x07, 0xd4, 0x93, 0x9c, 0xd2, 0xc5, 0xb7, 0x2a, 0x26, 0x21, 0xed, 0x10, 0x57, 0x5c,
-	0x8c, 0x3a, 0xea, 0x43, 0xf8, 0x01, 0x58, 0x91, 0x5a, 0xfc, 0xec, 0x59, 0x82, 0xc3, 0x96, 0xfc,
-	0xda, 0xa8, 0xc2, 0xcb, 0xd4, 0x2c, 0x0a, 0xd7, 0x59, 0xdf, 0x83, 0x26, 0xb0, 0xf5, 0xc5, 0x3c,
-	0xd0, 0xcf, 0x2e, 0x10, 0x61, 0xed, 0x20, 0x81, 0x1f, 0x01, 0x43, 0x34, 0x9a, 0xd8, 0x49, 0xec,
-	0xb1, 0x73, 0xa9, 0xde, 0x1b, 0xde, 0x81, 0x93, 0x11, 0x16, 0x5a, 0xe9, 0x9b, 0xf6, 0xd5, 0xe1,
-	0x95, 0xc0, 0x42, 0x23, 0xa0, 0x34, 0x14, 0x65, 0x54, 0x40, 0x12, 0xc0, 0x17, 0x62, 0xcb, 0x45,
-	0x89, 0x64, 0x45, 0x13, 0xff, 0xa3, 0xe9, 0x12, 0x99, 0xa8, 0xb3, 0xea, 0x3d, 0xde, 0xc2, 0x5f,
-	0xa5, 0x66, 0x51, 0x6a, 0xab, 0x7c, 0xeb, 0xab, 0xef, 0xbe, 0x7e, 0x90, 0xe1, 0xa7, 0x23, 0x8a,
-	0xd1, 0x00, 0xd9, 0x98, 0x24, 0xe2, 0xd8, 0x0b, 0x88, 0x0f, 0xf9, 0xdb, 0x2a, 0x26, 0x1d, 0x12,
-	0x27, 0xc4, 0x15, 0xc7, 0xab, 0xa3, 0x01, 0xe6, 0xaf, 0x3e, 0x0f, 0x33, 0xbb, 0xcd, 0x88, 0x2b,
-	0xcf, 0x12, 0x2d, 0x79, 0x98, 0xfd, 0x81, 0x11, 0xf7, 0x89, 0xf6, 0xf9, 0x97, 0xe6, 0x9c, 0x85,
-	0x41, 0x5e, 0xf5, 0xf7, 0xed, 0x56, 0x40, 0x66, 0xd4, 0xe8, 0x2e, 0x28, 0xf0, 0xaf, 0x39, 0xec,
-	0x11, 0xfb, 0x9c, 0x74, 0x55, 0xa5, 0xca, 0xba, 0x53, 0xf6, 0xdf, 0x93, 0x2e, 0x43, 0xa3, 0x40,
-	0x49, 0x7c, 0xa9, 0x81, 0xfc, 0x59, 0x8c, 0x1d, 0xa2, 0xba, 0x75, 0x5e, 0xed, 0x1c, 0xc6, 0x4a,
-	0x42, 0x21, 0xae, 0xcd, 0x0f, 0x95, 0xb6, 0x13, 0xf5, 0x44, 0xf6, 0x21, 0xcf, 0x88, 0x09, 0xb9,
-	0x20, 0x8e, 0xd8, 0x4b, 0x0d, 0x29, 0x04, 0xf7, 0xc0, 0xb2, 0xeb, 0x33, 0xdc, 0x08, 0xc4, 0xc7,
-	0xab, 0x73, 0x2e, 0x97, 0x5f, 0x35, 0x2e, 0x53, 0xb3, 0xa0, 0x1c, 0x75, 0x6e, 0x47, 0x63, 0x88,
-	0xd7, 0xd0, 0x30, 0x4d, 0xcc, 0x56, 0xec, 0x8d, 0x2e, 0x6b, 0x68, 0x10, 0x2a, 0x3c, 0x68, 0x02,
-	0xcb, 0x1b, 0xa3, 0xd1, 0xf6, 0x44, 0xf9, 0xea, 0x48, 0x02, 0x6e, 0x0d, 0xfc, 0xd0, 0x4f, 0x44,
-	0xb9, 0x2e, 0x20, 0x09, 0xe0, 0x07, 0x20, 0x47, 0x3b, 0x24, 0x8e, 0x7d, 0x97, 0x30, 0x51, 0xa6,
-	0xf9, 0xdd, 0x77, 0xa6, 0xcb, 0x60, 0xe4, 0x4b, 0x06, 0x0d, 0xe3, 0xf9, 0xe2, 0x48, 0x24, 0x26,
-	0x19, 0x92, 0x90, 0xc6, 0x5d, 0xd1, 0x5a, 0xa9, 0xc5, 0x49, 0xc7, 0x53, 0x61, 0x47, 0x63, 0x08,
-	0x56, 0x01, 0x54, 0x69, 0x31, 0x49, 0xda, 0x71, 0x64, 0x8b, 0x37, 0x48, 0x41, 0xe4, 0x8a, 0xe7,
-	0x58, 0x7a, 0x91, 0x70, 0x1e, 0xe2, 0x04, 0xa3, 0x29, 0x0b, 0xfc, 0x35, 0x80, 0xf2, 0x4c, 0xec,
-	0xcf, 0x18, 0x8d, 0xf8, 0xf7, 0xd8, 0x4b, 0xdf, 0x53, 0xbd, 0x91, 0xd0, 0x97, 0x5e, 0x35, 0x67,
-	0x43, 0xa2, 0x63, 0x46, 0xd5, 0x2a, 0x8e, 0x35, 0x5d, 0x33, 0x16, 0x8e, 0x35, 0x7d, 0xc9, 0xd0,
-	0x07, 0xfb, 0xa7, 0x56, 0x81, 0x56, 0xfb, 0x78, 0x64, 0x7a, 0xd6, 0x33, 0x00, 0x4e, 0x63, 0xe2,
-	0xf3, 0x0e, 0x36, 0x08, 0xf8, 0x6b, 0x2f, 0xc2, 0x21, 0xe9, 0xbf, 0x6f, 0xf9, 0x78, 0xb4, 0x30,
-	0xe7, 0xc7, 0x0b, 0x13, 0x02, 0xcd, 0xa1, 0x2e, 0x11, 0xa5, 0x91, 0x43, 0x62, 0x6c, 0xfd, 0x35,
-	0x03, 0xf2, 0xb5, 0x4e, 0x78, 0x40, 0xfd, 0xe8, 0x28, 0x7a, 0x49, 0x87, 0xd7, 0x7c, 0x66, 0xf4,
-	0x9a, 0x9f, 0xfe, 0xc2, 0x9f, 0xbf, 0xe6, 0x0b, 0x1f, 0xbe, 0x2b, 0xaa, 0xac, 0x15, 0xe0, 0xae,
-	0x8a, 0x92, 0x4a, 0x05, 0x65, 0x3c, 0x9c, 0x6a, 0x19, 0xf8, 0xb3, 0xb9, 0x3c, 0x6c, 0x19, 0x1e,
-	0xfc, 0x33, 0x03, 0x46, 0x3e, 0xa2, 0xe1, 0xaf, 0x40, 0x65, 0xff, 0xe0, 0xa0, 0x56, 0xaf, 0xdb,
-	0x67, 0x9f, 0x9c, 0xd6, 0xec, 0xd3, 0x1a, 0x7a, 0x7a, 0x54, 0xaf, 0x1f, 0x3d, 0x7f, 0x76, 0x52,
-	0xab, 0xd7, 0x8d, 0xb9, 0xca, 0xfd, 0xd7, 0x6f, 0x36, 0xca, 0xc3, 0xf8, 0x53, 0x12, 0x87, 0x3e,
-	0x63, 0x3e, 0x8d, 0x02, 0xbe, 0xdc, 0xf7, 0xc1, 0xda, 0x68, 0x36, 0xaa, 0xd5, 0xcf, 0xd0, 0xd1,
-	0xc1, 0x59, 0xed, 0xd0, 0xc8, 0x54, 0xca, 0xaf, 0xdf, 0x6c, 0x94, 0x86, 0x99, 0x88, 0xb0, 0x24,
-	0xf6, 0x1d, 0xfe, 0x1e, 0x78, 0x0c, 0xca, 0xd7, 0x6b, 0xd6, 0x0e, 0x8d, 0xf9, 0x4a, 0xe5, 0xf5,
-	0x9b, 0x8d, 0xb5, 0xeb, 0x14, 0x89, 0x5b, 0xd1, 0x3e, 0xff, 0xc7, 0xfa, 0x5c, 0xf5, 0xc9, 0x37,
-	0xbd, 0xf5, 0xcc, 0xb7, 0xbd, 0xf5, 0xcc, 0x7f, 0x7b, 0xeb, 0x99, 0x2f, 0xde, 0xae, 0xcf, 0x7d,
-	0xfb, 0x76, 0x7d, 0xee, 0x5f, 0x6f, 0xd7, 0xe7, 0xfe, 0xb8, 0xe1, 0xf9, 0x49, 0xb3, 0xdd, 0xd8,
-	0x72, 0x68, 0xb8, 0x3d, 0xf9, 0xcb, 0x4b, 0xd2, 0x6d, 0x11, 0xd6, 0x58, 0x14, 0x3f, 0xbe, 0x3d,
-	0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x48, 0x59, 0x0f, 0x70, 0xd5, 0x13, 0x00, 0x00,
+	0x19, 0x16, 0x25, 0x4a, 0x22, 0x87, 0x14, 0xb5, 0x1e, 0xd1, 0x32, 0x4d, 0x39, 0x5a, 0x75, 0x93,
+	0x16, 0xaa, 0x91, 0x4a, 0x96, 0x1c, 0xb5, 0x86, 0xd3, 0xb4, 0x10, 0x25, 0xba, 0x95, 0x2a, 0xdb,
+	0xc2, 0x50, 0x8d, 0x91, 0x20, 0xc5, 0x62, 0xb8, 0x3b, 0x5a, 0x6e, 0xb4, 0xbb, 0x43, 0xec, 0x0c,
+	0x69, 0xb2, 0x7f, 0xa0, 0x81, 0x7b, 0x49, 0x7e, 0x80, 0x81, 0x00, 0xbd, 0xe4, 0x98, 0x9f, 0xd0,
+	0x63, 0x8e, 0x39, 0x16, 0x01, 0xba, 0x28, 0xe4, 0x43, 0x00, 0xf5, 0xa6, 0x5f, 0x50, 0xcc, 0x07,
+	0xbf, 0x65, 0x55, 0x01, 0x08, 0x69, 0xde, 0xaf, 0xe7, 0x99, 0x77, 0xe6, 0x9d, 0xaf, 0x05, 0x65,
+	0x87, 0xb2, 0x90, 0xb2, 0x4d, 0xd2, 0x0e, 0x37, 0xc5, 0x6f, 0x4b, 0xb4, 0x36, 0x9a, 0x31, 0xe5,
+	0x14, 0x1a, 0xca, 0xb6, 0x21, 0x34, 0xe2, 0xb7, 0x55, 0xbe, 0x85, 0x43, 0x3f, 0xa2, 0x9b, 0xf2,
+	0xaf, 0x72, 0x2a, 0x17, 0x3d, 0xea, 0x51, 0xd9, 0xdc, 0x14, 0x2d, 0xa5, 0xb5, 0xbe, 0x9a, 0x05,
+	0x73, 0xc7, 0x38, 0xc6, 0x21, 0x83, 0x5b, 0x20, 0x4b, 0xda, 0xa1, 0xed, 0x92, 0x88, 0x86, 0xa5,
+	0xd4, 0x5a, 0x6a, 0x3d, 0x5b, 0x29, 0x5e, 0x26, 0xa6, 0xd1, 0xc5, 0x61, 0xf0, 0xd8, 0xea, 0x9b,
+	0x2c, 0x94, 0x21, 0xed, 0x70, 0x5f, 0x34, 0xe1, 0x2e, 0x00, 0xa4, 0xc3, 0x63, 0x6c, 0x13, 0xbf,
+	0xc9, 0x4a, 0xe9, 0xb5, 0x99, 0xf5, 0x99, 0x8a, 0x75, 0x9e, 0x98, 0xd9, 0xaa, 0xd0, 0x56, 0x0f,
+	0x8e, 0xd9, 0x65, 0x62, 0xde, 0xd2, 0x00, 0x7d, 0x47, 0x0b, 0x65, 0xa5, 0x50, 0xf5, 0x9b, 0x0c,
+	0x6e, 0x83, 0xbc, 0x80, 0x76, 0x1a, 0x38, 0x8a, 0x48, 0xc0, 0x4a, 0xf3, 0x6b, 0x33, 0xeb, 0xd9,
+	0xca, 0xe2, 0x79, 0x62, 0xe6, 0xaa, 0x1f, 0x3f, 0xdd, 0xd3, 0x6a, 0x94, 0x23, 0xed, 0xb0, 0x27,
+	0xc0, 0xbf, 0x80, 0x02, 0x76, 0x1c, 0xc2, 0x98, 0xed, 0xd0, 0x88, 0xc7, 0x34, 0x28, 0x65, 0xd6,
+	0x52, 0xeb, 0xb9, 0x6d, 0x73, 0x63, 0x7c, 0x20, 0x36, 0x76, 0xa5, 0xdf, 0x9e, 0x72, 0xab, 0xdc,
+	0xfe, 0x2e, 0x31, 0xa7, 0xce, 0x13, 0x73, 0x61, 0x44, 0x8d, 0x16, 0xf0, 0xb0, 0x08, 0x1f, 0x83,
+	0xbb, 0xd8, 0xe1, 0x7e, 0x9b, 0xd8, 0x8c, 0x63, 0xee, 0x3b, 0x76, 0x33, 0x26, 0x0e, 0x0d, 0x9b,
+	0x7e, 0x40, 0x58, 0x29, 0x2b, 0xfa, 0x87, 0xee, 0x28, 0x87, 0x9a, 0xb4, 0x1f, 0x0f, 0xcc, 0xf0,
+	0x01, 0x28, 0x36, 0x7c, 0xc6, 0x69, 0xdc, 0xb5, 0x19, 0x89, 0xdb, 0xc4, 0x7e, 0xe9, 0x47, 0x2e,
+	0x7d, 0x59, 0x02, 0x6b, 0xa9, 0xf5, 0x34, 0x82, 0xda, 0x56, 0x13, 0xa6, 0x17, 0xd2, 0x02, 0x3f,
+	0x03, 0xcb, 0xa4, 0xc3, 0x49, 0xe4, 0x12, 0x57, 0x0d, 0xb0, 0x4d, 0x9b, 0xdc, 0xa7, 0x11, 0x2b,
+	0xe5, 0x64, 0x52, 0xbf, 0x98, 0x4c, 0xaa, 0xaa, 0xfd, 0xe5, 0x24, 0x3c, 0x57, 0xde, 0xa8, 0x48,
+	0xae, 0xd0, 0xc2, 0x17, 0xc0, 0x38, 0x25, 0xc4, 0x76, 0x7d, 0xc6, 0x63, 0xbf, 0xde, 0x12, 0xca,
+	0x52, 0x5e, 0xe2, 0xfe, 0x6c, 0x12, 0xf7, 0x09, 0x21, 0xfb, 0x43, 0x8e, 0x95, 0xac, 0x18, 0xae,
+	0x6f, 0x7e, 0xfc, 0xf6, 0x7e, 0x0a, 0x2d, 0x9e, 0x8e, 0xda, 0xe0, 0x7b, 0xa0, 0x10, 0xe2, 0x8e,
+	0x5d, 0xc7, 0xdc, 0x69, 0xd8, 0x21, 0xf3, 0x58, 0x69, 0x41, 0xa6, 0x98, 0x0f, 0x71, 0xa7, 0x22,
+	0x94, 0x4f, 0x99, 0xc7, 0x1e, 0xaf, 0xbc, 0xfa, 0xf1, 0xdb, 0xfb, 0xcb, 0x43, 0xa5, 0xdb, 0x11,
+	0xc5, 0xab, 0x0a, 0xee, 0x30, 0x9d, 0x99, 0x36, 0x66, 0x0e, 0xd3, 0x99, 0x19, 0x23, 0x7d, 0x98,
+	0xce, 0xcc, 0x1a, 0x73, 0x87, 0xe9, 0xcc, 0x9c, 0x31, 0x6f, 0xfd, 0x37, 0x05, 0x16, 0xc7, 0xba,
+	0x02, 0x5f, 0x80, 0xa5, 0x3a, 0x66, 0xc4, 0x16, 0xc9, 0xd4, 0x5b, 0x71, 0x64, 0xc7, 0x98, 0xfb,
+	0x54, 0x97, 0xe9, 0xba, 0xe8, 0xe7, 0x0f, 0x89, 0xb9, 0xa2, 0x88, 0x98, 0x7b, 0xb6, 0xe1, 0xd3,
+	0xcd, 0x10, 0xf3, 0xc6, 0xc6, 0x11, 0xf1, 0xb0, 0xd3, 0xdd, 0x27, 0x8e, 0x4a, 0xc3, 0x10, 0x20,
+	0x4f, 0x08, 0xa9, 0xb4, 0xe2, 0x08, 0x09, 0x04, 0xf8, 0x29, 0x28, 0x3a, 0x34, 0x0c, 0x5b, 0x91,
+	0xcf, 0xbb, 0x76, 0x93, 0xd2, 0x40, 0x23, 0x4f, 0xff, 0x44, 0x64, 0xd8, 0x47, 0x39, 0xa6, 0x34,
+	0x50, 0xd8, 0xef, 0x82, 0x85, 0x66, 0x4c, 0x9b, 0x94, 0x91, 0xd8, 0xe6, 0x62, 0x85, 0xcc, 0xac,
+	0xa5, 0xd6, 0x33, 0x28, 0xdf, 0x53, 0x9e, 0x88, 0xc5, 0xf0, 0x11, 0x28, 0x5e, 0x35, 0x9f, 0xf0,
+	0xe7, 0xa0, 0x30, 0x5a, 0x17, 0x2a, 0x59, 0xb4, 0x30, 0x32, 0xcf, 0xd6, 0x57, 0x29, 0x30, 0x5a,
+	0xcd, 0x70, 0x17, 0xcc, 0x39, 0x31, 0xc1, 0x9c, 0xc8, 0x80, 0xdc, 0xf6, 0xbb, 0xff, 0x67, 0x55,
+	0x9c, 0x74, 0x9b, 0xa4, 0x92, 0x16, 0x89, 0x22, 0x1d, 0x08, 0x3f, 0x02, 0x69, 0x07, 0x07, 0x41,
+	0x69, 0xfa, 0xa7, 0x02, 0xc8, 0x30, 0xeb, 0xdf, 0x29, 0x70, 0x6b, 0xc2, 0x03, 0x3a, 0x20, 0xa7,
+	0x57, 0x2d, 0xef, 0x36, 0x55, 0xe7, 0x0a, 0xdb, 0xf7, 0xde, 0x86, 0x2d, 0x41, 0xdf, 0x3b, 0x4f,
+	0x4c, 0x30, 0x90, 0x2f, 0x13, 0x13, 0xaa, 0xcd, 0x64, 0x08, 0xc8, 0x42, 0x00, 0xf7, 0x3d, 0xa0,
+	0x03, 0x96, 0x46, 0xb7, 0x06, 0x3b, 0xf0, 0x19, 0x2f, 0x4d, 0xcb, 0x5d, 0xe5, 0xe1, 0x79, 0x62,
+	0x8e, 0x76, 0xec, 0xc8, 0x67, 0xfc, 0x32, 0x31, 0xcb, 0x23, 0xa8, 0xc3, 0x91, 0x16, 0xba, 0x85,
+	0xc7, 0x03, 0xac, 0x6f, 0x0c, 0x90, 0xdb, 0x6b, 0x60, 0x3f, 0xda, 0xa3, 0xd1, 0xa9, 0xef, 0xc1,
+	0xcf, 0xc0, 0x62, 0x83, 0x86, 0x84, 0x71, 0x82, 0x5d, 0xbb, 0x1e, 0x50, 0xe7, 0x4c, 0x17, 0xe6,
+	0xc3, 0x1f, 0x12, 0xf3, 0xf6, 0x64, 0xe9, 0x1c, 0x44, 0x82, 0x74, 0x59, 0x91, 0x8e, 0x45, 0x5a,
+	0xa8, 0xd0, 0xd7, 0x54, 0x84, 0x02, 0x36, 0x40, 0xc1, 0xc5, 0xd4, 0x3e, 0xa5, 0xf1, 0x99, 0x06,
+	0x57, 0xb5, 0x59, 0x79, 0x2b, 0xf8, 0x79, 0x62, 0xe6, 0xf7, 0x77, 0x9f, 0x3f, 0xa1, 0xf1, 0x99,
+	0x84, 0xb8, 0x4c, 0xcc, 0xdb, 0x8a, 0x6c, 0x14, 0xc8, 0x42, 0x79, 0x17, 0xd3, 0xbe, 0x9b, 0xd8,
+	0x2c, 0xfa, 0x0e, 0xac, 0xd5, 0x6c, 0xd2, 0x98, 0xab, 0x92, 0xad, 0xfc, 0xea, 0x3c, 0x31, 0x0b,
+	0x1a, 0xb2, 0xa6, 0x2c, 0x97, 0x89, 0x79, 0x67, 0x0c, 0x54, 0xc7, 0x58, 0xa8, 0xa0, 0x61, 0xb5,
+	0x2b, 0xac, 0x83, 0x3c, 0xf1, 0x9b, 0x5b, 0x3b, 0x0f, 0x74, 0x02, 0x69, 0x99, 0xc0, 0xef, 0xaf,
+	0x4b, 0x20, 0x57, 0x3d, 0x38, 0xde, 0xda, 0x79, 0xd0, 0xeb, 0xff, 0x92, 0xa2, 0x1a, 0x46, 0xb1,
+	0x50, 0x4e, 0x89, 0xaa, 0xf3, 0x3d, 0x8e, 0x1d, 0xcd, 0x31, 0x77, 0x53, 0x8e, 0x9d, 0xab, 0x38,
+	0x76, 0x46, 0x39, 0x76, 0x46, 0x39, 0x1e, 0x69, 0x8e, 0xf9, 0x9b, 0x72, 0x3c, 0xba, 0x8a, 0xe3,
+	0xd1, 0x28, 0x87, 0xf2, 0x11, 0xc5, 0x54, 0xef, 0xfe, 0x15, 0x47, 0xdc, 0x6f, 0x85, 0x9a, 0x26,
+	0x73, 0xe3, 0x62, 0x1a, 0x8b, 0xb4, 0x50, 0xa1, 0xaf, 0x51, 0xe8, 0x67, 0x62, 0xbb, 0x8b, 0x18,
+	0x17, 0xba, 0x88, 0x36, 0x03, 0xa2, 0x29, 0xb2, 0x92, 0xe2, 0xd1, 0x75, 0x14, 0x2b, 0x8a, 0xe2,
+	0xaa, 0x70, 0x0b, 0x2d, 0x8d, 0xaa, 0x15, 0x99, 0x0d, 0x8c, 0x26, 0xe1, 0x24, 0x66, 0xf5, 0x56,
+	0xec, 0x69, 0x22, 0x20, 0x89, 0x3e, 0xb8, 0x8e, 0x48, 0x97, 0xd5, 0x78, 0xa8, 0x85, 0x16, 0x07,
+	0x2a, 0x45, 0xf0, 0x09, 0x28, 0xf8, 0x82, 0xb5, 0xde, 0x0a, 0x34, 0x7c, 0x4e, 0xc2, 0x6f, 0x5f,
+	0x07, 0xaf, 0x97, 0xc2, 0x68, 0xa0, 0x85, 0x16, 0x7a, 0x0a, 0x05, 0xed, 0x02, 0x18, 0xb6, 0xfc,
+	0xd8, 0xf6, 0x02, 0xec, 0xf8, 0x24, 0xd6, 0xf0, 0x79, 0x09, 0xff, 0xeb, 0xeb, 0xe0, 0xef, 0x2a,
+	0xf8, 0xc9, 0x60, 0x0b, 0x19, 0x42, 0xf9, 0x07, 0xa5, 0x53, 0x2c, 0x35, 0x90, 0xaf, 0x93, 0x38,
+	0xf0, 0x23, 0x8d, 0xbf, 0x20, 0xf1, 0x1f, 0x5c, 0x87, 0xaf, 0x2b, 0x68, 0x38, 0xcc, 0x42, 0x39,
+	0x25, 0xf6, 0x41, 0x03, 0x1a, 0xb9, 0xb4, 0x07, 0x7a, 0xeb, 0xc6, 0xa0, 0xc3, 0x61, 0x16, 0xca,
+	0x29, 0x51, 0x81, 0x7a, 0x60, 0x09, 0xc7, 0x31, 0x7d, 0x39, 0x36, 0x20, 0x50, 0x62, 0xff, 0xe6,
+	0x3a, 0xec, 0xde, 0xe6, 0x3a, 0x19, 0x2d, 0x36, 0x57, 0xa1, 0x1d, 0x19, 0x12, 0x17, 0x40, 0x2f,
+	0xc6, 0xdd, 0x31, 0x9e, 0xe2, 0x8d, 0x07, 0x7e, 0x32, 0xd8, 0x42, 0x86, 0x50, 0x8e, 0xb0, 0x7c,
+	0x0e, 0x8a, 0x21, 0x89, 0x3d, 0x62, 0x47, 0x84, 0xb3, 0x66, 0xe0, 0x73, 0xcd, 0x73, 0xfb, 0xc6,
+	0xeb, 0xe0, 0xaa, 0x70, 0x0b, 0x41, 0xa9, 0x7e, 0xa6, 0xb5, 0x8a, 0xeb, 0x2e, 0xc8, 0x38, 0xe2,
+	0xb4, 0xb0, 0x7d, 0xb7, 0x54, 0x92, 0x97, 0xa4, 0x79, 0x29, 0x1f, 0xb8, 0xb0, 0x08, 0x66, 0xd5,
+	0xd9, 0x7e, 0x57, 0x9e, 0xed, 0x4a, 0x80, 0x65, 0x90, 0x71, 0x89, 0xe3, 0x87, 0x38, 0x60, 0xa5,
+	0xb2, 0x0c, 0xe8, 0xcb, 0xf0, 0x63, 0xb0, 0xc0, 0x1a, 0x38, 0xf2, 0x1a, 0xd8, 0xb7, 0xb9, 0x1f,
+	0x92, 0xd2, 0x8a, 0xec, 0xf1, 0xd6, 0x75, 0x3d, 0x2e, 0xaa, 0x1e, 0x8f, 0xc4, 0x59, 0x28, 0xdf,
+	0x93, 0x4f, 0xfc, 0x90, 0xc0, 0x63, 0x90, 0x73, 0x70, 0xe4, 0xb4, 0x22, 0x85, 0x7a, 0x4f, 0xa2,
+	0x6e, 0x5e, 0x87, 0xaa, 0x8f, 0xe2, 0xa1, 0x28, 0x0b, 0x01, 0x25, 0xf5, 0x10, 0x9b, 0x31, 0xf6,
+	0x5a, 0x44, 0x21, 0xbe, 0x73, 0x63, 0xc4, 0xa1, 0x28, 0x0b, 0x01, 0x25, 0xf5, 0x10, 0xdb, 0x24,
+	0x3e, 0x0b, 0x34, 0xe2, 0xea, 0x8d, 0x11, 0x87, 0xa2, 0x2c, 0x04, 0x94, 0x24, 0x11, 0x9f, 0x02,
+	0x40, 0x19, 0x3e, 0xc3, 0x0a, 0xd0, 0x94, 0x80, 0x1b, 0xd7, 0x01, 0xea, 0xc7, 0xcc, 0x20, 0xc8,
+	0x42, 0x59, 0x29, 0x08, 0xb8, 0xfe, 0x2d, 0x76, 0xd9, 0xb8, 0x73, 0x98, 0xce, 0xdc, 0x31, 0x4a,
+	0xd6, 0x26, 0x98, 0x15, 0x8f, 0x04, 0x02, 0x0d, 0x30, 0x73, 0x46, 0xba, 0xfa, 0x0e, 0x27, 0x9a,
+	0x62, 0xee, 0xdb, 0x38, 0x68, 0x11, 0x75, 0x9c, 0x23, 0x25, 0x58, 0xc7, 0x60, 0xf1, 0x24, 0xc6,
+	0x11, 0x13, 0x0f, 0x0c, 0x1a, 0x1d, 0x51, 0x8f, 0x41, 0x08, 0xd2, 0x0d, 0xcc, 0x1a, 0x3a, 0x56,
+	0xb6, 0xe1, 0x2f, 0x41, 0x3a, 0xa0, 0x1e, 0x93, 0x17, 0x9b, 0xdc, 0xf6, 0xed, 0xc9, 0x5b, 0xd4,
+	0x11, 0xf5, 0x90, 0x74, 0xb1, 0xfe, 0x36, 0x03, 0x66, 0x8e, 0xa8, 0x07, 0x4b, 0x60, 0x1e, 0xbb,
+	0x6e, 0x4c, 0x18, 0xd3, 0x48, 0x3d, 0x11, 0x2e, 0x83, 0x39, 0x4e, 0x9b, 0xbe, 0xa3, 0xe0, 0xb2,
+	0x48, 0x4b, 0x82, 0xd8, 0xc5, 0x1c, 0xcb, 0x3b, 0x40, 0x1e, 0xc9, 0xb6, 0x78, 0xaf, 0xc9, 0x52,
+	0xb7, 0xa3, 0x56, 0x58, 0x27, 0xb1, 0x3c, 0xca, 0xd3, 0x95, 0xc5, 0x8b, 0xc4, 0xcc, 0x49, 0xfd,
+	0x33, 0xa9, 0x46, 0xc3, 0x02, 0x7c, 0x1f, 0xcc, 0xf3, 0x8e, 0x2d, 0x73, 0x98, 0x95, 0x43, 0xbc,
+	0x74, 0x91, 0x98, 0x8b, 0x7c, 0x90, 0xe6, 0x1f, 0x31, 0x6b, 0xa0, 0x39, 0xde, 0x11, 0xff, 0xe1,
+	0x26, 0xc8, 0xf0, 0x8e, 0xed, 0x47, 0x2e, 0xe9, 0xc8, 0x43, 0x3c, 0x5d, 0x29, 0x5e, 0x24, 0xa6,
+	0x31, 0xe4, 0x7e, 0x20, 0x6c, 0x68, 0x9e, 0x77, 0x64, 0x03, 0xbe, 0x0f, 0x80, 0xea, 0x92, 0x64,
+	0x50, 0x67, 0xf2, 0xc2, 0x45, 0x62, 0x66, 0xa5, 0x56, 0x62, 0x0f, 0x9a, 0xd0, 0x02, 0xb3, 0x0a,
+	0x3b, 0x23, 0xb1, 0xf3, 0x17, 0x89, 0x99, 0x09, 0xa8, 0xa7, 0x30, 0x95, 0x49, 0x0c, 0x55, 0x4c,
+	0x42, 0xda, 0x26, 0xae, 0x3c, 0x18, 0x33, 0xa8, 0x27, 0xc2, 0x0f, 0xc1, 0xa2, 0xe2, 0x12, 0x73,
+	0xcf, 0x38, 0x0e, 0x9b, 0xea, 0x69, 0x57, 0x81, 0x17, 0x89, 0x59, 0x90, 0xa6, 0x93, 0x9e, 0x05,
+	0x8d, 0xc9, 0xd6, 0x97, 0xd3, 0x20, 0x73, 0xd2, 0x41, 0x84, 0xb5, 0x02, 0x0e, 0x9f, 0x00, 0x43,
+	0x5e, 0x34, 0xb1, 0xc3, 0xed, 0x91, 0x79, 0xa9, 0xac, 0x0c, 0xce, 0xc0, 0x71, 0x0f, 0x0b, 0x2d,
+	0xf6, 0x54, 0xbb, 0x7a, 0xf2, 0x8a, 0x60, 0xb6, 0x1e, 0x50, 0x1a, 0xca, 0x32, 0xca, 0x23, 0x25,
+	0xc0, 0x17, 0x72, 0xc8, 0x65, 0x89, 0xcc, 0xbc, 0xed, 0xb9, 0x37, 0x56, 0x67, 0x95, 0x15, 0x71,
+	0x85, 0xbf, 0x4c, 0xcc, 0x82, 0xe2, 0xd6, 0xf1, 0x96, 0x7a, 0xdf, 0xcc, 0xf1, 0x8e, 0x2c, 0x46,
+	0x03, 0xcc, 0xc4, 0x84, 0xcb, 0x69, 0xcf, 0x23, 0xd1, 0x14, 0xbb, 0x55, 0x4c, 0xda, 0x24, 0xe6,
+	0xc4, 0x95, 0xd3, 0x9b, 0x41, 0x7d, 0x59, 0x6c, 0x7d, 0x1e, 0x66, 0x76, 0x8b, 0x11, 0x57, 0xcd,
+	0x25, 0x9a, 0xf7, 0x30, 0xfb, 0x33, 0x23, 0xee, 0xe3, 0xf4, 0x17, 0x5f, 0x9b, 0x53, 0x16, 0x06,
+	0x39, 0x7d, 0xbf, 0x6f, 0x35, 0x03, 0x72, 0x4d, 0x8d, 0x6e, 0x83, 0xbc, 0x78, 0x3a, 0x63, 0x8f,
+	0xd8, 0x67, 0xa4, 0xab, 0x2b, 0x55, 0xd5, 0x9d, 0xd6, 0xff, 0x89, 0x74, 0x19, 0x1a, 0x16, 0x34,
+	0xc5, 0xd7, 0x69, 0x90, 0x3b, 0x89, 0xb1, 0x43, 0xf4, 0x6d, 0x5d, 0x54, 0xbb, 0x10, 0x63, 0x4d,
+	0xa1, 0x25, 0xc1, 0x2d, 0x26, 0x95, 0xb6, 0xb8, 0x5e, 0x91, 0x3d, 0x51, 0x44, 0xc4, 0x84, 0x74,
+	0x88, 0x23, 0xc7, 0x32, 0x8d, 0xb4, 0x04, 0x77, 0xc0, 0x82, 0xeb, 0x33, 0x5c, 0x0f, 0xe4, 0x97,
+	0x02, 0xe7, 0x4c, 0xa5, 0x5f, 0x31, 0x2e, 0x12, 0x33, 0xaf, 0x0d, 0x35, 0xa1, 0x47, 0x23, 0x92,
+	0xa8, 0xa1, 0x41, 0x98, 0xec, 0xad, 0x1c, 0x9b, 0x8c, 0xaa, 0xa1, 0xbe, 0xab, 0xb4, 0xa0, 0x31,
+	0x59, 0x9d, 0x18, 0xf5, 0x96, 0x27, 0xcb, 0x37, 0x83, 0x94, 0x20, 0xb4, 0x81, 0x1f, 0xfa, 0x5c,
+	0x96, 0xeb, 0x2c, 0x52, 0x02, 0xfc, 0x10, 0x64, 0x69, 0x9b, 0xc4, 0xb1, 0xef, 0x12, 0x26, 0xcb,
+	0x34, 0xb7, 0xfd, 0xce, 0x64, 0x19, 0x0c, 0xbd, 0x64, 0xd0, 0xc0, 0x5f, 0x24, 0x47, 0x22, 0xd9,
+	0xc9, 0x90, 0x84, 0x34, 0xee, 0x96, 0x72, 0x83, 0xe4, 0x94, 0xe1, 0xa9, 0xd4, 0xa3, 0x11, 0x09,
+	0x56, 0x00, 0xd4, 0x61, 0x31, 0xe1, 0xe2, 0xa1, 0x2e, 0x77, 0x90, 0xbc, 0x8c, 0x95, 0xeb, 0x58,
+	0x59, 0x91, 0x34, 0xee, 0x63, 0x8e, 0xd1, 0x84, 0x06, 0xfe, 0x0e, 0x40, 0x35, 0x27, 0xf6, 0xe7,
+	0x8c, 0x46, 0xe2, 0x3d, 0x76, 0xea, 0x7b, 0xfa, 0x6e, 0x24, 0xf9, 0x95, 0x55, 0xf7, 0xd9, 0x50,
+	0xd2, 0x21, 0xa3, 0x3a, 0x8b, 0xc3, 0x74, 0x26, 0x6d, 0xcc, 0x1e, 0xa6, 0x33, 0xf3, 0x46, 0xa6,
+	0x3f, 0x7e, 0x3a, 0x0b, 0xb4, 0xd4, 0x93, 0x87, 0xba, 0x67, 0x3d, 0x03, 0xe0, 0x38, 0x26, 0xbe,
+	0xb8, 0xc1, 0x06, 0x81, 0xd8, 0xf6, 0x22, 0x1c, 0x92, 0xde, 0x7e, 0x2b, 0xda, 0xc3, 0x85, 0x39,
+	0x3d, 0x5a, 0x98, 0x10, 0xa4, 0x1d, 0xea, 0x12, 0x59, 0x1a, 0x59, 0x24, 0xdb, 0xd6, 0xdf, 0x53,
+	0x20, 0x57, 0x6d, 0x87, 0x7b, 0xd4, 0x8f, 0x0e, 0xa2, 0x53, 0x3a, 0x38, 0xe6, 0x53, 0xc3, 0xc7,
+	0xfc, 0xe4, 0x0b, 0x7f, 0xfa, 0x8a, 0x17, 0xbe, 0xf8, 0x8a, 0xe0, 0xfa, 0xac, 0x19, 0xe0, 0xae,
+	0xf6, 0x52, 0x4c, 0x79, 0xad, 0xdc, 0x9f, 0xb8, 0x32, 0x88, 0xb5, 0xb9, 0x30, 0xb8, 0x32, 0xdc,
+	0xff, 0x67, 0x0a, 0x0c, 0x3d, 0xa2, 0xe1, 0x6f, 0x41, 0x79, 0x77, 0x6f, 0xaf, 0x5a, 0xab, 0xd9,
+	0x27, 0x9f, 0x1c, 0x57, 0xed, 0xe3, 0x2a, 0x7a, 0x7a, 0x50, 0xab, 0x1d, 0x3c, 0x7f, 0x76, 0x54,
+	0xad, 0xd5, 0x8c, 0xa9, 0xf2, 0xbd, 0x57, 0xaf, 0xd7, 0x4a, 0x03, 0xff, 0x63, 0x12, 0x87, 0x3e,
+	0x63, 0x3e, 0x8d, 0x02, 0x91, 0xee, 0x07, 0x60, 0x79, 0x38, 0x1a, 0x55, 0x6b, 0x27, 0xe8, 0x60,
+	0xef, 0xa4, 0xba, 0x6f, 0xa4, 0xca, 0xa5, 0x57, 0xaf, 0xd7, 0x8a, 0x83, 0x48, 0x44, 0xc4, 0x07,
+	0x1c, 0x47, 0xec, 0x03, 0x8f, 0x40, 0xe9, 0x6a, 0xce, 0xea, 0xbe, 0x31, 0x5d, 0x2e, 0xbf, 0x7a,
+	0xbd, 0xb6, 0x7c, 0x15, 0x23, 0x71, 0xcb, 0xe9, 0x2f, 0xfe, 0xb1, 0x3a, 0x55, 0x79, 0xfc, 0xdd,
+	0xf9, 0x6a, 0xea, 0xfb, 0xf3, 0xd5, 0xd4, 0x7f, 0xce, 0x57, 0x53, 0x5f, 0xbe, 0x59, 0x9d, 0xfa,
+	0xfe, 0xcd, 0xea, 0xd4, 0xbf, 0xde, 0xac, 0x4e, 0x7d, 0xba, 0xe6, 0xf9, 0xbc, 0xd1, 0xaa, 0x6f,
+	0x38, 0x34, 0xdc, 0x1c, 0xff, 0xce, 0x24, 0x3e, 0x0f, 0xb0, 0xfa, 0x9c, 0xfc, 0xd2, 0xf9, 0xf0,
+	0x7f, 0x03, 0x00, 0xb4, 0x12, 0x00, 0x0d, 0x42, 0x15, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1226,6 +1314,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchMsgs != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxBatchMsgs))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.FeeDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	if m.ExtendedDenomOptions != nil {
 		{
 			size, err := m.ExtendedDenomOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -1272,21 +1375,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ExtraEIPs) > 0 {
-		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.ExtraEIPs)*10)
+		var j4 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintEvm(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintEvm(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1300,6 +1403,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposerTips {
+		i--
+		if m.ProposerTips {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.CommunityPoolRatio.Size()
+		i -= size
+		if _, err := m.CommunityPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ExtendedDenomOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2261,6 +2417,27 @@ func (m *Params) Size() (n int) {
 		l = m.ExtendedDenomOptions.Size()
 		n += 1 + l + sovEvm(uint64(l))
 	}
+	l = m.FeeDistribution.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.MaxBatchMsgs != 0 {
+		n += 1 + sovEvm(uint64(m.MaxBatchMsgs))
+	}
+	return n
+}
+
+func (m *FeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFeeBurnRatio.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.CommunityPoolRatio.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.ProposerTips {
+		n += 2
+	}
 	return n
 }
 
@@ -2926,6 +3103,196 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchMsgs", wireType)
+			}
+			m.MaxBatchMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerTips", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProposerTips = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feeTokenPaymentKey is the context key under which the fee token payment of
+// an EVM transaction is stored.
+type feeTokenPaymentKey struct{}
+
+// FeeTokenPayment defines a whitelisted token used to pay for the fees of an
+// EVM transaction instead of the EVM denom.
+type FeeTokenPayment struct {
+	// Denom is the bank denomination of the fee token.
+	Denom string
+	// Price is the amount of the EVM denom, in its original decimals, that is
+	// equivalent to one unit of the fee token.
+	Price sdkmath.LegacyDec
+}
+
+// ConvertFrom18Decimals converts the given EVM denom amount in 18 decimals into
+// the fee token amount. The result is rounded up when charging fees and
+// truncated when refunding them so that the fee collector never loses funds.
+func (p FeeTokenPayment) ConvertFrom18Decimals(amt *big.Int, roundUp bool) sdkmath.Int {
+	if !p.Price.IsPositive() {
+		return sdkmath.ZeroInt()
+	}
+	amount := ConvertBigIntFrom18DecimalsToLegacyDec(amt).Quo(p.Price)
+	if roundUp {
+		return amount.Ceil().TruncateInt()
+	}
+	return amount.TruncateInt()
+}
+
+// ContextWithFeeTokenPayment returns a copy of the context that records the fee
+// token used to pay for the EVM transaction fees.
+func ContextWithFeeTokenPayment(ctx sdk.Context, payment FeeTokenPayment) sdk.Context {
+	return ctx.WithValue(feeTokenPaymentKey{}, payment)
+}
+
+// FeeTokenPaymentFromContext returns the fee token used to pay for the EVM
+// transaction fees, if any.
+func FeeTokenPaymentFromContext(ctx sdk.Context) (FeeTokenPayment, bool) {
+	payment, ok := ctx.Value(feeTokenPaymentKey{}).(FeeTokenPayment)
+	return payment, ok
+}
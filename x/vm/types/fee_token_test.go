@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testconstants "github.com/cosmos/evm/testutil/constants"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"cosmossdk.io/math"
+)
+
+func TestFeeTokenPaymentConvertFrom18Decimals(t *testing.T) {
+	eighteenDecimalsCoinInfo := testconstants.ExampleChainCoinInfo[testconstants.ExampleChainID]
+	sixDecimalsCoinInfo := testconstants.ExampleChainCoinInfo[testconstants.SixDecimalsChainID]
+
+	testCases := []struct {
+		name        string
+		evmCoinInfo evmtypes.EvmCoinInfo
+		price       math.LegacyDec
+		amount      *big.Int
+		roundUp     bool
+		expAmount   math.Int
+	}{
+		{
+			name:        "pass - 18 decimals exact conversion",
+			evmCoinInfo: eighteenDecimalsCoinInfo,
+			price:       math.LegacyNewDec(2),
+			amount:      big.NewInt(10),
+			expAmount:   math.NewInt(5),
+		},
+		{
+			name:        "pass - 18 decimals truncated",
+			evmCoinInfo: eighteenDecimalsCoinInfo,
+			price:       math.LegacyNewDec(2),
+			amount:      big.NewInt(11),
+			expAmount:   math.NewInt(5),
+		},
+		{
+			name:        "pass - 18 decimals rounded up",
+			evmCoinInfo: eighteenDecimalsCoinInfo,
+			price:       math.LegacyNewDec(2),
+			amount:      big.NewInt(11),
+			roundUp:     true,
+			expAmount:   math.NewInt(6),
+		},
+		{
+			name:        "pass - 6 decimals scaled before conversion",
+			evmCoinInfo: sixDecimalsCoinInfo,
+			price:       math.LegacyNewDecWithPrec(5, 1),
+			amount:      big.NewInt(3e12),
+			expAmount:   math.NewInt(6),
+		},
+		{
+			name:        "pass - 6 decimals fractional amount rounded up",
+			evmCoinInfo: sixDecimalsCoinInfo,
+			price:       math.LegacyOneDec(),
+			amount:      big.NewInt(1),
+			roundUp:     true,
+			expAmount:   math.NewInt(1),
+		},
+		{
+			name:        "pass - zero price",
+			evmCoinInfo: eighteenDecimalsCoinInfo,
+			price:       math.LegacyZeroDec(),
+			amount:      big.NewInt(10),
+			roundUp:     true,
+			expAmount:   math.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			configurator := evmtypes.NewEVMConfigurator()
+			configurator.ResetTestConfig()
+			require.NoError(t, configurator.WithEVMCoinInfo(tc.evmCoinInfo).Configure())
+
+			payment := evmtypes.FeeTokenPayment{Denom: "ibc/fee", Price: tc.price}
+			require.Equal(t, tc.expAmount, payment.ConvertFrom18Decimals(tc.amount, tc.roundUp))
+		})
+	}
+}
@@ -70,6 +70,12 @@ type FeeMarketKeeper interface {
 	CalculateBaseFee(ctx sdk.Context) math.LegacyDec
 }
 
+// DistributionKeeper defines the expected interface needed to send part of the
+// EVM transaction fees to the community pool.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // Erc20Keeper defines the expected interface needed to instantiate ERC20 precompiles.
 type Erc20Keeper interface {
 	GetERC20PrecompileInstance(ctx sdk.Context, address common.Address) (contract vm.PrecompiledContract, found bool, err error)
@@ -313,18 +313,55 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 }
 
 func (msg *MsgEthereumTx) BuildTxWithEvmParams(b client.TxBuilder, params Params) (signing.Tx, error) {
+	return BuildBatchTxWithEvmParams(b, params, msg)
+}
+
+// BuildBatchTx builds a Cosmos transaction that executes the given Ethereum
+// transactions as an atomic batch. The fee and the gas limit of the Cosmos
+// transaction are the sums of the ones of the Ethereum transactions.
+func BuildBatchTx(b client.TxBuilder, evmDenom string, msgs ...*MsgEthereumTx) (signing.Tx, error) {
+	return BuildBatchTxWithEvmParams(b, Params{
+		EvmDenom: evmDenom,
+		ExtendedDenomOptions: &ExtendedDenomOptions{
+			ExtendedDenom: GetEVMCoinExtendedDenom(),
+		},
+	}, msgs...)
+}
+
+// BuildBatchTxWithEvmParams builds a Cosmos transaction that executes the given
+// Ethereum transactions, using the denoms of the given EVM params for the fee.
+func BuildBatchTxWithEvmParams(b client.TxBuilder, params Params, msgs ...*MsgEthereumTx) (signing.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
 	}
+	if len(msgs) == 0 {
+		return nil, errors.New("no ethereum transactions to build")
+	}
 
 	option, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
 	if err != nil {
 		return nil, err
 	}
 
+	feeAmt := sdkmath.ZeroInt()
+	gasLimit := uint64(0)
+	txMsgs := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		feeAmt = feeAmt.Add(sdkmath.NewIntFromBigInt(msg.GetFee()))
+		if gasLimit+msg.GetGas() < gasLimit {
+			return nil, errorsmod.Wrap(ErrGasOverflow, "batch gas limit")
+		}
+		gasLimit += msg.GetGas()
+
+		// only keep the nessessary fields
+		txMsgs[i] = &MsgEthereumTx{
+			From: msg.From,
+			Raw:  msg.Raw,
+		}
+	}
+
 	fees := make(sdk.Coins, 0, 1)
-	feeAmt := sdkmath.NewIntFromBigInt(msg.GetFee())
 	if feeAmt.Sign() > 0 {
 		fees = append(fees, sdk.NewCoin(params.EvmDenom, feeAmt))
 		fees = ConvertCoinsDenomToExtendedDenomWithEvmParams(fees, params)
@@ -332,16 +369,11 @@ func (msg *MsgEthereumTx) BuildTxWithEvmParams(b client.TxBuilder, params Params
 
 	builder.SetExtensionOptions(option)
 
-	// only keep the nessessary fields
-	err = builder.SetMsgs(&MsgEthereumTx{
-		From: msg.From,
-		Raw:  msg.Raw,
-	})
-	if err != nil {
+	if err := builder.SetMsgs(txMsgs...); err != nil {
 		return nil, err
 	}
 	builder.SetFeeAmount(fees)
-	builder.SetGasLimit(msg.GetGas())
+	builder.SetGasLimit(gasLimit)
 	tx := builder.GetTx()
 	return tx, nil
 }
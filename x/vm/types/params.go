@@ -13,6 +13,7 @@ import (
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -75,6 +76,17 @@ func DefaultParams() Params {
 		AccessControl:           DefaultAccessControl,
 		HistoryServeWindow:      DefaultHistoryServeWindow,
 		ExtendedDenomOptions:    &ExtendedDenomOptions{ExtendedDenom: sdk.DefaultBondDenom},
+		FeeDistribution:         DefaultFeeDistribution(),
+	}
+}
+
+// DefaultFeeDistribution returns the default fee distribution, which keeps all
+// the fees in the fee collector.
+func DefaultFeeDistribution() FeeDistribution {
+	return FeeDistribution{
+		BaseFeeBurnRatio:   sdkmath.LegacyZeroDec(),
+		CommunityPoolRatio: sdkmath.LegacyZeroDec(),
+		ProposerTips:       false,
 	}
 }
 
@@ -105,6 +117,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := p.FeeDistribution.Validate(); err != nil {
+		return err
+	}
+
 	return validateChannels(p.EVMChannels)
 }
 
@@ -123,6 +139,62 @@ func (p Params) IsEVMChannel(channel string) bool {
 	return slices.Contains(p.EVMChannels, channel)
 }
 
+// virtualFeeCollection is true if the EVM transaction fees are collected through
+// the virtual fee collection of the bank keeper, which the fee distribution
+// doesn't support. It is set together with the keeper option, so that the params
+// validation also covers the genesis params.
+var virtualFeeCollection bool
+
+// SetVirtualFeeCollection sets whether the EVM transaction fees are collected
+// through the virtual fee collection of the bank keeper.
+func SetVirtualFeeCollection(enabled bool) {
+	virtualFeeCollection = enabled
+}
+
+// Validate checks that the fee distribution ratios are within [0, 1], that
+// they don't exceed the base fee portion when combined and that the fee
+// distribution is disabled if the virtual fee collection is used.
+func (fd FeeDistribution) Validate() error {
+	burnRatio, communityRatio := fd.GetBaseFeeBurnRatio(), fd.GetCommunityPoolRatio()
+	if burnRatio.IsNegative() || burnRatio.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("base fee burn ratio must be within [0, 1]: %s", burnRatio)
+	}
+	if communityRatio.IsNegative() || communityRatio.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("community pool ratio must be within [0, 1]: %s", communityRatio)
+	}
+	if burnRatio.Add(communityRatio).GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("base fee burn ratio and community pool ratio cannot exceed 1 combined: %s + %s", burnRatio, communityRatio)
+	}
+	if virtualFeeCollection && fd.IsEnabled() {
+		return errorsmod.Wrap(ErrInvalidFeeDistribution, "fee distribution is not supported with virtual fee collection")
+	}
+	return nil
+}
+
+// GetBaseFeeBurnRatio returns the base fee burn ratio, defaulting to zero when
+// it is not set.
+func (fd FeeDistribution) GetBaseFeeBurnRatio() sdkmath.LegacyDec {
+	if fd.BaseFeeBurnRatio.IsNil() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return fd.BaseFeeBurnRatio
+}
+
+// GetCommunityPoolRatio returns the community pool ratio, defaulting to zero
+// when it is not set.
+func (fd FeeDistribution) GetCommunityPoolRatio() sdkmath.LegacyDec {
+	if fd.CommunityPoolRatio.IsNil() {
+		return sdkmath.LegacyZeroDec()
+	}
+	return fd.CommunityPoolRatio
+}
+
+// IsEnabled returns true if any part of the EVM fees is redirected away from
+// the fee collector.
+func (fd FeeDistribution) IsEnabled() bool {
+	return fd.ProposerTips || fd.GetBaseFeeBurnRatio().IsPositive() || fd.GetCommunityPoolRatio().IsPositive()
+}
+
 func (ac AccessControl) Validate() error {
 	if err := ac.Create.Validate(); err != nil {
 		return err
@@ -5,6 +5,8 @@ import (
 
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
 )
 
 func TestParamsValidate(t *testing.T) {
@@ -49,6 +51,45 @@ func TestParamsValidate(t *testing.T) {
 			},
 			errContains: "precompiles need to be sorted",
 		},
+		{
+			name: "valid fee distribution",
+			params: Params{
+				FeeDistribution: FeeDistribution{
+					BaseFeeBurnRatio:   sdkmath.LegacyNewDecWithPrec(7, 1),
+					CommunityPoolRatio: sdkmath.LegacyNewDecWithPrec(3, 1),
+					ProposerTips:       true,
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "negative base fee burn ratio",
+			params: Params{
+				FeeDistribution: FeeDistribution{
+					BaseFeeBurnRatio: sdkmath.LegacyNewDec(-1),
+				},
+			},
+			errContains: "base fee burn ratio must be within [0, 1]",
+		},
+		{
+			name: "community pool ratio above one",
+			params: Params{
+				FeeDistribution: FeeDistribution{
+					CommunityPoolRatio: sdkmath.LegacyNewDec(2),
+				},
+			},
+			errContains: "community pool ratio must be within [0, 1]",
+		},
+		{
+			name: "fee distribution ratios exceeding one combined",
+			params: Params{
+				FeeDistribution: FeeDistribution{
+					BaseFeeBurnRatio:   sdkmath.LegacyNewDecWithPrec(6, 1),
+					CommunityPoolRatio: sdkmath.LegacyNewDecWithPrec(5, 1),
+				},
+			},
+			errContains: "cannot exceed 1 combined",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -75,6 +116,20 @@ func TestParamsValidate(t *testing.T) {
 	}
 }
 
+// NOTE: not parallel since it sets the package level virtual fee collection flag.
+func TestParamsValidateVirtualFeeCollection(t *testing.T) {
+	SetVirtualFeeCollection(true)
+	defer SetVirtualFeeCollection(false)
+
+	params := DefaultParams()
+	require.NoError(t, params.Validate(), "expected the default fee distribution to be valid")
+
+	params.FeeDistribution.ProposerTips = true
+	err := params.Validate()
+	require.ErrorIs(t, err, ErrInvalidFeeDistribution)
+	require.ErrorContains(t, err, "not supported with virtual fee collection")
+}
+
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []int64{2929, 1884, 1344}
 	params := NewParams(extraEips, nil, nil, DefaultAccessControl)
@@ -692,6 +692,103 @@ func (m *QueryCodeResponse) GetCode() []byte {
 	return nil
 }
 
+// QueryCodeDelegationRequest is the request type for the Query/CodeDelegation
+// RPC method.
+type QueryCodeDelegationRequest struct {
+	// address is the ethereum hex address to query the code delegation for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryCodeDelegationRequest) Reset()         { *m = QueryCodeDelegationRequest{} }
+func (m *QueryCodeDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeDelegationRequest) ProtoMessage()    {}
+func (*QueryCodeDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
+}
+func (m *QueryCodeDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeDelegationRequest.Merge(m, src)
+}
+func (m *QueryCodeDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeDelegationRequest proto.InternalMessageInfo
+
+// QueryCodeDelegationResponse is the response type for the
+// Query/CodeDelegation RPC method.
+type QueryCodeDelegationResponse struct {
+	// delegated is true if the account code is an EIP-7702 delegation.
+	Delegated bool `protobuf:"varint,1,opt,name=delegated,proto3" json:"delegated,omitempty"`
+	// address is the ethereum hex address of the account the code is delegated
+	// to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryCodeDelegationResponse) Reset()         { *m = QueryCodeDelegationResponse{} }
+func (m *QueryCodeDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeDelegationResponse) ProtoMessage()    {}
+func (*QueryCodeDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
+}
+func (m *QueryCodeDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCodeDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeDelegationResponse.Merge(m, src)
+}
+func (m *QueryCodeDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCodeDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryCodeDelegationResponse) GetDelegated() bool {
+	if m != nil {
+		return m.Delegated
+	}
+	return false
+}
+
+func (m *QueryCodeDelegationResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // QueryTxLogsRequest is the request type for the Query/TxLogs RPC method.
 type QueryTxLogsRequest struct {
 	// hash is the ethereum transaction hex hash to query the logs for.
@@ -704,7 +801,7 @@ func (m *QueryTxLogsRequest) Reset()         { *m = QueryTxLogsRequest{} }
 func (m *QueryTxLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsRequest) ProtoMessage()    {}
 func (*QueryTxLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{14}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
 }
 func (m *QueryTxLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,7 +842,7 @@ func (m *QueryTxLogsResponse) Reset()         { *m = QueryTxLogsResponse{} }
 func (m *QueryTxLogsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTxLogsResponse) ProtoMessage()    {}
 func (*QueryTxLogsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{15}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
 }
 func (m *QueryTxLogsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +893,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{16}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +932,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{17}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +986,7 @@ func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
 func (m *EthCallRequest) String() string { return proto.CompactTextString(m) }
 func (*EthCallRequest) ProtoMessage()    {}
 func (*EthCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{18}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
 }
 func (m *EthCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1065,7 @@ func (m *EstimateGasResponse) Reset()         { *m = EstimateGasResponse{} }
 func (m *EstimateGasResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateGasResponse) ProtoMessage()    {}
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{19}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
 }
 func (m *EstimateGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1045,7 +1142,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{20}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,7 +1244,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{21}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1207,7 +1304,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{22}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1302,7 +1399,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{23}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1459,7 @@ func (m *QueryTraceCallRequest) Reset()         { *m = QueryTraceCallRequest{} }
 func (m *QueryTraceCallRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceCallRequest) ProtoMessage()    {}
 func (*QueryTraceCallRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{24}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
 }
 func (m *QueryTraceCallRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1457,7 +1554,7 @@ func (m *QueryTraceCallResponse) Reset()         { *m = QueryTraceCallResponse{}
 func (m *QueryTraceCallResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceCallResponse) ProtoMessage()    {}
 func (*QueryTraceCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{25}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
 }
 func (m *QueryTraceCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1599,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{26}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1541,7 +1638,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{27}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1676,7 @@ func (m *QueryGlobalMinGasPriceRequest) Reset()         { *m = QueryGlobalMinGas
 func (m *QueryGlobalMinGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceRequest) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{28}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{30}
 }
 func (m *QueryGlobalMinGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1715,7 @@ func (m *QueryGlobalMinGasPriceResponse) Reset()         { *m = QueryGlobalMinGa
 func (m *QueryGlobalMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGlobalMinGasPriceResponse) ProtoMessage()    {}
 func (*QueryGlobalMinGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0e8f08e175b3ef0c, []int{29}
+	return fileDescriptor_0e8f08e175b3ef0c, []int{31}
 }
 func (m *QueryGlobalMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1662,6 +1759,8 @@ func init() {
 	proto.RegisterType((*QueryStorageResponse)(nil), "cosmos.evm.vm.v1.QueryStorageResponse")
 	proto.RegisterType((*QueryCodeRequest)(nil), "cosmos.evm.vm.v1.QueryCodeRequest")
 	proto.RegisterType((*QueryCodeResponse)(nil), "cosmos.evm.vm.v1.QueryCodeResponse")
+	proto.RegisterType((*QueryCodeDelegationRequest)(nil), "cosmos.evm.vm.v1.QueryCodeDelegationRequest")
+	proto.RegisterType((*QueryCodeDelegationResponse)(nil), "cosmos.evm.vm.v1.QueryCodeDelegationResponse")
 	proto.RegisterType((*QueryTxLogsRequest)(nil), "cosmos.evm.vm.v1.QueryTxLogsRequest")
 	proto.RegisterType((*QueryTxLogsResponse)(nil), "cosmos.evm.vm.v1.QueryTxLogsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evm.vm.v1.QueryParamsRequest")
@@ -1683,115 +1782,119 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evm/vm/v1/query.proto", fileDescriptor_0e8f08e175b3ef0c) }
 
 var fileDescriptor_0e8f08e175b3ef0c = []byte{
-	// 1716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0x2d, 0xd9, 0x92, 0x46, 0x76, 0xa2, 0x4c, 0xec, 0x8d, 0xcc, 0xb5, 0x25, 0x87, 0xf1,
-	0x77, 0x1c, 0x32, 0xf6, 0x66, 0x17, 0xd8, 0xec, 0x61, 0xd7, 0x36, 0x1c, 0x27, 0x9b, 0x64, 0x91,
-	0x55, 0x8d, 0x1e, 0x0a, 0x14, 0xc2, 0x88, 0x9a, 0x50, 0x84, 0x45, 0x52, 0xe1, 0x50, 0xaa, 0x9c,
-	0x8f, 0x1e, 0x8a, 0x36, 0x4d, 0x90, 0x4b, 0x8a, 0xde, 0xdb, 0x1c, 0x7b, 0x6b, 0x6f, 0xfd, 0x17,
-	0x72, 0x0c, 0x50, 0x14, 0x28, 0x7a, 0x48, 0x8b, 0xa4, 0x40, 0xfb, 0x37, 0xf4, 0x54, 0xcc, 0x07,
-	0x25, 0x52, 0x14, 0x25, 0xa7, 0x48, 0xd1, 0x1e, 0x0a, 0x18, 0x09, 0x67, 0xe6, 0xcd, 0x7b, 0xbf,
-	0x79, 0xdf, 0x4f, 0x60, 0x56, 0x77, 0x88, 0xe5, 0x10, 0x0d, 0xb7, 0x2c, 0x8d, 0xfe, 0x6d, 0x68,
-	0xb7, 0x9a, 0xd8, 0x3d, 0x54, 0x1b, 0xae, 0xe3, 0x39, 0x30, 0xc7, 0x4f, 0x55, 0xdc, 0xb2, 0x54,
-	0xfa, 0xb7, 0x21, 0x9f, 0x40, 0x96, 0x69, 0x3b, 0x1a, 0xfb, 0x97, 0x13, 0xc9, 0x6b, 0x82, 0x45,
-	0x05, 0x11, 0xcc, 0x6f, 0x6b, 0xad, 0x8d, 0x0a, 0xf6, 0xd0, 0x86, 0xd6, 0x40, 0x86, 0x69, 0x23,
-	0xcf, 0x74, 0x6c, 0x41, 0x2b, 0x47, 0xc4, 0x51, 0xd6, 0xfc, 0x6c, 0x26, 0x72, 0xe6, 0xb5, 0xc5,
-	0xd1, 0x94, 0xe1, 0x18, 0x0e, 0xfb, 0xd4, 0xe8, 0x97, 0xd8, 0x9d, 0x35, 0x1c, 0xc7, 0xa8, 0x63,
-	0x0d, 0x35, 0x4c, 0x0d, 0xd9, 0xb6, 0xe3, 0x31, 0x49, 0x44, 0x9c, 0x16, 0xc5, 0x29, 0x5b, 0x55,
-	0x9a, 0x37, 0x35, 0xcf, 0xb4, 0x30, 0xf1, 0x90, 0xd5, 0xe0, 0x04, 0xca, 0x14, 0x80, 0xff, 0xa7,
-	0x68, 0x77, 0x1c, 0xfb, 0xa6, 0x69, 0x94, 0xf0, 0xad, 0x26, 0x26, 0x9e, 0x72, 0x0d, 0x9c, 0x0c,
-	0xed, 0x92, 0x86, 0x63, 0x13, 0x0c, 0xff, 0x0e, 0xc6, 0x75, 0xb6, 0x93, 0x97, 0xe6, 0xa5, 0x95,
-	0xec, 0xe6, 0x9c, 0xda, 0xab, 0x1a, 0x75, 0xa7, 0x86, 0x4c, 0x5b, 0x5c, 0x13, 0xc4, 0xca, 0x3f,
-	0x05, 0xb7, 0x2d, 0x5d, 0x77, 0x9a, 0xb6, 0x27, 0x84, 0xc0, 0x3c, 0x48, 0xa1, 0x6a, 0xd5, 0xc5,
-	0x84, 0x30, 0x76, 0x99, 0x92, 0xbf, 0xbc, 0x98, 0x7e, 0xf0, 0xa4, 0x38, 0xf2, 0xd3, 0x93, 0xe2,
-	0x88, 0xa2, 0x83, 0xa9, 0xf0, 0x55, 0x81, 0x24, 0x0f, 0x52, 0x15, 0x54, 0x47, 0xb6, 0x8e, 0xfd,
-	0xbb, 0x62, 0x09, 0xff, 0x0a, 0x32, 0xba, 0x53, 0xc5, 0xe5, 0x1a, 0x22, 0xb5, 0xfc, 0x28, 0x3b,
-	0x4b, 0xd3, 0x8d, 0xcb, 0x88, 0xd4, 0xe0, 0x14, 0x18, 0xb3, 0x1d, 0x7a, 0x29, 0x31, 0x2f, 0xad,
-	0x24, 0x4b, 0x7c, 0xa1, 0xfc, 0x1b, 0xcc, 0x88, 0xd7, 0xd2, 0xc7, 0xfc, 0x0a, 0x94, 0xf7, 0x25,
-	0x20, 0xf7, 0xe3, 0x20, 0xc0, 0x2e, 0x82, 0x63, 0x5c, 0x4f, 0xe5, 0x30, 0xa7, 0x49, 0xbe, 0xbb,
-	0xc5, 0x37, 0xa1, 0x0c, 0xd2, 0x84, 0x0a, 0xa5, 0xf8, 0x46, 0x19, 0xbe, 0xce, 0x9a, 0xb2, 0x40,
-	0x9c, 0x6b, 0xd9, 0x6e, 0x5a, 0x15, 0xec, 0x8a, 0x17, 0x4c, 0x8a, 0xdd, 0xff, 0xb1, 0x4d, 0xe5,
-	0x2a, 0x98, 0x65, 0x38, 0xde, 0x44, 0x75, 0xb3, 0x8a, 0x3c, 0xc7, 0xed, 0x79, 0xcc, 0x69, 0x30,
-	0xa1, 0x3b, 0x76, 0x2f, 0x8e, 0x2c, 0xdd, 0xdb, 0x8a, 0xbc, 0xea, 0x91, 0x04, 0xe6, 0x62, 0xb8,
-	0x89, 0x87, 0x2d, 0x83, 0xe3, 0x3e, 0xaa, 0x30, 0x47, 0x1f, 0xec, 0x6b, 0x7c, 0x9a, 0xef, 0x44,
-	0xdb, 0xdc, 0xce, 0xaf, 0x62, 0x9e, 0xf3, 0xc2, 0x89, 0x3a, 0x57, 0x87, 0x39, 0x91, 0x72, 0x55,
-	0x08, 0x7b, 0xc3, 0x73, 0x5c, 0x64, 0x0c, 0x17, 0x06, 0x73, 0x20, 0x71, 0x80, 0x0f, 0x85, 0xbf,
-	0xd1, 0xcf, 0x80, 0xf8, 0x75, 0x21, 0xbe, 0xc3, 0x4c, 0x88, 0x9f, 0x02, 0x63, 0x2d, 0x54, 0x6f,
-	0xfa, 0xc2, 0xf9, 0x42, 0xf9, 0x07, 0xc8, 0x09, 0x57, 0xaa, 0xbe, 0xd2, 0x23, 0x97, 0xc1, 0x89,
-	0xc0, 0x3d, 0x21, 0x02, 0x82, 0x24, 0xf5, 0x7d, 0x76, 0x6b, 0xa2, 0xc4, 0xbe, 0x95, 0xdb, 0x22,
-	0xe2, 0xf7, 0xdb, 0xd7, 0x1c, 0x83, 0xf8, 0x22, 0x20, 0x48, 0xb2, 0x88, 0xe1, 0xfc, 0xd9, 0x37,
-	0xbc, 0x04, 0x40, 0x37, 0x77, 0xb1, 0xb7, 0x65, 0x37, 0x97, 0xfc, 0x90, 0xa7, 0x89, 0x4e, 0xe5,
-	0x69, 0x52, 0x24, 0x3a, 0xf5, 0x46, 0x57, 0x55, 0xa5, 0xc0, 0xcd, 0x00, 0xc8, 0x87, 0x92, 0x50,
-	0xac, 0x2f, 0x5c, 0xe0, 0x5c, 0x05, 0xc9, 0xba, 0x63, 0xd0, 0xd7, 0x25, 0x56, 0xb2, 0x9b, 0xd3,
-	0xd1, 0xb4, 0x72, 0xcd, 0x31, 0x4a, 0x8c, 0x04, 0xee, 0xf5, 0x01, 0xb5, 0x3c, 0x14, 0x14, 0x97,
-	0x13, 0x44, 0xd5, 0xc9, 0x7c, 0x37, 0x90, 0x8b, 0x2c, 0x5f, 0x0f, 0x4a, 0x49, 0x00, 0xf4, 0x77,
-	0x05, 0xc0, 0x7f, 0x81, 0xf1, 0x06, 0xdb, 0x11, 0x99, 0x2f, 0x1f, 0x85, 0xc8, 0x6f, 0x6c, 0x67,
-	0x9e, 0x3e, 0x2f, 0x8e, 0x7c, 0xf6, 0xe3, 0x17, 0x6b, 0x52, 0x49, 0x5c, 0x51, 0xbe, 0x96, 0xc0,
-	0xb1, 0x5d, 0xaf, 0xb6, 0x83, 0xea, 0xf5, 0x80, 0xba, 0x91, 0x6b, 0x10, 0xdf, 0x30, 0xf4, 0x1b,
-	0x9e, 0x02, 0x29, 0x03, 0x91, 0xb2, 0x8e, 0x1a, 0x22, 0x46, 0xc6, 0x0d, 0x44, 0x76, 0x50, 0x03,
-	0xbe, 0x0d, 0x72, 0x0d, 0xd7, 0x69, 0x38, 0x04, 0xbb, 0x9d, 0x38, 0xa3, 0x31, 0x32, 0xb1, 0xbd,
-	0xf9, 0xf3, 0xf3, 0xa2, 0x6a, 0x98, 0x5e, 0xad, 0x59, 0x51, 0x75, 0xc7, 0xd2, 0x44, 0xf1, 0xe0,
-	0xff, 0x9d, 0x23, 0xd5, 0x03, 0xcd, 0x3b, 0x6c, 0x60, 0xa2, 0xee, 0x74, 0x03, 0xbc, 0x74, 0xdc,
-	0xe7, 0xe5, 0x07, 0xe7, 0x0c, 0x48, 0xeb, 0x34, 0x6b, 0x97, 0xcd, 0x6a, 0x3e, 0x39, 0x2f, 0xad,
-	0x24, 0x4a, 0x29, 0xb6, 0xbe, 0x52, 0x85, 0xb3, 0x20, 0xe3, 0xb4, 0xb0, 0xeb, 0x9a, 0x55, 0x4c,
-	0xf2, 0x63, 0x0c, 0x6b, 0x77, 0x43, 0xd9, 0x07, 0x27, 0x77, 0x89, 0x67, 0x5a, 0xc8, 0xc3, 0x7b,
-	0xa8, 0xab, 0xab, 0x1c, 0x48, 0x18, 0x88, 0x3f, 0x2d, 0x59, 0xa2, 0x9f, 0x74, 0xc7, 0xc5, 0x1e,
-	0x7b, 0xd5, 0x44, 0x89, 0x7e, 0x52, 0x99, 0x2d, 0xab, 0x8c, 0x5d, 0xd7, 0xe1, 0xe1, 0x9e, 0x29,
-	0xa5, 0x5a, 0xd6, 0x2e, 0x5d, 0x2a, 0x0f, 0x93, 0xbe, 0x8f, 0xb8, 0x48, 0xc7, 0xfb, 0x6d, 0x5f,
-	0x65, 0x1b, 0x20, 0x61, 0x11, 0xbf, 0xf2, 0x14, 0xa3, 0xfa, 0xbf, 0x4e, 0x8c, 0x5d, 0xaf, 0x86,
-	0x5d, 0xdc, 0xb4, 0xf6, 0xdb, 0x25, 0x4a, 0x0b, 0xff, 0x03, 0x26, 0x3c, 0xca, 0xa4, 0x2c, 0xaa,
-	0x56, 0x22, 0xae, 0x6a, 0x31, 0x51, 0xa2, 0x6a, 0x65, 0xbd, 0xee, 0x02, 0xee, 0x80, 0x89, 0x86,
-	0x8b, 0xab, 0x58, 0xc7, 0x84, 0x38, 0x2e, 0xc9, 0x27, 0x99, 0x83, 0x0e, 0x95, 0x1e, 0xba, 0x44,
-	0xb3, 0x6e, 0xa5, 0xee, 0xe8, 0x07, 0x7e, 0x7e, 0x1b, 0x63, 0x4a, 0xce, 0xb2, 0x3d, 0x9e, 0xdd,
-	0xe0, 0x1c, 0x00, 0x9c, 0x84, 0x05, 0xe1, 0x38, 0xd3, 0x48, 0x86, 0xed, 0xb0, 0xba, 0x75, 0xd9,
-	0x3f, 0xa6, 0xe5, 0x3b, 0x9f, 0x62, 0xcf, 0x90, 0x55, 0x5e, 0xdb, 0x55, 0xbf, 0xb6, 0xab, 0xfb,
-	0x7e, 0x6d, 0xdf, 0x9e, 0xa4, 0x4e, 0xf8, 0xf8, 0xbb, 0xa2, 0xc4, 0x1d, 0x91, 0x73, 0xa2, 0xc7,
-	0x7d, 0x7d, 0x29, 0xfd, 0xdb, 0xf8, 0x52, 0x26, 0xec, 0x4b, 0x0a, 0x98, 0xe4, 0x6f, 0xb0, 0x50,
-	0xbb, 0x4c, 0x1d, 0x04, 0x04, 0xd4, 0x70, 0x1d, 0xb5, 0xf7, 0x10, 0xf9, 0x6f, 0x32, 0x3d, 0x9a,
-	0x4b, 0x94, 0xd2, 0x5e, 0xbb, 0x6c, 0xda, 0x55, 0xdc, 0x56, 0xd6, 0x44, 0xea, 0xec, 0xb8, 0x42,
-	0x37, 0xaf, 0x55, 0x91, 0x87, 0xfc, 0xf0, 0xa1, 0xdf, 0xca, 0x97, 0x09, 0xf0, 0x97, 0x2e, 0xf1,
-	0x36, 0xe5, 0x1a, 0x70, 0x1d, 0xaf, 0xed, 0x67, 0x97, 0xe1, 0xae, 0xe3, 0xb5, 0xc9, 0x6b, 0x70,
-	0x9d, 0x3f, 0xad, 0x7e, 0x44, 0xab, 0x2b, 0xe7, 0xc0, 0xa9, 0x88, 0xe1, 0x06, 0x18, 0xfa, 0xc3,
-	0x04, 0x98, 0xee, 0xd2, 0xff, 0x51, 0xb3, 0x6a, 0xaf, 0x03, 0x25, 0x7f, 0x07, 0x07, 0xda, 0x79,
-	0x45, 0x07, 0x4a, 0xfb, 0x0e, 0x14, 0xf4, 0x9d, 0xa0, 0x71, 0xd3, 0x21, 0xe3, 0x2a, 0xeb, 0xc1,
-	0x88, 0xe3, 0x86, 0x18, 0x60, 0xb7, 0xe9, 0x4e, 0x07, 0x47, 0xf0, 0x25, 0x8c, 0xbb, 0xb3, 0xc6,
-	0x54, 0x78, 0x5b, 0xb0, 0xb8, 0x00, 0xd2, 0xb4, 0x9c, 0x97, 0x6f, 0x62, 0xd1, 0x21, 0x6d, 0xcf,
-	0x7c, 0xfb, 0xbc, 0x38, 0xcd, 0xf5, 0x47, 0xaa, 0x07, 0xaa, 0xe9, 0x68, 0x16, 0xf2, 0x6a, 0xea,
-	0x15, 0xdb, 0xa3, 0x9d, 0x1b, 0xbb, 0xad, 0x14, 0x45, 0xcf, 0xba, 0x57, 0x77, 0x2a, 0xa8, 0x7e,
-	0xdd, 0xb4, 0xf7, 0x10, 0xb9, 0xe1, 0x9a, 0x9d, 0x86, 0x51, 0xd1, 0x41, 0x21, 0x8e, 0x40, 0x08,
-	0xde, 0x02, 0x93, 0x96, 0x69, 0x53, 0x67, 0x2d, 0x37, 0xe8, 0x81, 0x90, 0x3e, 0x47, 0x95, 0x13,
-	0x8f, 0x20, 0x6b, 0x75, 0x59, 0x6d, 0x7e, 0x94, 0x03, 0x63, 0x4c, 0x0a, 0xfc, 0x40, 0x02, 0x29,
-	0xd1, 0x36, 0xc3, 0xc5, 0xa8, 0xf1, 0xfb, 0xcc, 0x45, 0xf2, 0xd2, 0x30, 0x32, 0x8e, 0x53, 0x39,
-	0xfb, 0xde, 0x57, 0x3f, 0x7c, 0x3c, 0xba, 0x08, 0xcf, 0x68, 0x91, 0x99, 0x51, 0xb4, 0xce, 0xda,
-	0x1d, 0xe1, 0xd8, 0xf7, 0xe0, 0x27, 0x12, 0x98, 0x0c, 0x4d, 0x27, 0xf0, 0x6c, 0x8c, 0x98, 0x7e,
-	0x53, 0x90, 0xbc, 0x7e, 0x34, 0x62, 0x81, 0x6c, 0x93, 0x21, 0x5b, 0x87, 0x6b, 0x51, 0x64, 0xfe,
-	0x20, 0x14, 0x01, 0xf8, 0xb9, 0x04, 0x72, 0xbd, 0x83, 0x06, 0x54, 0x63, 0xc4, 0xc6, 0xcc, 0x37,
-	0xb2, 0x76, 0x64, 0x7a, 0x81, 0xf4, 0x22, 0x43, 0x7a, 0x01, 0x6e, 0x46, 0x91, 0xb6, 0xfc, 0x3b,
-	0x5d, 0xb0, 0xc1, 0xd9, 0xe9, 0x1e, 0xbc, 0x2f, 0x81, 0x94, 0x18, 0x29, 0x62, 0x4d, 0x1b, 0x9e,
-	0x56, 0x62, 0x4d, 0xdb, 0x33, 0x99, 0x28, 0xeb, 0x0c, 0xd6, 0x12, 0x5c, 0x88, 0xc2, 0x12, 0x23,
-	0x0a, 0x09, 0xa8, 0xee, 0x91, 0x04, 0x52, 0x62, 0xb8, 0x88, 0x05, 0x12, 0x9e, 0x64, 0x62, 0x81,
-	0xf4, 0xcc, 0x28, 0xca, 0x06, 0x03, 0x72, 0x16, 0xae, 0x46, 0x81, 0x10, 0x4e, 0xda, 0xc5, 0xa1,
-	0xdd, 0x39, 0xc0, 0x87, 0xf7, 0xe0, 0x6d, 0x90, 0xa4, 0x33, 0x08, 0x54, 0x62, 0x5d, 0xa6, 0x33,
-	0xd8, 0xc8, 0x67, 0x06, 0xd2, 0x08, 0x0c, 0xab, 0x0c, 0xc3, 0x19, 0x78, 0xba, 0x9f, 0x37, 0x55,
-	0x43, 0x9a, 0x78, 0x07, 0x8c, 0xf3, 0x36, 0x1c, 0x2e, 0xc4, 0x70, 0x0e, 0x75, 0xfb, 0xf2, 0xe2,
-	0x10, 0x2a, 0x81, 0x60, 0x9e, 0x21, 0x90, 0x61, 0x3e, 0x8a, 0x80, 0xb7, 0xf8, 0xb0, 0x0d, 0x52,
-	0xa2, 0xc3, 0x87, 0xf3, 0x51, 0x9e, 0xe1, 0xe6, 0x5f, 0x5e, 0x1e, 0xd6, 0x81, 0xf8, 0x72, 0x15,
-	0x26, 0x77, 0x16, 0xca, 0x51, 0xb9, 0xd8, 0xab, 0x95, 0x75, 0x2a, 0xee, 0x5d, 0x90, 0x0d, 0x34,
-	0xe1, 0x47, 0x90, 0xde, 0xe7, 0xcd, 0x7d, 0xba, 0x78, 0x65, 0x89, 0xc9, 0x9e, 0x87, 0x85, 0x3e,
-	0xb2, 0x05, 0x39, 0x4d, 0x91, 0xf0, 0x2e, 0x48, 0x89, 0xee, 0x2c, 0xd6, 0xf7, 0xc2, 0x8d, 0x7c,
-	0xac, 0xef, 0xf5, 0x34, 0x79, 0x83, 0x5e, 0xcf, 0x2b, 0xab, 0xd7, 0x86, 0x0f, 0x24, 0x00, 0xba,
-	0x6d, 0x03, 0x5c, 0x19, 0xc4, 0x3a, 0xd8, 0x12, 0xca, 0xab, 0x47, 0xa0, 0x14, 0x38, 0x16, 0x19,
-	0x8e, 0x22, 0x9c, 0x8b, 0xc3, 0xc1, 0x6a, 0x25, 0x7c, 0x5f, 0x02, 0x99, 0x4e, 0x21, 0x84, 0xcb,
-	0x83, 0xf8, 0x07, 0xcd, 0xb1, 0x32, 0x9c, 0x50, 0xe0, 0x58, 0x60, 0x38, 0x0a, 0x70, 0x36, 0x0e,
-	0x07, 0xf3, 0x87, 0xbb, 0x34, 0x29, 0xb1, 0x5a, 0x38, 0x20, 0x29, 0x05, 0x0b, 0xf0, 0x80, 0xa4,
-	0x14, 0x2a, 0xc8, 0x83, 0xec, 0xe1, 0x17, 0x6a, 0x1a, 0x80, 0xa2, 0x7b, 0x59, 0x88, 0x0d, 0xed,
-	0xc0, 0x0f, 0x8d, 0xb1, 0x01, 0x18, 0xfe, 0xe1, 0x71, 0x50, 0x00, 0xf2, 0xf6, 0x0a, 0x7e, 0x2a,
-	0x81, 0x13, 0x91, 0x92, 0x0e, 0xe3, 0xea, 0x41, 0x5c, 0x77, 0x20, 0x9f, 0x3f, 0xfa, 0x05, 0x01,
-	0x6d, 0x99, 0x41, 0x3b, 0x0d, 0x8b, 0x51, 0x68, 0xa1, 0x2e, 0x62, 0xfb, 0xe2, 0xd3, 0x17, 0x05,
-	0xe9, 0xd9, 0x8b, 0x82, 0xf4, 0xfd, 0x8b, 0x82, 0xf4, 0xf8, 0x65, 0x61, 0xe4, 0xd9, 0xcb, 0xc2,
-	0xc8, 0x37, 0x2f, 0x0b, 0x23, 0x6f, 0xcd, 0x47, 0x7b, 0x4d, 0xca, 0xa4, 0x4d, 0xd9, 0xb0, 0x4e,
-	0xb3, 0x32, 0xce, 0x9a, 0xb5, 0xbf, 0xfd, 0x12, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xe7, 0xce, 0x3f,
-	0xa9, 0x16, 0x00, 0x00,
+	// 1783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x94, 0x48, 0x3d, 0x49, 0x8e, 0x3c, 0x96, 0x13, 0x7a, 0x23, 0x91, 0xf4, 0xda,
+	0xa2, 0x64, 0x5b, 0xde, 0x8d, 0x54, 0xb7, 0x40, 0xdd, 0x43, 0x63, 0xa9, 0x8e, 0x92, 0xc6, 0x2e,
+	0xdc, 0xad, 0xda, 0x43, 0x81, 0x82, 0x18, 0xee, 0x8e, 0x97, 0x0b, 0x71, 0x77, 0x99, 0x9d, 0x25,
+	0x4b, 0x27, 0x71, 0x0f, 0x45, 0x9b, 0x26, 0xc8, 0x25, 0x40, 0xef, 0x6d, 0x80, 0x5e, 0x7a, 0x6b,
+	0x6f, 0xf9, 0x0a, 0x39, 0x06, 0x28, 0x0a, 0x14, 0x3d, 0xb8, 0x85, 0x5d, 0xa0, 0xfd, 0x0c, 0x3d,
+	0x15, 0xf3, 0x67, 0xc9, 0x5d, 0x2d, 0x57, 0x4b, 0x15, 0x29, 0xda, 0x43, 0x01, 0xc2, 0xde, 0x79,
+	0xf3, 0xe6, 0xfd, 0x7e, 0x6f, 0xe6, 0xcd, 0x9b, 0xf7, 0x04, 0x1b, 0x56, 0x40, 0xbd, 0x80, 0x1a,
+	0x64, 0xe8, 0x19, 0xec, 0xb7, 0x67, 0xbc, 0x33, 0x20, 0xe1, 0x13, 0xbd, 0x1f, 0x06, 0x51, 0x80,
+	0xd6, 0xc4, 0xac, 0x4e, 0x86, 0x9e, 0xce, 0x7e, 0x7b, 0xea, 0x45, 0xec, 0xb9, 0x7e, 0x60, 0xf0,
+	0x7f, 0x85, 0x92, 0x7a, 0x53, 0x9a, 0xe8, 0x60, 0x4a, 0xc4, 0x6a, 0x63, 0xb8, 0xd7, 0x21, 0x11,
+	0xde, 0x33, 0xfa, 0xd8, 0x71, 0x7d, 0x1c, 0xb9, 0x81, 0x2f, 0x75, 0xd5, 0x0c, 0x1c, 0x33, 0x2d,
+	0xe6, 0xae, 0x64, 0xe6, 0xa2, 0x91, 0x9c, 0x5a, 0x77, 0x02, 0x27, 0xe0, 0x9f, 0x06, 0xfb, 0x92,
+	0xd2, 0x0d, 0x27, 0x08, 0x9c, 0x1e, 0x31, 0x70, 0xdf, 0x35, 0xb0, 0xef, 0x07, 0x11, 0x47, 0xa2,
+	0x72, 0xb6, 0x21, 0x67, 0xf9, 0xa8, 0x33, 0x78, 0x6c, 0x44, 0xae, 0x47, 0x68, 0x84, 0xbd, 0xbe,
+	0x50, 0xd0, 0xd6, 0x01, 0x7d, 0x97, 0xb1, 0x3d, 0x0c, 0xfc, 0xc7, 0xae, 0x63, 0x92, 0x77, 0x06,
+	0x84, 0x46, 0xda, 0x03, 0xb8, 0x94, 0x92, 0xd2, 0x7e, 0xe0, 0x53, 0x82, 0xbe, 0x0a, 0x8b, 0x16,
+	0x97, 0xd4, 0x94, 0xa6, 0xb2, 0xb3, 0xbc, 0xbf, 0xa9, 0x9f, 0xde, 0x1a, 0xfd, 0xb0, 0x8b, 0x5d,
+	0x5f, 0x2e, 0x93, 0xca, 0xda, 0xd7, 0xa5, 0xb5, 0x7b, 0x96, 0x15, 0x0c, 0xfc, 0x48, 0x82, 0xa0,
+	0x1a, 0x54, 0xb0, 0x6d, 0x87, 0x84, 0x52, 0x6e, 0x6e, 0xc9, 0x8c, 0x87, 0x77, 0xab, 0x1f, 0x7e,
+	0xda, 0x98, 0xfb, 0xc7, 0xa7, 0x8d, 0x39, 0xcd, 0x82, 0xf5, 0xf4, 0x52, 0xc9, 0xa4, 0x06, 0x95,
+	0x0e, 0xee, 0x61, 0xdf, 0x22, 0xf1, 0x5a, 0x39, 0x44, 0xaf, 0xc2, 0x92, 0x15, 0xd8, 0xa4, 0xdd,
+	0xc5, 0xb4, 0x5b, 0x9b, 0xe7, 0x73, 0x55, 0x26, 0x78, 0x13, 0xd3, 0x2e, 0x5a, 0x87, 0x05, 0x3f,
+	0x60, 0x8b, 0x4a, 0x4d, 0x65, 0xa7, 0x6c, 0x8a, 0x81, 0xf6, 0x4d, 0xb8, 0x22, 0xbd, 0x65, 0xce,
+	0xfc, 0x1b, 0x2c, 0x3f, 0x50, 0x40, 0x9d, 0x66, 0x41, 0x92, 0xdd, 0x82, 0x0b, 0x62, 0x9f, 0xda,
+	0x69, 0x4b, 0xab, 0x42, 0x7a, 0x4f, 0x08, 0x91, 0x0a, 0x55, 0xca, 0x40, 0x19, 0xbf, 0x79, 0xce,
+	0x6f, 0x3c, 0x66, 0x26, 0xb0, 0xb0, 0xda, 0xf6, 0x07, 0x5e, 0x87, 0x84, 0xd2, 0x83, 0x55, 0x29,
+	0xfd, 0x0e, 0x17, 0x6a, 0x6f, 0xc3, 0x06, 0xe7, 0xf1, 0x03, 0xdc, 0x73, 0x6d, 0x1c, 0x05, 0xe1,
+	0x29, 0x67, 0xae, 0xc2, 0x8a, 0x15, 0xf8, 0xa7, 0x79, 0x2c, 0x33, 0xd9, 0xbd, 0x8c, 0x57, 0x1f,
+	0x2b, 0xb0, 0x99, 0x63, 0x4d, 0x3a, 0xb6, 0x0d, 0x2f, 0xc5, 0xac, 0xd2, 0x16, 0x63, 0xb2, 0x5f,
+	0xa2, 0x6b, 0x71, 0x10, 0x1d, 0x88, 0x73, 0x3e, 0xcf, 0xf1, 0xbc, 0x06, 0xeb, 0xe9, 0xa5, 0x45,
+	0x41, 0xa4, 0xbd, 0x2d, 0xc1, 0xbe, 0x17, 0x05, 0x21, 0x76, 0x8a, 0xc1, 0xd0, 0x1a, 0x94, 0x4e,
+	0xc8, 0x13, 0x19, 0x6f, 0xec, 0x33, 0x01, 0xbf, 0x0b, 0xeb, 0x69, 0x63, 0x12, 0x7e, 0x1d, 0x16,
+	0x86, 0xb8, 0x37, 0x88, 0xc1, 0xc5, 0x40, 0xfb, 0x1a, 0xac, 0xc9, 0x50, 0xb2, 0xcf, 0xe5, 0xe4,
+	0x36, 0x5c, 0x4c, 0xac, 0x93, 0x10, 0x08, 0xca, 0x2c, 0xf6, 0xf9, 0xaa, 0x15, 0x93, 0x7f, 0x6b,
+	0xaf, 0x8f, 0x63, 0xd5, 0x26, 0xdf, 0x22, 0x3d, 0xe2, 0xf0, 0x84, 0x71, 0x1e, 0xa8, 0xef, 0xc3,
+	0xab, 0x53, 0x2d, 0x48, 0xd0, 0x0d, 0x58, 0xb2, 0x85, 0x94, 0xd8, 0xdc, 0x48, 0xd5, 0x9c, 0x08,
+	0x92, 0x00, 0xf3, 0x29, 0x00, 0xed, 0x5d, 0x99, 0x8a, 0x8e, 0x47, 0x0f, 0x02, 0x87, 0xc6, 0x84,
+	0x10, 0x94, 0xf9, 0x55, 0x16, 0x6c, 0xf8, 0x37, 0x7a, 0x03, 0x60, 0x92, 0x54, 0xb9, 0x99, 0xe5,
+	0xfd, 0x56, 0x9c, 0x8b, 0x58, 0x06, 0xd6, 0x45, 0xfe, 0x96, 0x19, 0x58, 0x7f, 0x34, 0x39, 0x43,
+	0x33, 0xb1, 0x32, 0xe1, 0xd2, 0x47, 0x0a, 0x5c, 0x4a, 0x81, 0x4b, 0x5f, 0x6e, 0x40, 0xb9, 0x17,
+	0x38, 0x6c, 0x2f, 0x4a, 0x3b, 0xcb, 0xfb, 0x97, 0xb3, 0xf9, 0xee, 0x41, 0xe0, 0x98, 0x5c, 0x05,
+	0x1d, 0x4d, 0x21, 0xb5, 0x5d, 0x48, 0x4a, 0xe0, 0x24, 0x59, 0x8d, 0x53, 0xf2, 0x23, 0x1c, 0x62,
+	0x2f, 0xde, 0x07, 0xcd, 0x84, 0x4b, 0x29, 0xa9, 0x24, 0xf8, 0x0d, 0x58, 0xec, 0x73, 0x89, 0x4c,
+	0xc9, 0xb5, 0x2c, 0x45, 0xb1, 0xe2, 0x60, 0xe9, 0xf3, 0x67, 0x8d, 0xb9, 0xdf, 0xfe, 0xfd, 0xf7,
+	0x37, 0x15, 0x53, 0x2e, 0xd1, 0xfe, 0xa8, 0xc0, 0x85, 0xfb, 0x51, 0xf7, 0x10, 0xf7, 0x7a, 0x89,
+	0xed, 0xc6, 0xa1, 0x43, 0xe3, 0x88, 0x61, 0xdf, 0xe8, 0x15, 0xa8, 0x38, 0x98, 0xb6, 0x2d, 0xdc,
+	0x97, 0x97, 0x77, 0xd1, 0xc1, 0xf4, 0x10, 0xf7, 0xd1, 0x8f, 0x60, 0xad, 0x1f, 0x06, 0xfd, 0x80,
+	0x92, 0x70, 0x9c, 0x00, 0xd8, 0xe5, 0x5d, 0x39, 0xd8, 0xff, 0xe7, 0xb3, 0x86, 0xee, 0xb8, 0x51,
+	0x77, 0xd0, 0xd1, 0xad, 0xc0, 0x33, 0xe4, 0xab, 0x26, 0xfe, 0xbb, 0x4d, 0xed, 0x13, 0x23, 0x7a,
+	0xd2, 0x27, 0x54, 0x3f, 0x9c, 0x64, 0x1e, 0xf3, 0xa5, 0xd8, 0x96, 0x14, 0xa0, 0x2b, 0x50, 0xb5,
+	0xd8, 0x73, 0xd2, 0x76, 0xed, 0x5a, 0xb9, 0xa9, 0xec, 0x94, 0xcc, 0x0a, 0x1f, 0xbf, 0x65, 0xb3,
+	0x18, 0x0b, 0x86, 0x24, 0x0c, 0x5d, 0x9b, 0xd0, 0xda, 0x02, 0xe7, 0x3a, 0x11, 0x68, 0xc7, 0x70,
+	0xe9, 0x3e, 0x8d, 0x5c, 0x0f, 0x47, 0xe4, 0x08, 0x4f, 0xf6, 0x6a, 0x0d, 0x4a, 0x0e, 0x16, 0xae,
+	0x95, 0x4d, 0xf6, 0xc9, 0x24, 0x21, 0x89, 0xb8, 0x57, 0x2b, 0x26, 0xfb, 0x64, 0x98, 0x43, 0xaf,
+	0x4d, 0xc2, 0x30, 0x10, 0x79, 0x68, 0xc9, 0xac, 0x0c, 0xbd, 0xfb, 0x6c, 0xa8, 0x7d, 0x54, 0x8e,
+	0x63, 0x24, 0xc4, 0x16, 0x39, 0x1e, 0xc5, 0x5b, 0xb6, 0x07, 0x25, 0x8f, 0xc6, 0x4f, 0x62, 0x23,
+	0xbb, 0xff, 0x0f, 0xa9, 0x73, 0x3f, 0xea, 0x92, 0x90, 0x0c, 0xbc, 0xe3, 0x91, 0xc9, 0x74, 0xd1,
+	0xeb, 0xb0, 0x12, 0x31, 0x23, 0x6d, 0xf9, 0x9c, 0x96, 0xf2, 0x9e, 0x53, 0x0e, 0x25, 0x9f, 0xd3,
+	0xe5, 0x68, 0x32, 0x40, 0x87, 0xb0, 0xd2, 0x0f, 0x89, 0x4d, 0x2c, 0x42, 0x69, 0x10, 0xd2, 0x5a,
+	0xb9, 0x59, 0x9a, 0x05, 0x3d, 0xb5, 0x88, 0x3d, 0x07, 0x9d, 0x5e, 0x60, 0x9d, 0xc4, 0x89, 0x77,
+	0x81, 0x6f, 0xf2, 0x32, 0x97, 0x89, 0xb4, 0x8b, 0x36, 0x01, 0x84, 0x0a, 0xbf, 0x84, 0x8b, 0x7c,
+	0x47, 0x96, 0xb8, 0x84, 0x3f, 0xa8, 0x6f, 0xc6, 0xd3, 0xac, 0xae, 0xa8, 0x55, 0xb8, 0x1b, 0xaa,
+	0x2e, 0x8a, 0x0e, 0x3d, 0x2e, 0x3a, 0xf4, 0xe3, 0xb8, 0xe8, 0x38, 0x58, 0x65, 0x41, 0xf8, 0xc9,
+	0x5f, 0x1a, 0x8a, 0x08, 0x44, 0x61, 0x89, 0x4d, 0x4f, 0x8d, 0xa5, 0xea, 0x7f, 0x26, 0x96, 0x96,
+	0xd2, 0xb1, 0xa4, 0xc1, 0xaa, 0xf0, 0xc1, 0xc3, 0xa3, 0x36, 0x0b, 0x10, 0x48, 0x6c, 0xc3, 0x43,
+	0x3c, 0x3a, 0xc2, 0xf4, 0xdb, 0xe5, 0xea, 0xfc, 0x5a, 0xc9, 0xac, 0x46, 0xa3, 0xb6, 0xeb, 0xdb,
+	0x64, 0xa4, 0xdd, 0x94, 0x39, 0x7d, 0x1c, 0x0a, 0x93, 0x84, 0x6b, 0xe3, 0x08, 0xc7, 0xd7, 0x87,
+	0x7d, 0x6b, 0x9f, 0x95, 0xe0, 0xe5, 0x89, 0xf2, 0x01, 0xb3, 0x9a, 0x08, 0x9d, 0x68, 0x14, 0x67,
+	0x97, 0xe2, 0xd0, 0x89, 0x46, 0xf4, 0x4b, 0x08, 0x9d, 0xff, 0x9f, 0xfa, 0x8c, 0xa7, 0xae, 0xdd,
+	0x86, 0x57, 0x32, 0x07, 0x77, 0xc6, 0x41, 0xff, 0xa2, 0x04, 0x97, 0x27, 0xfa, 0xff, 0xab, 0x59,
+	0xf5, 0x74, 0x00, 0x95, 0xff, 0x0b, 0x01, 0x74, 0x78, 0xce, 0x00, 0xaa, 0xc6, 0x01, 0x94, 0x8c,
+	0x9d, 0xe4, 0xe1, 0x56, 0x53, 0x87, 0xab, 0xed, 0xc2, 0xcb, 0xa7, 0x0f, 0xe2, 0x8c, 0x73, 0xbb,
+	0x3c, 0x2e, 0x2d, 0x29, 0x79, 0x83, 0x90, 0x49, 0x13, 0xb4, 0x9e, 0x16, 0x4b, 0x13, 0x77, 0xa0,
+	0xca, 0x9e, 0xf3, 0xf6, 0x63, 0x22, 0x4b, 0xb7, 0x83, 0x2b, 0x7f, 0x7e, 0xd6, 0xb8, 0x2c, 0xf6,
+	0x8f, 0xda, 0x27, 0xba, 0x1b, 0x18, 0x1e, 0x8e, 0xba, 0xfa, 0x5b, 0x7e, 0xc4, 0x4a, 0x4a, 0xbe,
+	0x5a, 0x6b, 0xc8, 0x62, 0xfa, 0xa8, 0x17, 0x74, 0x70, 0xef, 0xa1, 0xeb, 0x1f, 0x61, 0xfa, 0x28,
+	0x74, 0xc7, 0x95, 0xac, 0x66, 0x41, 0x3d, 0x4f, 0x41, 0x02, 0xdf, 0x83, 0x55, 0xcf, 0xf5, 0x59,
+	0xb0, 0xb6, 0xfb, 0x6c, 0x42, 0xa2, 0x6f, 0xb2, 0xcd, 0xc9, 0x67, 0xb0, 0xec, 0x4d, 0x4c, 0xed,
+	0x7f, 0x76, 0x11, 0x16, 0x38, 0x0a, 0xfa, 0xb9, 0x02, 0x15, 0x59, 0xcf, 0xa3, 0xad, 0xec, 0xe1,
+	0x4f, 0x69, 0xd8, 0xd4, 0x56, 0x91, 0x9a, 0xe0, 0xa9, 0xdd, 0xfa, 0xe9, 0x1f, 0xfe, 0xf6, 0xcb,
+	0xf9, 0x2d, 0x74, 0xcd, 0xc8, 0x34, 0xb3, 0xb2, 0xa6, 0x37, 0xde, 0x93, 0x81, 0xfd, 0x14, 0xfd,
+	0x4a, 0x81, 0xd5, 0x54, 0xdb, 0x84, 0x6e, 0xe5, 0xc0, 0x4c, 0x6b, 0xcf, 0xd4, 0xdd, 0xd9, 0x94,
+	0x25, 0xb3, 0x7d, 0xce, 0x6c, 0x17, 0xdd, 0xcc, 0x32, 0x8b, 0x3b, 0xb4, 0x0c, 0xc1, 0xdf, 0x29,
+	0xb0, 0x76, 0xba, 0x03, 0x42, 0x7a, 0x0e, 0x6c, 0x4e, 0xe3, 0xa5, 0x1a, 0x33, 0xeb, 0x4b, 0xa6,
+	0x77, 0x39, 0xd3, 0x3b, 0x68, 0x3f, 0xcb, 0x74, 0x18, 0xaf, 0x99, 0x90, 0x4d, 0x36, 0x75, 0x4f,
+	0xd1, 0x07, 0x0a, 0x54, 0x64, 0xaf, 0x93, 0x7b, 0xb4, 0xe9, 0x36, 0x4a, 0x6d, 0x15, 0xa9, 0x49,
+	0x5a, 0xbb, 0x9c, 0x56, 0x0b, 0x5d, 0xcf, 0xd2, 0x92, 0xbd, 0x13, 0x4d, 0x6c, 0xdd, 0xc7, 0x0a,
+	0x54, 0x64, 0xd7, 0x93, 0x4b, 0x24, 0xdd, 0x62, 0xa9, 0xad, 0x22, 0x35, 0x49, 0x64, 0x8f, 0x13,
+	0xb9, 0x85, 0x6e, 0x64, 0x89, 0x50, 0xa1, 0x3a, 0xe1, 0x61, 0xbc, 0x77, 0x42, 0x9e, 0x3c, 0x45,
+	0xef, 0x42, 0x99, 0x75, 0x2c, 0x48, 0xcb, 0x0d, 0x99, 0x71, 0xc7, 0xa5, 0x5e, 0x3b, 0x53, 0x47,
+	0x72, 0xb8, 0xc1, 0x39, 0x5c, 0x43, 0x57, 0xa7, 0x45, 0x93, 0x9d, 0xda, 0x89, 0xdf, 0x28, 0x70,
+	0x21, 0xdd, 0x2e, 0xa1, 0xdd, 0x33, 0x20, 0x32, 0x7d, 0x99, 0x7a, 0x7b, 0x46, 0x6d, 0x49, 0xed,
+	0x0e, 0xa7, 0xa6, 0xa3, 0xdd, 0xe9, 0xd4, 0xda, 0xf6, 0x78, 0x49, 0x92, 0xe5, 0x8f, 0x61, 0x51,
+	0x34, 0x0b, 0xe8, 0x7a, 0x0e, 0x5c, 0xaa, 0x27, 0x51, 0xb7, 0x0a, 0xb4, 0x24, 0x99, 0x26, 0x27,
+	0xa3, 0xa2, 0x5a, 0x96, 0x8c, 0x68, 0x44, 0xd0, 0x08, 0x2a, 0xb2, 0x0f, 0x41, 0xcd, 0xac, 0xcd,
+	0x74, 0x8b, 0xa2, 0x6e, 0x17, 0xd5, 0x49, 0x31, 0xae, 0xc6, 0x71, 0x37, 0x90, 0x9a, 0xc5, 0x25,
+	0x51, 0xb7, 0x6d, 0x31, 0xb8, 0x9f, 0xc0, 0x72, 0xa2, 0x55, 0x98, 0x01, 0x7d, 0x8a, 0xcf, 0x53,
+	0x7a, 0x0d, 0xad, 0xc5, 0xb1, 0x9b, 0xa8, 0x3e, 0x05, 0x5b, 0xaa, 0xb3, 0x44, 0x8e, 0xde, 0x87,
+	0x8a, 0xac, 0x21, 0x73, 0x6f, 0x48, 0xba, 0xdd, 0x50, 0x5b, 0x45, 0x6a, 0xc5, 0xde, 0x8b, 0xf7,
+	0x3f, 0x1a, 0xa1, 0x0f, 0x15, 0x80, 0x49, 0x71, 0x83, 0x76, 0xce, 0x32, 0x9d, 0x2c, 0x5c, 0xd5,
+	0x1b, 0x33, 0x68, 0x4a, 0x1e, 0x5b, 0x9c, 0x47, 0x03, 0x6d, 0xe6, 0xf1, 0xe0, 0x2f, 0x3a, 0xfa,
+	0x99, 0x02, 0x4b, 0xe3, 0xe7, 0x1a, 0x6d, 0x9f, 0x65, 0x3f, 0x79, 0x1c, 0x3b, 0xc5, 0x8a, 0x92,
+	0xc7, 0x75, 0xce, 0xa3, 0x8e, 0x36, 0xf2, 0x78, 0xf0, 0x78, 0x78, 0x9f, 0xa5, 0x4e, 0xfe, 0x62,
+	0x9f, 0x91, 0x3a, 0x93, 0x65, 0x82, 0xda, 0x2a, 0x52, 0x2b, 0x3e, 0x8f, 0xb8, 0x9c, 0x60, 0x17,
+	0x50, 0xd6, 0x58, 0xd7, 0x73, 0xef, 0x7b, 0xe2, 0xef, 0xb4, 0xea, 0x56, 0x81, 0x56, 0xf1, 0x05,
+	0x14, 0x45, 0x20, 0xfa, 0xb5, 0x02, 0x17, 0x33, 0x85, 0x07, 0xca, 0x7b, 0xb5, 0xf2, 0x6a, 0x18,
+	0xf5, 0xb5, 0xd9, 0x17, 0x48, 0x6a, 0xdb, 0x9c, 0xda, 0x55, 0xd4, 0xc8, 0x52, 0x4b, 0xd5, 0x3a,
+	0x07, 0x77, 0x3f, 0x7f, 0x5e, 0x57, 0xbe, 0x78, 0x5e, 0x57, 0xfe, 0xfa, 0xbc, 0xae, 0x7c, 0xf2,
+	0xa2, 0x3e, 0xf7, 0xc5, 0x8b, 0xfa, 0xdc, 0x9f, 0x5e, 0xd4, 0xe7, 0x7e, 0xd8, 0xcc, 0x56, 0xc4,
+	0xcc, 0xc8, 0x88, 0x99, 0xe1, 0xf5, 0x70, 0x67, 0x91, 0x97, 0x94, 0x5f, 0xf9, 0xd7, 0x00, 0xe2,
+	0xce, 0xc3, 0x41, 0xe8, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1820,6 +1923,9 @@ type QueryClient interface {
 	Storage(ctx context.Context, in *QueryStorageRequest, opts ...grpc.CallOption) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(ctx context.Context, in *QueryCodeRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// CodeDelegation queries the EIP-7702 code delegation of an account, set by
+	// the authorizations of SetCode transactions.
+	CodeDelegation(ctx context.Context, in *QueryCodeDelegationRequest, opts ...grpc.CallOption) (*QueryCodeDelegationResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
@@ -1908,6 +2014,15 @@ func (c *queryClient) Code(ctx context.Context, in *QueryCodeRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) CodeDelegation(ctx context.Context, in *QueryCodeDelegationRequest, opts ...grpc.CallOption) (*QueryCodeDelegationResponse, error) {
+	out := new(QueryCodeDelegationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/CodeDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.evm.vm.v1.Query/Params", in, out, opts...)
@@ -2005,6 +2120,9 @@ type QueryServer interface {
 	Storage(context.Context, *QueryStorageRequest) (*QueryStorageResponse, error)
 	// Code queries the balance of all coins for a single account.
 	Code(context.Context, *QueryCodeRequest) (*QueryCodeResponse, error)
+	// CodeDelegation queries the EIP-7702 code delegation of an account, set by
+	// the authorizations of SetCode transactions.
+	CodeDelegation(context.Context, *QueryCodeDelegationRequest) (*QueryCodeDelegationResponse, error)
 	// Params queries the parameters of x/vm module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EthCall implements the `eth_call` rpc api
@@ -2053,6 +2171,9 @@ func (*UnimplementedQueryServer) Storage(ctx context.Context, req *QueryStorageR
 func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryCodeRequest) (*QueryCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
 }
+func (*UnimplementedQueryServer) CodeDelegation(ctx context.Context, req *QueryCodeDelegationRequest) (*QueryCodeDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeDelegation not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -2193,6 +2314,24 @@ func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CodeDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evm.vm.v1.Query/CodeDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeDelegation(ctx, req.(*QueryCodeDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -2355,6 +2494,7 @@ func _Query_GlobalMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evm.vm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2383,6 +2523,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Code",
 			Handler:    _Query_Code_Handler,
 		},
+		{
+			MethodName: "CodeDelegation",
+			Handler:    _Query_CodeDelegation_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -2881,6 +3025,76 @@ func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCodeDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Delegated {
+		i--
+		if m.Delegated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTxLogsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3803,6 +4017,35 @@ func (m *QueryCodeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCodeDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Delegated {
+		n += 2
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTxLogsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5390,6 +5633,190 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCodeDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delegated = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTxLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0